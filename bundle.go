@@ -0,0 +1,504 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// bundleFormatVersion is bumped whenever the bundle layout changes incompatibly.
+const bundleFormatVersion = 1
+
+// WorkflowBundle packages one or more workflows for promotion between environments.
+// Environment specific values (config entries, hosts, secrets) are replaced by
+// ${bundle:NAME} placeholders and listed in Variables. Credentials are never
+// exported; the names the workflows reference are listed so they can be mapped
+// to credentials of the target environment.
+type WorkflowBundle struct {
+	FormatVersion int                         `json:"formatVersion"` // Layout version of the bundle.
+	ExportedAt    time.Time                   `json:"exportedAt"`    // When the bundle was created.
+	Workflows     []BundledWorkflow           `json:"workflows"`     // The exported workflows.
+	Variables     map[string]BundleVariable   `json:"variables"`     // Placeholders shared by the workflows.
+	Credentials   map[string]BundleCredential `json:"credentials"`   // Credentials referenced by the workflows.
+}
+
+// BundleCredential describes a credential referenced by the bundled workflows.
+type BundleCredential struct {
+	Type string `json:"type,omitempty"` // Credential type in the source environment, if known.
+}
+
+// BundledWorkflow is a single workflow inside a bundle.
+type BundledWorkflow struct {
+	WorkflowID   string                 `json:"workflowID"`   // ID of the workflow in the source environment.
+	UpdatedAt    time.Time              `json:"updatedAt"`    // Last modification in the source environment.
+	WorkflowData map[string]interface{} `json:"workflowData"` // Workflow definition with placeholders.
+}
+
+// BundleVariable describes a placeholder that is resolved on import.
+type BundleVariable struct {
+	Kind   string      `json:"kind"`            // "config", "host" or "secret".
+	Value  interface{} `json:"value,omitempty"` // Default value; never set for secrets.
+	Secret bool        `json:"secret"`          // Whether a value must be supplied on import.
+}
+
+// BundleImportRequest is the body accepted by the import endpoint.
+type BundleImportRequest struct {
+	Bundle        WorkflowBundle         `json:"bundle"`        // The bundle to import.
+	Values        map[string]interface{} `json:"values"`        // Target environment values for placeholders.
+	CredentialMap map[string]string      `json:"credentialMap"` // Renames credential references (source name -> target name).
+	OnConflict    string                 `json:"onConflict"`    // "skip" (default), "overwrite" or "rename".
+}
+
+// BundleImportResult reports what happened to one workflow during import.
+type BundleImportResult struct {
+	WorkflowID string `json:"workflowID"`      // ID of the workflow in the bundle.
+	ImportedAs string `json:"importedAs"`      // ID the workflow was saved under.
+	Status     string `json:"status"`          // "created", "overwritten", "renamed" or "skipped".
+	Error      string `json:"error,omitempty"` // Set when the workflow could not be saved.
+}
+
+var (
+	bundlePlaceholderRegex = regexp.MustCompile(`\$\{bundle:([A-Za-z0-9_.\-]+)\}`)
+	bundleHostRegex        = regexp.MustCompile(`^https?://[^/?#{}\s]+`)
+	bundleNameSanitizer    = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+	bundleVariableEscaper  = regexp.MustCompile(`[^A-Za-z0-9_.\-]+`)
+	secretKeyRegex         = regexp.MustCompile(`(?i)(password|passwd|secret|token|apikey|api_key|connectionstring)`)
+)
+
+// bundlePlaceholder returns the placeholder text for a bundle variable.
+func bundlePlaceholder(name string) string {
+	return "${bundle:" + name + "}"
+}
+
+// isTemplateString reports whether a value is computed from templates at runtime.
+func isTemplateString(s string) bool {
+	return strings.Contains(s, "{{")
+}
+
+// bundleExporter accumulates variables while workflows are added to a bundle.
+type bundleExporter struct {
+	bundle WorkflowBundle
+}
+
+// newBundleExporter creates an empty bundle ready to receive workflows.
+func newBundleExporter() *bundleExporter {
+	return &bundleExporter{
+		bundle: WorkflowBundle{
+			FormatVersion: bundleFormatVersion,
+			ExportedAt:    time.Now().UTC(),
+			Workflows:     []BundledWorkflow{},
+			Variables:     make(map[string]BundleVariable),
+			Credentials:   make(map[string]BundleCredential),
+		},
+	}
+}
+
+// bundleVariableName replaces the characters placeholders do not allow, which
+// config keys, node IDs and workflow IDs may contain, with '_'.
+func bundleVariableName(name string) string {
+	name = bundleVariableEscaper.ReplaceAllString(name, "_")
+	if name == "" {
+		return "_"
+	}
+	return name
+}
+
+// addVariable registers a variable, falling back to a workflow scoped name when
+// another workflow already uses the same name with a different value, and to a
+// numbered one when even that is taken.
+func (be *bundleExporter) addVariable(name, scope string, variable BundleVariable) string {
+	name = bundleVariableName(name)
+	candidate := name
+	for i := 1; ; i++ {
+		existing, ok := be.bundle.Variables[candidate]
+		if !ok {
+			be.bundle.Variables[candidate] = variable
+			return candidate
+		}
+		if existing.Kind == variable.Kind && fmt.Sprintf("%v", existing.Value) == fmt.Sprintf("%v", variable.Value) {
+			return candidate
+		}
+		candidate = bundleVariableName(scope) + "." + name
+		if i > 1 {
+			candidate += fmt.Sprintf("_%d", i)
+		}
+	}
+}
+
+// Add parameterizes a workflow and appends it to the bundle.
+func (be *bundleExporter) Add(workflowID string, updatedAt time.Time, workflowData map[string]interface{}) error {
+	// Round-trip through JSON to get a private copy made of plain maps and slices
+	raw, err := json.Marshal(workflowData)
+	if err != nil {
+		return fmt.Errorf("failed to marshal workflow %s: %w", workflowID, err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return fmt.Errorf("failed to copy workflow %s: %w", workflowID, err)
+	}
+
+	// Config entries become shared variables; secret looking ones are blanked out
+	if config, ok := data["config"].(map[string]interface{}); ok {
+		for key, value := range config {
+			variable := BundleVariable{Kind: "config", Value: value}
+			if secretKeyRegex.MatchString(key) {
+				variable = BundleVariable{Kind: "secret", Secret: true}
+			}
+			config[key] = bundlePlaceholder(be.addVariable(key, workflowID, variable))
+		}
+	}
+
+	// Literal hosts and secrets inside node parameters
+	if nodes, ok := data["nodes"].([]interface{}); ok {
+		for _, rawNode := range nodes {
+			node, ok := rawNode.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			nodeID, _ := node["id"].(string)
+			if params, ok := node["parameters"].(map[string]interface{}); ok {
+				node["parameters"] = be.parameterize(params, workflowID, nodeID)
+			}
+		}
+	}
+
+	// Record referenced credentials; their values stay in the source environment
+	refs := make(map[string]bool)
+	findCredentialRefs(data, refs)
+	for _, params := range workflowNodeParams(data) {
+		findNodeCredentials(params, refs)
+	}
+	for name := range refs {
+		be.bundle.Credentials[name] = BundleCredential{}
+	}
+
+	be.bundle.Workflows = append(be.bundle.Workflows, BundledWorkflow{
+		WorkflowID:   workflowID,
+		UpdatedAt:    updatedAt,
+		WorkflowData: data,
+	})
+	return nil
+}
+
+// parameterize walks node parameters replacing hosts and literal secrets with placeholders.
+func (be *bundleExporter) parameterize(value interface{}, workflowID, nodeID string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if str, ok := val.(string); ok && str != "" && !isTemplateString(str) && secretKeyRegex.MatchString(key) {
+				name := be.addVariable(nodeID+"."+key, workflowID, BundleVariable{Kind: "secret", Secret: true})
+				v[key] = bundlePlaceholder(name)
+				continue
+			}
+			v[key] = be.parameterize(val, workflowID, nodeID)
+		}
+		return v
+	case []interface{}:
+		for i, val := range v {
+			v[i] = be.parameterize(val, workflowID, nodeID)
+		}
+		return v
+	case string:
+		origin := bundleHostRegex.FindString(v)
+		if origin == "" {
+			return v
+		}
+		parsed, err := url.Parse(origin)
+		if err != nil || parsed.Host == "" {
+			return v
+		}
+		name := "host_" + strings.Trim(bundleNameSanitizer.ReplaceAllString(parsed.Host, "_"), "_")
+		name = be.addVariable(name, workflowID, BundleVariable{Kind: "host", Value: origin})
+		return bundlePlaceholder(name) + strings.TrimPrefix(v, origin)
+	default:
+		return v
+	}
+}
+
+// DescribeCredentials fills in the type of each referenced credential that exists
+// in the source environment.
+func (be *bundleExporter) DescribeCredentials() {
+	for name := range be.bundle.Credentials {
+		if info, err := GetCredentialInfoFromDB(name); err == nil {
+			be.bundle.Credentials[name] = BundleCredential{Type: info.Type}
+		}
+	}
+}
+
+// Bundle returns the finished bundle.
+func (be *bundleExporter) Bundle() WorkflowBundle {
+	return be.bundle
+}
+
+// resolveBundleValues merges the supplied values over the bundle defaults and
+// reports placeholders that still have no value.
+func resolveBundleValues(bundle WorkflowBundle, values map[string]interface{}) (map[string]interface{}, error) {
+	resolved := make(map[string]interface{})
+	var missing []string
+
+	for name, variable := range bundle.Variables {
+		if value, ok := values[name]; ok {
+			resolved[name] = value
+			continue
+		}
+		if variable.Secret || variable.Value == nil {
+			missing = append(missing, name)
+			continue
+		}
+		resolved[name] = variable.Value
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("missing values for placeholders: %s", strings.Join(missing, ", "))
+	}
+	return resolved, nil
+}
+
+// substituteBundlePlaceholders replaces placeholders in a workflow with resolved values.
+// A string consisting of a single placeholder takes the value with its original type.
+func substituteBundlePlaceholders(value interface{}, values map[string]interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			substituted, err := substituteBundlePlaceholders(val, values)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = substituted
+		}
+		return v, nil
+	case []interface{}:
+		for i, val := range v {
+			substituted, err := substituteBundlePlaceholders(val, values)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = substituted
+		}
+		return v, nil
+	case string:
+		if m := bundlePlaceholderRegex.FindStringSubmatch(v); m != nil && m[0] == v {
+			resolved, ok := values[m[1]]
+			if !ok {
+				return nil, fmt.Errorf("unknown placeholder: %s", m[1])
+			}
+			return resolved, nil
+		}
+		var substituteErr error
+		result := bundlePlaceholderRegex.ReplaceAllStringFunc(v, func(match string) string {
+			name := bundlePlaceholderRegex.FindStringSubmatch(match)[1]
+			resolved, ok := values[name]
+			if !ok {
+				substituteErr = fmt.Errorf("unknown placeholder: %s", name)
+				return match
+			}
+			return fmt.Sprintf("%v", resolved)
+		})
+		return result, substituteErr
+	default:
+		return v, nil
+	}
+}
+
+// ImportWorkflowBundle saves the workflows of a bundle, resolving placeholders and
+// applying the requested conflict strategy to IDs that already exist.
+func ImportWorkflowBundle(req BundleImportRequest) ([]BundleImportResult, error) {
+	if req.Bundle.FormatVersion != bundleFormatVersion {
+		return nil, fmt.Errorf("unsupported bundle format version: %d", req.Bundle.FormatVersion)
+	}
+
+	onConflict := req.OnConflict
+	if onConflict == "" {
+		onConflict = "skip"
+	}
+	if onConflict != "skip" && onConflict != "overwrite" && onConflict != "rename" {
+		return nil, fmt.Errorf("invalid onConflict value: %s", onConflict)
+	}
+
+	values, err := resolveBundleValues(req.Bundle, req.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every referenced credential must exist in the target environment
+	var missing []string
+	for _, target := range bundleCredentialTargets(req.Bundle, req.CredentialMap) {
+		if _, err := GetCredentialInfoFromDB(target); err != nil {
+			missing = append(missing, target)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("missing credentials in target environment: %s", strings.Join(missing, ", "))
+	}
+
+	results := make([]BundleImportResult, 0, len(req.Bundle.Workflows))
+	for _, bundled := range req.Bundle.Workflows {
+		result := BundleImportResult{WorkflowID: bundled.WorkflowID, ImportedAs: bundled.WorkflowID, Status: "created"}
+
+		data, err := prepareBundledWorkflow(bundled, values, req.CredentialMap)
+		if err != nil {
+			result.Status = "error"
+			result.Error = err.Error()
+			results = append(results, result)
+			continue
+		}
+
+		exists, err := WorkflowExistsInDB(bundled.WorkflowID)
+		if err != nil {
+			return results, err
+		}
+		if exists {
+			switch onConflict {
+			case "skip":
+				result.Status = "skipped"
+				results = append(results, result)
+				continue
+			case "overwrite":
+				result.Status = "overwritten"
+			case "rename":
+				newID, err := nextFreeWorkflowID(bundled.WorkflowID)
+				if err != nil {
+					return results, err
+				}
+				result.ImportedAs = newID
+				result.Status = "renamed"
+			}
+		}
+
+		if err := SaveWorkflowToDB(result.ImportedAs, data); err != nil {
+			result.Status = "error"
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// bundleCredentialTargets returns the names, after mapping, of the credentials
+// the bundled workflows need in the target environment.
+func bundleCredentialTargets(bundle WorkflowBundle, mapping map[string]string) []string {
+	targets := make([]string, 0, len(bundle.Credentials))
+	for name := range bundle.Credentials {
+		if mapped, ok := mapping[name]; ok {
+			name = mapped
+		}
+		targets = append(targets, name)
+	}
+	sort.Strings(targets)
+	return targets
+}
+
+// prepareBundledWorkflow returns a copy of a bundled workflow with its
+// placeholders resolved and its credentials renamed for the target environment.
+func prepareBundledWorkflow(bundled BundledWorkflow, values map[string]interface{}, mapping map[string]string) (map[string]interface{}, error) {
+	data, err := substituteBundlePlaceholders(deepCopyValue(bundled.WorkflowData), values)
+	if err != nil {
+		return nil, err
+	}
+	workflowData, _ := data.(map[string]interface{})
+	renameCredentialRefs(workflowData, mapping)
+	return workflowData, nil
+}
+
+// workflowNodeParams returns the parameters of every node of a workflow.
+func workflowNodeParams(workflowData map[string]interface{}) []map[string]interface{} {
+	var all []map[string]interface{}
+	nodes, _ := workflowData["nodes"].([]interface{})
+	for _, rawNode := range nodes {
+		node, _ := rawNode.(map[string]interface{})
+		if params, ok := node["parameters"].(map[string]interface{}); ok {
+			all = append(all, params)
+		}
+	}
+	return all
+}
+
+// renameCredentialRefs renames the credentials of a workflow according to the
+// mapping: $credentials.NAME references and the credential parameters of nodes.
+func renameCredentialRefs(workflowData map[string]interface{}, mapping map[string]string) {
+	if len(mapping) == 0 {
+		return
+	}
+	renameCredentialTemplates(workflowData, mapping)
+	for _, params := range workflowNodeParams(workflowData) {
+		for _, holder := range nodeCredentialParams(params) {
+			if name, ok := holder["credential"].(string); ok {
+				if target, ok := mapping[name]; ok {
+					holder["credential"] = target
+				}
+			}
+		}
+	}
+}
+
+// renameCredentialTemplates rewrites $credentials.NAME references according to the mapping.
+func renameCredentialTemplates(value interface{}, mapping map[string]string) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			v[key] = renameCredentialTemplates(val, mapping)
+		}
+		return v
+	case []interface{}:
+		for i, val := range v {
+			v[i] = renameCredentialTemplates(val, mapping)
+		}
+		return v
+	case string:
+		return credentialRefRegex.ReplaceAllStringFunc(v, func(match string) string {
+			name := strings.TrimPrefix(match, "$credentials.")
+			if target, ok := mapping[name]; ok {
+				return "$credentials." + target
+			}
+			return match
+		})
+	default:
+		return v
+	}
+}
+
+// nextFreeWorkflowID finds an unused ID of the form "<id>-imported", "<id>-imported-2", ...
+func nextFreeWorkflowID(workflowID string) (string, error) {
+	for i := 1; i <= 100; i++ {
+		candidate := workflowID + "-imported"
+		if i > 1 {
+			candidate = fmt.Sprintf("%s-imported-%d", workflowID, i)
+		}
+		exists, err := WorkflowExistsInDB(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return candidate, nil
+		}
+	}
+	return "", errors.New("could not find a free workflow ID for " + workflowID)
+}
+
+// deepCopyValue copies nested maps and slices so that they can be modified safely.
+func deepCopyValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			copied[key] = deepCopyValue(val)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, val := range v {
+			copied[i] = deepCopyValue(val)
+		}
+		return copied
+	default:
+		return v
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBundleRenamesNodeCredentials(t *testing.T) {
+	workflow := map[string]interface{}{
+		"workflow": map[string]interface{}{"name": "Business partners"},
+		"nodes": []interface{}{
+			map[string]interface{}{"id": "get_bps", "type": "sapB1", "parameters": map[string]interface{}{
+				"credential": "sapDev",
+				"operation":  "get",
+				"endpoint":   "BusinessPartners",
+			}},
+			map[string]interface{}{"id": "copy", "type": "sqlQuery", "parameters": map[string]interface{}{
+				"credential": "warehouse",
+				"operation":  "copy",
+				"query":      "SELECT * FROM partners ORDER BY id",
+				"target":     map[string]interface{}{"credential": "sapDev", "table": "partners"},
+			}},
+			map[string]interface{}{"id": "notify", "type": "httpRequest", "parameters": map[string]interface{}{
+				"headers": map[string]interface{}{"Authorization": "Bearer {{$credentials.sapDev.token}}"},
+			}},
+		},
+	}
+
+	exporter := newBundleExporter()
+	if err := exporter.Add("partners", time.Now(), workflow); err != nil {
+		t.Fatalf("export failed: %v", err)
+	}
+	raw, err := json.Marshal(exporter.Bundle())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bundle WorkflowBundle
+	if err := json.Unmarshal(raw, &bundle); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := bundle.Credentials["sapDev"]; !ok {
+		t.Errorf("bundle credentials = %v, want sapDev listed", bundle.Credentials)
+	}
+	if _, ok := bundle.Credentials["warehouse"]; !ok {
+		t.Errorf("bundle credentials = %v, want warehouse listed", bundle.Credentials)
+	}
+
+	mapping := map[string]string{"sapDev": "sapProd"}
+	if got, want := bundleCredentialTargets(bundle, mapping), []string{"sapProd", "warehouse"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("credentials checked on import = %v, want %v", got, want)
+	}
+
+	values, err := resolveBundleValues(bundle, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	imported, err := prepareBundledWorkflow(bundle.Workflows[0], values, mapping)
+	if err != nil {
+		t.Fatalf("import failed: %v", err)
+	}
+
+	params := workflowNodeParams(imported)
+	if got := params[0]["credential"]; got != "sapProd" {
+		t.Errorf("sapB1 credential = %v, want sapProd", got)
+	}
+	if got := params[1]["credential"]; got != "warehouse" {
+		t.Errorf("sqlQuery credential = %v, want warehouse (not mapped)", got)
+	}
+	if got := params[1]["target"].(map[string]interface{})["credential"]; got != "sapProd" {
+		t.Errorf("copy target credential = %v, want sapProd", got)
+	}
+	header := params[2]["headers"].(map[string]interface{})["Authorization"]
+	if want := "Bearer {{$credentials.sapProd.token}}"; header != want {
+		t.Errorf("Authorization = %v, want %v", header, want)
+	}
+
+	// The bundle itself is left as exported
+	if got := workflowNodeParams(bundle.Workflows[0].WorkflowData)[0]["credential"]; got != "sapDev" {
+		t.Errorf("bundled sapB1 credential = %v, want sapDev", got)
+	}
+}
@@ -0,0 +1,294 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned without contacting a system whose circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// Circuit breaker states
+const (
+	circuitClosed   = "closed"
+	circuitOpen     = "open"
+	circuitHalfOpen = "halfOpen"
+)
+
+// CircuitBreakerConfig controls when a breaker opens and how it recovers.
+type CircuitBreakerConfig struct {
+	FailureThreshold int `json:"failureThreshold"` // Consecutive failures that open the breaker (0 disables it).
+	OpenSeconds      int `json:"openSeconds"`      // How long the breaker stays open before probing.
+	HalfOpenRequests int `json:"halfOpenRequests"` // Probe requests allowed while half-open.
+}
+
+// defaultCircuitBreakerConfig applies to every system without its own entry
+var defaultCircuitBreakerConfig = CircuitBreakerConfig{FailureThreshold: 5, OpenSeconds: 30, HalfOpenRequests: 1}
+
+// circuitBreakerConfigs holds the settings from WORKFLOW_CIRCUIT_BREAKERS, keyed
+// like the breakers ("credential:<name>" or "host:<hostname>") or "*" for the default:
+//
+//	{"*": {"failureThreshold": 5, "openSeconds": 30},
+//	 "credential:sapDev": {"failureThreshold": 3, "openSeconds": 120}}
+var circuitBreakerConfigs = map[string]CircuitBreakerConfig{}
+
+// circuitBreakers are shared by all executions in the process.
+var circuitBreakers = struct {
+	sync.Mutex
+	byKey map[string]*circuitBreaker
+}{byKey: make(map[string]*circuitBreaker)}
+
+// circuitBreaker tracks the health of one downstream system.
+type circuitBreaker struct {
+	mu          sync.Mutex
+	key         string
+	config      CircuitBreakerConfig
+	state       string
+	failures    int
+	openedAt    time.Time
+	probes      int
+	lastError   string
+	lastFailure time.Time
+}
+
+// CircuitBreakerState is the API view of a breaker.
+type CircuitBreakerState struct {
+	Key         string     `json:"key"`
+	State       string     `json:"state"`
+	Failures    int        `json:"failures"`
+	OpenedAt    *time.Time `json:"openedAt,omitempty"`
+	RetryAt     *time.Time `json:"retryAt,omitempty"`
+	LastError   string     `json:"lastError,omitempty"`
+	LastFailure *time.Time `json:"lastFailure,omitempty"`
+}
+
+// InitCircuitBreakers reads the breaker settings from WORKFLOW_CIRCUIT_BREAKERS.
+func InitCircuitBreakers() error {
+	raw := os.Getenv("WORKFLOW_CIRCUIT_BREAKERS")
+	if raw == "" {
+		return nil
+	}
+
+	configs := map[string]CircuitBreakerConfig{}
+	if err := json.Unmarshal([]byte(raw), &configs); err != nil {
+		return fmt.Errorf("invalid WORKFLOW_CIRCUIT_BREAKERS: %w", err)
+	}
+	for key, config := range configs {
+		if key != "*" && !strings.HasPrefix(key, "host:") && !strings.HasPrefix(key, "credential:") {
+			return fmt.Errorf("invalid WORKFLOW_CIRCUIT_BREAKERS key %q: expected *, host:<name> or credential:<name>", key)
+		}
+		if config.FailureThreshold < 0 || config.OpenSeconds < 0 || config.HalfOpenRequests < 0 {
+			return fmt.Errorf("invalid WORKFLOW_CIRCUIT_BREAKERS entry %q: values must not be negative", key)
+		}
+	}
+	if config, ok := configs["*"]; ok {
+		defaultCircuitBreakerConfig = config
+	}
+	circuitBreakerConfigs = configs
+	return nil
+}
+
+// circuitBreakerKey names the system a request goes to: its credential when it
+// has one, since a credential stands for one system, else its host.
+func circuitBreakerKey(target, credentialName string) string {
+	if credentialName != "" {
+		return "credential:" + credentialName
+	}
+	if parsed, err := url.Parse(target); err == nil && parsed.Hostname() != "" {
+		return "host:" + strings.ToLower(parsed.Hostname())
+	}
+	return ""
+}
+
+// getCircuitBreaker returns the shared breaker of a key, or nil when breaking is disabled for it.
+func getCircuitBreaker(key string) *circuitBreaker {
+	if key == "" {
+		return nil
+	}
+
+	circuitBreakers.Lock()
+	defer circuitBreakers.Unlock()
+
+	if breaker, ok := circuitBreakers.byKey[key]; ok {
+		return breaker
+	}
+
+	config, ok := circuitBreakerConfigs[key]
+	if !ok {
+		config = defaultCircuitBreakerConfig
+	}
+	if config.FailureThreshold <= 0 {
+		return nil
+	}
+	if config.OpenSeconds <= 0 {
+		config.OpenSeconds = 30
+	}
+	if config.HalfOpenRequests <= 0 {
+		config.HalfOpenRequests = 1
+	}
+
+	breaker := &circuitBreaker{key: key, config: config, state: circuitClosed}
+	circuitBreakers.byKey[key] = breaker
+	return breaker
+}
+
+// allow reports whether a request may be sent. probe is set for the trial
+// requests of a half-open breaker.
+func (b *circuitBreaker) allow() (probe bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	openFor := time.Duration(b.config.OpenSeconds) * time.Second
+	if b.state == circuitOpen && time.Since(b.openedAt) >= openFor {
+		b.state = circuitHalfOpen
+		b.probes = 0
+	}
+
+	switch b.state {
+	case circuitOpen:
+		retryIn := time.Until(b.openedAt.Add(openFor)).Round(time.Second)
+		return false, fmt.Errorf("%w for %s after %d failures (last: %s); retry in %v",
+			ErrCircuitOpen, b.key, b.failures, b.lastError, retryIn)
+	case circuitHalfOpen:
+		if b.probes >= b.config.HalfOpenRequests {
+			return false, fmt.Errorf("%w for %s: waiting for probe requests to finish", ErrCircuitOpen, b.key)
+		}
+		b.probes++
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// record updates the breaker with the outcome of a request. failure is empty on success.
+func (b *circuitBreaker) record(probe bool, failure string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		b.probes--
+	}
+
+	if failure == "" {
+		if b.state == circuitHalfOpen && probe {
+			log.Printf("Circuit breaker for %s closed", b.key)
+		}
+		if b.state != circuitOpen {
+			b.state = circuitClosed
+			b.failures = 0
+		}
+		return
+	}
+
+	b.failures++
+	b.lastError = failure
+	b.lastFailure = time.Now()
+
+	if (b.state == circuitHalfOpen && probe) || (b.state == circuitClosed && b.failures >= b.config.FailureThreshold) {
+		b.state = circuitOpen
+		b.openedAt = time.Now()
+		log.Printf("Circuit breaker for %s opened after %d failures: %s", b.key, b.failures, failure)
+	}
+}
+
+// cancel gives back a probe slot without recording an outcome.
+func (b *circuitBreaker) cancel(probe bool) {
+	if !probe {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probes--
+}
+
+// snapshot returns the API view of the breaker.
+func (b *circuitBreaker) snapshot() CircuitBreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state := CircuitBreakerState{Key: b.key, State: b.state, Failures: b.failures, LastError: b.lastError}
+	if b.state == circuitOpen && time.Since(b.openedAt) >= time.Duration(b.config.OpenSeconds)*time.Second {
+		state.State = circuitHalfOpen
+	}
+	if !b.openedAt.IsZero() && b.state != circuitClosed {
+		openedAt := b.openedAt
+		retryAt := openedAt.Add(time.Duration(b.config.OpenSeconds) * time.Second)
+		state.OpenedAt, state.RetryAt = &openedAt, &retryAt
+	}
+	if !b.lastFailure.IsZero() {
+		lastFailure := b.lastFailure
+		state.LastFailure = &lastFailure
+	}
+	return state
+}
+
+// CircuitBreakerStates returns the state of every breaker, sorted by key.
+func CircuitBreakerStates() []CircuitBreakerState {
+	circuitBreakers.Lock()
+	breakers := make([]*circuitBreaker, 0, len(circuitBreakers.byKey))
+	for _, breaker := range circuitBreakers.byKey {
+		breakers = append(breakers, breaker)
+	}
+	circuitBreakers.Unlock()
+
+	states := make([]CircuitBreakerState, 0, len(breakers))
+	for _, breaker := range breakers {
+		states = append(states, breaker.snapshot())
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].Key < states[j].Key })
+	return states
+}
+
+// ResetCircuitBreaker closes a breaker, e.g. once the system is known to be back.
+func ResetCircuitBreaker(key string) bool {
+	circuitBreakers.Lock()
+	breaker, ok := circuitBreakers.byKey[key]
+	circuitBreakers.Unlock()
+	if !ok {
+		return false
+	}
+
+	breaker.mu.Lock()
+	defer breaker.mu.Unlock()
+	breaker.state = circuitClosed
+	breaker.failures = 0
+	breaker.probes = 0
+	return true
+}
+
+// guardCall runs a request through the circuit breaker of its system. A
+// transport error or a 5xx status counts as a failure of the system.
+func (we *WorkflowEngine) guardCall(target, credentialName string, call func() (int, error)) error {
+	breaker := getCircuitBreaker(circuitBreakerKey(target, credentialName))
+	if breaker == nil {
+		_, err := call()
+		return err
+	}
+
+	probe, err := breaker.allow()
+	if err != nil {
+		return err
+	}
+
+	statusCode, err := call()
+	switch {
+	case errors.Is(err, ErrOutboundBlocked):
+		// The request never left the process, so it says nothing about the system
+		breaker.cancel(probe)
+	case err != nil:
+		breaker.record(probe, we.redactError(err))
+	case statusCode >= 500:
+		breaker.record(probe, fmt.Sprintf("status %d", statusCode))
+	default:
+		breaker.record(probe, "")
+	}
+	return err
+}
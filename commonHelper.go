@@ -3,38 +3,19 @@ package main
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
-// countryToAlpha3 converts a country name to its ISO Alpha-3 code.
-// Returns "   " if the country is not found in the map.
-func countryToAlpha3(country string) string {
-	countryMap := map[string]string{
-		"united states":  "USA",
-		"united kingdom": "GBR",
-		"germany":        "DEU",
-		"france":         "FRA",
-		"canada":         "CAN",
-		"australia":      "AUS",
-		"india":          "IND",
-		"china":          "CHN",
-		"japan":          "JPN",
-		"brazil":         "BRA",
-		"israel":         "IL",
-	}
-
-	normalized := strings.ToLower(strings.TrimSpace(country))
-	if code, ok := countryMap[normalized]; ok {
-		return code
-	}
-	return "   "
-}
-
-// truncate shortens a string to the specified maximum length.
+// truncate shortens a string to at most maxLen characters.
 func truncate(s string, maxLen int) string {
-	if len(s) > maxLen {
-		return s[:maxLen]
+	if maxLen < 0 {
+		maxLen = 0
+	}
+	if runes := []rune(s); len(runes) > maxLen {
+		return string(runes[:maxLen])
 	}
 	return s
 }
@@ -78,21 +59,16 @@ func toBoolean(value interface{}) (bool, error) {
 	}
 }
 
-// substring extracts a portion of a string between start and end indices.
+// substring extracts the characters of a string between start and end indices.
 func substring(s string, start, end int) string {
-	if start < 0 {
-		start = 0
-	}
-	if end > len(s) {
-		end = len(s)
-	}
+	runes := []rune(s)
+	// Like JavaScript, indices are clamped to the string and swapped when reversed
+	start = max(0, min(start, len(runes)))
+	end = max(0, min(end, len(runes)))
 	if start > end {
 		start, end = end, start
 	}
-	if start > len(s) || end < 0 {
-		return ""
-	}
-	return s[start:end]
+	return string(runes[start:end])
 }
 
 // concat concatenates multiple strings into one.
@@ -125,15 +101,19 @@ func replace(s, old, new string) string {
 	return strings.Replace(s, old, new, 1)
 }
 
-// strLength returns the length of a string.
+// strLength returns the length of a string in characters.
 func strLength(s string) int {
-	return len(s)
+	return utf8.RuneCountInString(s)
 }
 
-// indexOf returns the index of the first occurrence of a substring in a string.
+// indexOf returns the character index of the first occurrence of a substring in a string.
 // Returns -1 if the substring is not found.
 func indexOf(s, substr string) int {
-	return strings.Index(s, substr)
+	i := strings.Index(s, substr)
+	if i < 0 {
+		return i
+	}
+	return utf8.RuneCountInString(s[:i])
 }
 
 // includes checks if a substring exists within a string.
@@ -179,15 +159,6 @@ func keys(obj map[string]interface{}) []string {
 	return keys
 }
 
-// values returns a slice of all values in a map.
-func values(obj map[string]interface{}) []interface{} {
-	vals := make([]interface{}, 0, len(obj))
-	for _, v := range obj {
-		vals = append(vals, v)
-	}
-	return vals
-}
-
 // parseInt converts a string to an integer.
 // Returns 0 if the string is empty.
 func parseInt(s string) (int, error) {
@@ -210,3 +181,34 @@ func parseFloat(s string) (float64, error) {
 func toFixed(num float64, digits int) string {
 	return strconv.FormatFloat(num, 'f', digits, 64)
 }
+
+// urlEncode percent-encodes a string for a URL query value, with spaces as %20.
+// With mode "path" it encodes a path segment instead.
+func urlEncode(s, mode string) string {
+	if mode == "path" {
+		return url.PathEscape(s)
+	}
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+// odataString escapes a string for use inside a quoted OData literal ('...').
+func odataString(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
+
+// sqlString escapes a string for use inside a quoted SQL literal ('...').
+// NUL characters, which some drivers treat as a terminator, are removed.
+func sqlString(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "\x00", ""), "'", "''")
+}
+
+// soqlString escapes a string for use inside a quoted SOQL literal ('...').
+func soqlString(s string) string {
+	return soqlEscaper.Replace(s)
+}
+
+// soqlEscaper escapes the characters that SOQL string literals require escaped
+var soqlEscaper = strings.NewReplacer(
+	`\`, `\\`, `'`, `\'`, `"`, `\"`,
+	"\n", `\n`, "\r", `\r`, "\t", `\t`, "\b", `\b`, "\f", `\f`,
+)
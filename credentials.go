@@ -0,0 +1,615 @@
+package main
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/crypto/scrypt"
+)
+
+var (
+	credentialsCollection *mongo.Collection
+
+	// Keys used to encrypt credential data. The previous key is only used for
+	// decryption so that credentials stay readable while the master key is rotated.
+	masterKey         []byte
+	previousMasterKey []byte
+
+	// legacyMasterKeys are passphrase keys derived with a single SHA-256 before
+	// scrypt was used. They only decrypt credentials until the next rotation.
+	legacyMasterKeys [][]byte
+
+	// ErrCredentialNotFound is returned when a credential does not exist
+	ErrCredentialNotFound = errors.New("credential not found")
+	// ErrCredentialStoreDisabled is returned when no master key is configured
+	ErrCredentialStoreDisabled = errors.New("credential store is not configured: set WORKFLOW_MASTER_KEY")
+
+	credentialNameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_\-]*$`)
+
+	// credentialRefRegex matches references like $credentials.sapDev.password inside templates
+	credentialRefRegex = regexp.MustCompile(`\$credentials\.([A-Za-z][A-Za-z0-9_\-]*)`)
+	// credentialFieldRefRegex also captures the field of a reference, if any
+	credentialFieldRefRegex = regexp.MustCompile(`\$credentials\.([A-Za-z][A-Za-z0-9_\-]*)(?:\.([A-Za-z0-9_\-]+))?`)
+)
+
+const (
+	// credentialDataVersion 2 binds the encrypted data to the credential name,
+	// so it cannot be copied to another credential document
+	credentialDataVersion = 2
+
+	// scrypt parameters used to derive the master key from a passphrase
+	masterKeyScryptN = 1 << 15
+	masterKeyScryptR = 8
+	masterKeyScryptP = 1
+)
+
+// credentialTypes lists the supported credential types and their required fields
+var credentialTypes = map[string][]string{
+	"generic": {},
+	"basic":   {"username", "password"},
+	"bearer":  {"token"},
+	"apiKey":  {"key"}, // Optional header (default X-API-Key), prefix or queryParam
+	"sapB1":   {"serviceLayerUrl", "companyDB", "username", "password"},
+
+	// Database credentials hold a dsn or host, port, database, username and password
+	"database": {"driver"},
+	"mongodb":  {"uri"}, // Optional database used when a node names none
+
+	// OAuth2 credentials obtain access tokens from a token endpoint
+	"oauth2ClientCredentials": {"tokenUrl", "clientId", "clientSecret"},
+	"oauth2RefreshToken":      {"tokenUrl", "clientId", "refreshToken"},
+	"oauth2JwtBearer":         {"tokenUrl", "clientId", "username", "privateKey"},
+}
+
+// CredentialDocument is the stored form of a credential; Data holds the encrypted values
+type CredentialDocument struct {
+	Name        string    `bson:"_id"`
+	Type        string    `bson:"type"`
+	Description string    `bson:"description"`
+	Fields      []string  `bson:"fields"`
+	KeyID       string    `bson:"keyID"`
+	Version     int       `bson:"version"` // credentialDataVersion the data was encrypted with
+	Data        string    `bson:"data"`
+	CreatedAt   time.Time `bson:"createdAt"`
+	UpdatedAt   time.Time `bson:"updatedAt"`
+}
+
+// CredentialInfo is the public view of a credential. It never contains the secret values.
+type CredentialInfo struct {
+	Name        string    `json:"name"`
+	Type        string    `json:"type"`
+	Description string    `json:"description"`
+	Fields      []string  `json:"fields"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// CredentialInput is the body accepted when creating or updating a credential
+type CredentialInput struct {
+	Name        string                 `json:"name"`
+	Type        string                 `json:"type"`
+	Description string                 `json:"description"`
+	Data        map[string]interface{} `json:"data"`
+}
+
+// InitCredentialStore loads the master keys and prepares the credentials collection
+func InitCredentialStore() error {
+	credentialsCollection = database.Collection("credentials")
+
+	value := os.Getenv("WORKFLOW_MASTER_KEY")
+	if value == "" {
+		log.Println("Warning: WORKFLOW_MASTER_KEY is not set, credential store is disabled")
+		return nil
+	}
+	salt, err := loadMasterKeySalt()
+	if err != nil {
+		return err
+	}
+
+	key, legacy, err := parseMasterKey(value, salt)
+	if err != nil {
+		return fmt.Errorf("invalid WORKFLOW_MASTER_KEY: %w", err)
+	}
+	masterKey = key
+
+	previous, previousLegacy, err := parseMasterKey(os.Getenv("WORKFLOW_MASTER_KEY_PREVIOUS"), salt)
+	if err != nil {
+		return fmt.Errorf("invalid WORKFLOW_MASTER_KEY_PREVIOUS: %w", err)
+	}
+	previousMasterKey = previous
+
+	legacyMasterKeys = nil
+	for _, key := range [][]byte{legacy, previousLegacy} {
+		if key != nil {
+			legacyMasterKeys = append(legacyMasterKeys, key)
+		}
+	}
+	return nil
+}
+
+// loadMasterKeySalt returns the salt for deriving master keys from passphrases,
+// creating it on first start. It is shared by all instances of the service.
+func loadMasterKeySalt() ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	settings := database.Collection("settings")
+	var doc struct {
+		Salt []byte `bson:"salt"`
+	}
+	err := settings.FindOne(ctx, bson.M{"_id": "masterKeySalt"}).Decode(&doc)
+	if err == nil {
+		return doc.Salt, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("failed to load master key salt: %w", err)
+	}
+
+	salt := make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("failed to generate master key salt: %w", err)
+	}
+	if _, err := settings.InsertOne(ctx, bson.M{"_id": "masterKeySalt", "salt": salt}); err != nil {
+		// Another instance created it first
+		if mongo.IsDuplicateKeyError(err) {
+			return loadMasterKeySalt()
+		}
+		return nil, fmt.Errorf("failed to save master key salt: %w", err)
+	}
+	return salt, nil
+}
+
+// parseMasterKey accepts a base64 encoded 32 byte key, or derives one from a
+// passphrase with scrypt. For passphrases it also returns the legacy SHA-256
+// key, so that credentials encrypted with it can still be read.
+func parseMasterKey(value string, salt []byte) ([]byte, []byte, error) {
+	if value == "" {
+		return nil, nil, nil
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(value); err == nil && len(decoded) == 32 {
+		return decoded, nil, nil
+	}
+	if len(value) < 16 {
+		return nil, nil, errors.New("passphrase must be at least 16 characters")
+	}
+	key, err := scrypt.Key([]byte(value), salt, masterKeyScryptN, masterKeyScryptR, masterKeyScryptP, 32)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+	legacy := sha256.Sum256([]byte(value))
+	return key, legacy[:], nil
+}
+
+// masterKeyByID returns the master key with the given ID, or nil
+func masterKeyByID(id string) []byte {
+	for _, key := range append([][]byte{masterKey, previousMasterKey}, legacyMasterKeys...) {
+		if key != nil && masterKeyID(key) == id {
+			return key
+		}
+	}
+	return nil
+}
+
+// masterKeyID returns a short identifier of a key, stored next to the ciphertext
+func masterKeyID(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4])
+}
+
+// encryptCredentialData encrypts credential values with AES-256-GCM using the
+// current master key, with the credential name as additional data
+func encryptCredentialData(name string, data map[string]interface{}) (string, string, error) {
+	if masterKey == nil {
+		return "", "", ErrCredentialStoreDisabled
+	}
+
+	plaintext, err := json.Marshal(data)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to marshal credential data: %w", err)
+	}
+
+	gcm, err := newGCM(masterKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", "", fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	sealed := gcm.Seal(nonce, nonce, plaintext, []byte(name))
+	return base64.StdEncoding.EncodeToString(sealed), masterKeyID(masterKey), nil
+}
+
+// decryptCredentialData decrypts credential values with whichever master key encrypted them
+func decryptCredentialData(doc CredentialDocument) (map[string]interface{}, error) {
+	if masterKey == nil {
+		return nil, ErrCredentialStoreDisabled
+	}
+
+	key := masterKeyByID(doc.KeyID)
+	if key == nil {
+		return nil, fmt.Errorf("credential %s was encrypted with an unknown master key", doc.Name)
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(doc.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode credential %s: %w", doc.Name, err)
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return nil, fmt.Errorf("credential %s is corrupt", doc.Name)
+	}
+
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	// Data encrypted before version 2 is not bound to the name
+	var additionalData []byte
+	if doc.Version >= credentialDataVersion {
+		additionalData = []byte(doc.Name)
+	}
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt credential %s: %w", doc.Name, err)
+	}
+
+	var data map[string]interface{}
+	if err := json.Unmarshal(plaintext, &data); err != nil {
+		return nil, fmt.Errorf("failed to parse credential %s: %w", doc.Name, err)
+	}
+	return data, nil
+}
+
+// newGCM creates an AES-GCM cipher for the given key
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCM: %w", err)
+	}
+	return gcm, nil
+}
+
+// validateCredentialInput checks the name, type and required fields of a credential
+func validateCredentialInput(input CredentialInput) error {
+	if !credentialNameRegex.MatchString(input.Name) {
+		return errors.New("invalid credential name: must start with a letter and contain only letters, digits, '_' or '-'")
+	}
+
+	required, ok := credentialTypes[input.Type]
+	if !ok {
+		return fmt.Errorf("invalid credential type: %s", input.Type)
+	}
+
+	for _, field := range required {
+		if value, ok := input.Data[field]; !ok || value == nil || value == "" {
+			return fmt.Errorf("invalid credential data: %s credentials require '%s'", input.Type, field)
+		}
+	}
+	return nil
+}
+
+// credentialFields returns the sorted field names of credential data
+func credentialFields(data map[string]interface{}) []string {
+	fields := make([]string, 0, len(data))
+	for field := range data {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// toCredentialInfo strips the encrypted data from a credential document
+func toCredentialInfo(doc CredentialDocument) CredentialInfo {
+	fields := doc.Fields
+	if fields == nil {
+		fields = []string{}
+	}
+	return CredentialInfo{
+		Name:        doc.Name,
+		Type:        doc.Type,
+		Description: doc.Description,
+		Fields:      fields,
+		CreatedAt:   doc.CreatedAt,
+		UpdatedAt:   doc.UpdatedAt,
+	}
+}
+
+// SaveCredentialToDB encrypts and stores a credential. When create is true the
+// credential must not exist yet; otherwise it must exist and its values are replaced.
+func SaveCredentialToDB(input CredentialInput, create bool) (CredentialInfo, error) {
+	info, err := saveCredential(input, create)
+	if err == nil {
+		credentialChanged(input.Name)
+	}
+	return info, err
+}
+
+// credentialChanged drops tokens and sessions obtained with the old values of a credential
+func credentialChanged(name string) {
+	invalidateOAuth2Token(name)
+	invalidateSAPSessions(name)
+	invalidateRateLimiter(name)
+	invalidateSQLPool(name)
+	invalidateMongoClient(name)
+	invalidateHTTPTransports(name)
+}
+
+// saveCredential stores a credential without touching cached tokens or sessions
+func saveCredential(input CredentialInput, create bool) (CredentialInfo, error) {
+	if err := validateCredentialInput(input); err != nil {
+		return CredentialInfo{}, err
+	}
+
+	encrypted, keyID, err := encryptCredentialData(input.Name, input.Data)
+	if err != nil {
+		return CredentialInfo{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	doc := CredentialDocument{
+		Name:        input.Name,
+		Type:        input.Type,
+		Description: input.Description,
+		Fields:      credentialFields(input.Data),
+		KeyID:       keyID,
+		Version:     credentialDataVersion,
+		Data:        encrypted,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	if create {
+		if _, err := credentialsCollection.InsertOne(ctx, doc); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return CredentialInfo{}, fmt.Errorf("credential %s already exists", input.Name)
+			}
+			return CredentialInfo{}, fmt.Errorf("failed to save credential: %w", err)
+		}
+		return toCredentialInfo(doc), nil
+	}
+
+	update := bson.M{"$set": bson.M{
+		"type":        doc.Type,
+		"description": doc.Description,
+		"fields":      doc.Fields,
+		"keyID":       doc.KeyID,
+		"version":     doc.Version,
+		"data":        doc.Data,
+		"updatedAt":   now,
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var updated CredentialDocument
+	if err := credentialsCollection.FindOneAndUpdate(ctx, bson.M{"_id": input.Name}, update, opts).Decode(&updated); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return CredentialInfo{}, ErrCredentialNotFound
+		}
+		return CredentialInfo{}, fmt.Errorf("failed to update credential: %w", err)
+	}
+
+	return toCredentialInfo(updated), nil
+}
+
+// getCredentialDocument loads the stored form of a credential
+func getCredentialDocument(name string) (CredentialDocument, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var doc CredentialDocument
+	if err := credentialsCollection.FindOne(ctx, bson.M{"_id": name}).Decode(&doc); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return doc, ErrCredentialNotFound
+		}
+		return doc, fmt.Errorf("failed to get credential: %w", err)
+	}
+	return doc, nil
+}
+
+// GetCredentialInfoFromDB returns the metadata of a credential
+func GetCredentialInfoFromDB(name string) (CredentialInfo, error) {
+	doc, err := getCredentialDocument(name)
+	if err != nil {
+		return CredentialInfo{}, err
+	}
+	return toCredentialInfo(doc), nil
+}
+
+// GetCredentialData returns the type and decrypted values of a credential, for use by the engine only
+func GetCredentialData(name string) (string, map[string]interface{}, error) {
+	doc, err := getCredentialDocument(name)
+	if err != nil {
+		return "", nil, err
+	}
+	data, err := decryptCredentialData(doc)
+	return doc.Type, data, err
+}
+
+// ListCredentialsFromDB returns the metadata of all credentials
+func ListCredentialsFromDB() ([]CredentialInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	opts := options.Find().SetProjection(bson.M{"data": 0}).SetSort(bson.D{{Key: "_id", Value: 1}})
+	cursor, err := credentialsCollection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []CredentialDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode credentials: %w", err)
+	}
+
+	infos := make([]CredentialInfo, 0, len(docs))
+	for _, doc := range docs {
+		infos = append(infos, toCredentialInfo(doc))
+	}
+	return infos, nil
+}
+
+// DeleteCredentialFromDB deletes a credential
+func DeleteCredentialFromDB(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := credentialsCollection.DeleteOne(ctx, bson.M{"_id": name})
+	if err != nil {
+		return fmt.Errorf("failed to delete credential: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrCredentialNotFound
+	}
+
+	credentialChanged(name)
+	return nil
+}
+
+// RotateCredentialMasterKey re-encrypts every credential with the current master key.
+// Run it after moving the old key to WORKFLOW_MASTER_KEY_PREVIOUS and setting a new one.
+// Credentials encrypted with a legacy key or an older data version are upgraded too.
+func RotateCredentialMasterKey() (int, error) {
+	if masterKey == nil {
+		return 0, ErrCredentialStoreDisabled
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	currentID := masterKeyID(masterKey)
+	filter := bson.M{"$or": bson.A{
+		bson.M{"keyID": bson.M{"$ne": currentID}},
+		bson.M{"version": bson.M{"$ne": credentialDataVersion}},
+	}}
+	cursor, err := credentialsCollection.Find(ctx, filter)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find credentials: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	rotated := 0
+	for cursor.Next(ctx) {
+		var doc CredentialDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return rotated, fmt.Errorf("failed to decode credential: %w", err)
+		}
+
+		data, err := decryptCredentialData(doc)
+		if err != nil {
+			return rotated, err
+		}
+		encrypted, keyID, err := encryptCredentialData(doc.Name, data)
+		if err != nil {
+			return rotated, err
+		}
+
+		update := bson.M{"$set": bson.M{"data": encrypted, "keyID": keyID, "version": credentialDataVersion}}
+		if _, err := credentialsCollection.UpdateByID(ctx, doc.Name, update); err != nil {
+			return rotated, fmt.Errorf("failed to update credential %s: %w", doc.Name, err)
+		}
+		rotated++
+	}
+
+	return rotated, cursor.Err()
+}
+
+// nodeCredentialParams returns the parameter objects of a node that name a
+// credential in their "credential" field: the parameters themselves and, for
+// a sqlQuery copy, the target database.
+func nodeCredentialParams(params map[string]interface{}) []map[string]interface{} {
+	holders := []map[string]interface{}{params}
+	if target, ok := params["target"].(map[string]interface{}); ok {
+		holders = append(holders, target)
+	}
+	return holders
+}
+
+// findNodeCredentials returns the names of the credentials a node uses, named
+// by its credential parameters or referenced in templates
+func findNodeCredentials(params map[string]interface{}, refs map[string]bool) {
+	findCredentialRefs(params, refs)
+	for _, holder := range nodeCredentialParams(params) {
+		if name, ok := holder["credential"].(string); ok && name != "" && !isTemplateString(name) {
+			refs[name] = true
+		}
+	}
+}
+
+// checkCredentialFields fails on a template reference that names no field or a
+// field the loaded credential does not have, which would otherwise resolve to ""
+func checkCredentialFields(value interface{}, credentials map[string]map[string]interface{}) error {
+	switch v := value.(type) {
+	case string:
+		for _, match := range credentialFieldRefRegex.FindAllStringSubmatch(v, -1) {
+			name, field := match[1], match[2]
+			if field == "" {
+				return fmt.Errorf("credential reference $credentials.%s must name a field, e.g. $credentials.%s.password", name, name)
+			}
+			data, ok := credentials[name]
+			if !ok {
+				continue
+			}
+			if _, ok := data[field]; !ok {
+				return fmt.Errorf("credential %s has no field %s", name, field)
+			}
+		}
+	case map[string]interface{}:
+		for _, val := range v {
+			if err := checkCredentialFields(val, credentials); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, val := range v {
+			if err := checkCredentialFields(val, credentials); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// findCredentialRefs returns the names of all credentials referenced in a value
+func findCredentialRefs(value interface{}, refs map[string]bool) {
+	switch v := value.(type) {
+	case string:
+		for _, match := range credentialRefRegex.FindAllStringSubmatch(v, -1) {
+			refs[match[1]] = true
+		}
+	case map[string]interface{}:
+		for _, val := range v {
+			findCredentialRefs(val, refs)
+		}
+	case []interface{}:
+		for _, val := range v {
+			findCredentialRefs(val, refs)
+		}
+	}
+}
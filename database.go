@@ -2,9 +2,11 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson"
@@ -20,13 +22,60 @@ var (
 
 // WorkflowDocument represents the structure of a workflow document in MongoDB
 type WorkflowDocument struct {
-	ID           string                 `bson:"_id"`
-	WorkflowID   string                 `bson:"workflowID"`
-	WorkflowData map[string]interface{} `bson:"workflowData"`
-	CreatedAt    time.Time              `bson:"createdAt"`
-	UpdatedAt    time.Time              `bson:"updatedAt"`
+	ID            string                 `bson:"_id"`
+	WorkflowID    string                 `bson:"workflowID"`
+	WorkflowData  map[string]interface{} `bson:"workflowData"`
+	Name          string                 `bson:"name"`
+	Description   string                 `bson:"description"`
+	Tags          []string               `bson:"tags"`
+	Active        bool                   `bson:"active"`
+	LastExecution *ExecutionSummary      `bson:"lastExecution,omitempty"`
+	CreatedAt     time.Time              `bson:"createdAt"`
+	UpdatedAt     time.Time              `bson:"updatedAt"`
 }
 
+// ExecutionSummary records the outcome of the most recent run of a workflow
+type ExecutionSummary struct {
+	Status     string    `bson:"status" json:"status"`
+	Error      string    `bson:"error,omitempty" json:"error,omitempty"`
+	StartedAt  time.Time `bson:"startedAt" json:"startedAt"`
+	FinishedAt time.Time `bson:"finishedAt" json:"finishedAt"`
+}
+
+// WorkflowSummary is the listing view of a workflow, without its nodes
+type WorkflowSummary struct {
+	WorkflowID    string            `bson:"workflowID" json:"workflowID"`
+	Name          string            `bson:"name" json:"name"`
+	Description   string            `bson:"description" json:"description"`
+	Tags          []string          `bson:"tags" json:"tags"`
+	Active        bool              `bson:"active" json:"active"`
+	LastExecution *ExecutionSummary `bson:"lastExecution,omitempty" json:"lastExecution"`
+	CreatedAt     time.Time         `bson:"createdAt" json:"createdAt"`
+	UpdatedAt     time.Time         `bson:"updatedAt" json:"updatedAt"`
+}
+
+// WorkflowListQuery holds the filters, sort order and page position for listing workflows
+type WorkflowListQuery struct {
+	Search string   // Free text matched against name and description
+	Tags   []string // Workflows must carry all of these tags
+	Active *bool    // Optional filter on the active flag
+	Sort   string   // Sort field, prefixed with "-" for descending order
+	Limit  int64    // Maximum number of workflows per page
+	Cursor string   // Opaque cursor returned by the previous page
+}
+
+// workflowSortFields lists the fields a workflow listing can be sorted by
+var workflowSortFields = map[string]bool{
+	"name":      true,
+	"createdAt": true,
+	"updatedAt": true,
+}
+
+const (
+	defaultWorkflowListLimit = 50
+	maxWorkflowListLimit     = 200
+)
+
 // InitMongoDB initializes the MongoDB connection
 func InitMongoDB() error {
 	// Get MongoDB connection string from environment
@@ -66,6 +115,11 @@ func InitMongoDB() error {
 		log.Printf("Warning: Failed to create indexes: %v", err)
 	}
 
+	// Populate listing metadata on workflows saved by older versions
+	if err := backfillWorkflowMetadata(); err != nil {
+		log.Printf("Warning: Failed to backfill workflow metadata: %v", err)
+	}
+
 	log.Println("Successfully connected to MongoDB")
 	return nil
 }
@@ -75,13 +129,28 @@ func createIndexes() error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	// Create index on workflowID for faster queries
-	indexModel := mongo.IndexModel{
-		Keys:    bson.D{{Key: "workflowID", Value: 1}},
-		Options: options.Index().SetUnique(true),
+	indexModels := []mongo.IndexModel{
+		// Create index on workflowID for faster queries
+		{
+			Keys:    bson.D{{Key: "workflowID", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		// Text index used by the listing search
+		{
+			Keys: bson.D{{Key: "name", Value: "text"}, {Key: "description", Value: "text"}},
+			Options: options.Index().
+				SetName("workflow_text").
+				SetWeights(bson.D{{Key: "name", Value: 5}, {Key: "description", Value: 1}}),
+		},
+		// Indexes backing tag filters and the sortable listing fields
+		{Keys: bson.D{{Key: "tags", Value: 1}}},
+		{Keys: bson.D{{Key: "active", Value: 1}}},
+		{Keys: bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "createdAt", Value: 1}, {Key: "_id", Value: 1}}},
+		{Keys: bson.D{{Key: "updatedAt", Value: 1}, {Key: "_id", Value: 1}}},
 	}
 
-	_, err := collection.Indexes().CreateOne(ctx, indexModel)
+	_, err := collection.Indexes().CreateMany(ctx, indexModels)
 	if err != nil {
 		return fmt.Errorf("failed to create index: %w", err)
 	}
@@ -89,6 +158,79 @@ func createIndexes() error {
 	return nil
 }
 
+// backfillWorkflowMetadata copies the name, description, tags and active flag
+// out of workflowData for documents saved before those fields were stored
+func backfillWorkflowMetadata() error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	cursor, err := collection.Find(ctx, bson.M{"name": bson.M{"$exists": false}})
+	if err != nil {
+		return fmt.Errorf("failed to find workflows to backfill: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var doc WorkflowDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return fmt.Errorf("failed to decode workflow: %w", err)
+		}
+
+		meta := extractWorkflowMetadata(doc.WorkflowData)
+		update := bson.M{"$set": bson.M{
+			"name":        meta.Name,
+			"description": meta.Description,
+			"tags":        meta.Tags,
+			"active":      meta.Active,
+		}}
+		if _, err := collection.UpdateByID(ctx, doc.ID, update); err != nil {
+			return fmt.Errorf("failed to backfill workflow %s: %w", doc.WorkflowID, err)
+		}
+	}
+
+	return cursor.Err()
+}
+
+// extractWorkflowMetadata reads the listing metadata from the "workflow" section of a workflow
+func extractWorkflowMetadata(workflowData map[string]interface{}) WorkflowSummary {
+	meta := WorkflowSummary{Tags: []string{}, Active: true}
+
+	info, ok := workflowData["workflow"].(map[string]interface{})
+	if !ok {
+		return meta
+	}
+
+	if name, ok := info["name"].(string); ok {
+		meta.Name = name
+	}
+	if description, ok := info["description"].(string); ok {
+		meta.Description = description
+	}
+	if active, ok := info["active"].(bool); ok {
+		meta.Active = active
+	}
+
+	// Tags may arrive as []interface{} (JSON) or primitive.A (BSON)
+	var rawTags []interface{}
+	switch t := info["tags"].(type) {
+	case []interface{}:
+		rawTags = t
+	case bson.A:
+		rawTags = t
+	case []string:
+		for _, tag := range t {
+			rawTags = append(rawTags, tag)
+		}
+	}
+	for _, tag := range rawTags {
+		if tagStr, ok := tag.(string); ok && strings.TrimSpace(tagStr) != "" {
+			meta.Tags = append(meta.Tags, strings.TrimSpace(tagStr))
+		}
+	}
+
+	return meta
+}
+
 // CloseMongoDB closes the MongoDB connection
 func CloseMongoDB() {
 	if mongoClient != nil {
@@ -110,13 +252,18 @@ func SaveWorkflowToDB(workflowID string, workflowData map[string]interface{}) er
 
 	now := time.Now()
 
-	// No need to create a separate document as the update object handles the data
+	// Keep the listing metadata alongside the raw workflow data
+	meta := extractWorkflowMetadata(workflowData)
 
 	// Use upsert to create or update
 	filter := bson.M{"workflowID": workflowID}
 	update := bson.M{
 		"$set": bson.M{
 			"workflowData": workflowData,
+			"name":         meta.Name,
+			"description":  meta.Description,
+			"tags":         meta.Tags,
+			"active":       meta.Active,
 			"updatedAt":    now,
 		},
 		"$setOnInsert": bson.M{
@@ -154,6 +301,43 @@ func GetWorkflowFromDB(workflowID string) (map[string]interface{}, error) {
 	return doc.WorkflowData, nil
 }
 
+// GetWorkflowDocumentsFromDB retrieves full workflow documents by ID, or all workflows when ids is empty
+func GetWorkflowDocumentsFromDB(workflowIDs []string) ([]WorkflowDocument, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	filter := bson.M{}
+	if len(workflowIDs) > 0 {
+		filter["workflowID"] = bson.M{"$in": workflowIDs}
+	}
+
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "workflowID", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflows: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []WorkflowDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("failed to decode workflows: %w", err)
+	}
+
+	return docs, nil
+}
+
+// WorkflowExistsInDB reports whether a workflow with the given ID is stored
+func WorkflowExistsInDB(workflowID string) (bool, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	count, err := collection.CountDocuments(ctx, bson.M{"workflowID": workflowID}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, fmt.Errorf("failed to check workflow: %w", err)
+	}
+
+	return count > 0, nil
+}
+
 // DeleteWorkflowFromDB deletes a workflow from MongoDB
 func DeleteWorkflowFromDB(workflowID string) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -202,3 +386,167 @@ func GetAllWorkflowIDsFromDB() ([]string, error) {
 
 	return workflowIDs, nil
 }
+
+// RecordWorkflowExecution stores the outcome of a workflow run as its last execution
+func RecordWorkflowExecution(workflowID string, summary ExecutionSummary) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"workflowID": workflowID}
+	update := bson.M{"$set": bson.M{"lastExecution": summary}}
+	if _, err := collection.UpdateOne(ctx, filter, update); err != nil {
+		return fmt.Errorf("failed to record workflow execution: %w", err)
+	}
+
+	return nil
+}
+
+// ListWorkflowsFromDB returns one page of workflow summaries matching the query,
+// along with the cursor for the next page ("" when there are no more results)
+func ListWorkflowsFromDB(query WorkflowListQuery) ([]WorkflowSummary, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Resolve the sort field and direction
+	sortField := "updatedAt"
+	sortDir := -1
+	if query.Sort != "" {
+		sortField = strings.TrimPrefix(query.Sort, "-")
+		sortDir = 1
+		if strings.HasPrefix(query.Sort, "-") {
+			sortDir = -1
+		}
+	}
+	if !workflowSortFields[sortField] {
+		return nil, "", fmt.Errorf("invalid sort field: %s", sortField)
+	}
+	sortKey := sortField
+	if sortDir < 0 {
+		sortKey = "-" + sortField
+	}
+
+	limit := query.Limit
+	if limit <= 0 {
+		limit = defaultWorkflowListLimit
+	}
+	if limit > maxWorkflowListLimit {
+		limit = maxWorkflowListLimit
+	}
+
+	// Build the filter from the query options
+	filter := bson.M{}
+	if query.Search != "" {
+		filter["$text"] = bson.M{"$search": query.Search}
+	}
+	if len(query.Tags) > 0 {
+		filter["tags"] = bson.M{"$all": query.Tags}
+	}
+	if query.Active != nil {
+		filter["active"] = *query.Active
+	}
+
+	// Continue after the last item of the previous page
+	if query.Cursor != "" {
+		cursorSort, sortValue, lastID, err := decodeListCursor(query.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		if cursorSort != sortKey {
+			return nil, "", fmt.Errorf("invalid cursor: it belongs to a listing sorted by %s, not %s", cursorSort, sortKey)
+		}
+		op := "$gt"
+		if sortDir < 0 {
+			op = "$lt"
+		}
+		filter["$or"] = bson.A{
+			bson.M{sortField: bson.M{op: sortValue}},
+			bson.M{sortField: sortValue, "_id": bson.M{op: lastID}},
+		}
+	}
+
+	// Fetch one extra document to know whether another page exists
+	opts := options.Find().
+		SetSort(bson.D{{Key: sortField, Value: sortDir}, {Key: "_id", Value: sortDir}}).
+		SetLimit(limit + 1).
+		SetProjection(bson.M{"workflowData": 0})
+
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list workflows: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var docs []WorkflowDocument
+	if err := cursor.All(ctx, &docs); err != nil {
+		return nil, "", fmt.Errorf("failed to decode workflows: %w", err)
+	}
+
+	nextCursor := ""
+	if int64(len(docs)) > limit {
+		docs = docs[:limit]
+		last := docs[len(docs)-1]
+		var sortValue interface{}
+		switch sortField {
+		case "name":
+			sortValue = last.Name
+		case "createdAt":
+			sortValue = last.CreatedAt
+		case "updatedAt":
+			sortValue = last.UpdatedAt
+		}
+		nextCursor, err = encodeListCursor(sortKey, sortValue, last.ID)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	summaries := make([]WorkflowSummary, 0, len(docs))
+	for _, doc := range docs {
+		tags := doc.Tags
+		if tags == nil {
+			tags = []string{}
+		}
+		summaries = append(summaries, WorkflowSummary{
+			WorkflowID:    doc.WorkflowID,
+			Name:          doc.Name,
+			Description:   doc.Description,
+			Tags:          tags,
+			Active:        doc.Active,
+			LastExecution: doc.LastExecution,
+			CreatedAt:     doc.CreatedAt,
+			UpdatedAt:     doc.UpdatedAt,
+		})
+	}
+
+	return summaries, nextCursor, nil
+}
+
+// encodeListCursor packs the sort order, and the sort value and ID of the last
+// listed workflow, into an opaque cursor.
+// BSON is used so that timestamps keep their type when the cursor is decoded.
+func encodeListCursor(sortKey string, sortValue interface{}, id string) (string, error) {
+	raw, err := bson.Marshal(bson.M{"s": sortKey, "v": sortValue, "id": id})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// decodeListCursor unpacks a cursor created by encodeListCursor
+func decodeListCursor(cursor string) (string, interface{}, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", nil, "", errors.New("invalid cursor")
+	}
+
+	var decoded struct {
+		Sort  string        `bson:"s"`
+		Value bson.RawValue `bson:"v"`
+		ID    string        `bson:"id"`
+	}
+	if err := bson.Unmarshal(raw, &decoded); err != nil || decoded.Sort == "" {
+		return "", nil, "", errors.New("invalid cursor")
+	}
+
+	return decoded.Sort, decoded.Value, decoded.ID, nil
+}
@@ -0,0 +1,379 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	// Time zones must resolve even on hosts without a zoneinfo database
+	_ "time/tzdata"
+)
+
+// Date functions accept times as RFC 3339 strings, as dates (2024-01-31), as
+// SQL style date times (2024-01-31 13:45:00) and as SAP's compact dates
+// (20240131). Values without a zone are taken as UTC unless a function is
+// given a time zone. Functions that return a time pass it on to the next
+// function in the pipeline; at the end of the pipeline it becomes an RFC 3339
+// string.
+//
+//   - formatDate:layout[,zone] formats a time in a layout, in zone when given
+//   - parseDate:layout[,zone] parses a value written in a layout
+//   - toTimezone:zone converts a time to a zone, e.g. Asia/Jerusalem
+//   - addDuration:amount / subtractDuration:amount, e.g. 90m, 7d, 1M, 1y2M
+//   - startOfDay, endOfDay, startOfMonth, endOfMonth, with an optional zone
+//   - isBefore:other, isAfter:other, isSameDay:other[,zone] compare with a time, now or today
+//   - dateDiff:other[,unit] is the time until other in seconds, minutes, hours or days (default)
+//   - toUnix, toUnixMs and fromUnix[:ms] convert to and from Unix timestamps
+//
+// Layouts are the names iso, date, datetime, time, rfc1123 and sap, Go reference
+// layouts (2006-01-02), or patterns such as DD/MM/YYYY HH:mm:ss. The
+// functions are registered in templateFunctions.go.
+
+// namedDateLayouts are the layouts that can be given by name
+var namedDateLayouts = map[string]string{
+	"iso":      time.RFC3339Nano,
+	"rfc3339":  time.RFC3339,
+	"date":     "2006-01-02",
+	"datetime": "2006-01-02 15:04:05",
+	"time":     "15:04:05",
+	"rfc1123":  time.RFC1123Z,
+	"sap":      "20060102",
+}
+
+// autoDateLayouts are tried in order when a value is parsed without a layout
+var autoDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+	"20060102",
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// datePatternTokens translates pattern tokens to Go layout elements, longest first
+var datePatternTokens = []struct{ token, layout string }{
+	{"YYYY", "2006"}, {"YY", "06"},
+	{"MMMM", "January"}, {"MMM", "Jan"}, {"MM", "01"}, {"M", "1"},
+	{"dddd", "Monday"}, {"ddd", "Mon"},
+	{"DD", "02"}, {"D", "2"},
+	{"HH", "15"}, {"hh", "03"}, {"h", "3"},
+	{"mm", "04"}, {"m", "4"},
+	{"ss", "05"}, {"s", "5"},
+	{"SSS", ".000"},
+	{"A", "PM"}, {"a", "pm"},
+	{"ZZ", "-0700"}, {"Z", "-07:00"},
+}
+
+var (
+	durationPartRegex = regexp.MustCompile(`([+-]?\d+(?:\.\d+)?)\s*(ms|s|m|h|d|w|M|y)`)
+
+	// Loaded time zones by name
+	timeZones = struct {
+		sync.Mutex
+		byName map[string]*time.Location
+	}{byName: make(map[string]*time.Location)}
+)
+
+// applyDateFunction applies one of the date functions to a value. Empty values
+// are passed on unchanged so that defaultIfEmpty can follow.
+func applyDateFunction(name string, value interface{}, args []string) (interface{}, error) {
+	if isEmptyValue(value) {
+		return value, nil
+	}
+	arg := func(i int) string {
+		if i < len(args) {
+			return args[i]
+		}
+		return ""
+	}
+
+	if name == "fromUnix" {
+		n, err := toNumber(value)
+		if err != nil {
+			return nil, fmt.Errorf("fromUnix: %w", err)
+		}
+		if arg(0) == "ms" {
+			return time.UnixMilli(int64(n)).UTC(), nil
+		}
+		sec, frac := math.Modf(n)
+		return time.Unix(int64(sec), int64(frac*1e9)).UTC(), nil
+	}
+
+	if name == "parseDate" {
+		if arg(0) == "" {
+			return nil, fmt.Errorf("parseDate requires a layout argument")
+		}
+		loc, err := loadTimeZone(arg(1))
+		if err != nil {
+			return nil, err
+		}
+		t, err := time.ParseInLocation(dateLayout(arg(0)), strings.TrimSpace(fmt.Sprintf("%v", value)), loc)
+		if err != nil {
+			return nil, fmt.Errorf("parseDate: %w", err)
+		}
+		return t, nil
+	}
+
+	t, err := parseDateValue(value, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	switch name {
+	case "formatDate":
+		if arg(0) == "" {
+			return nil, fmt.Errorf("formatDate requires a layout argument")
+		}
+		if arg(1) != "" {
+			loc, err := loadTimeZone(arg(1))
+			if err != nil {
+				return nil, err
+			}
+			t = t.In(loc)
+		}
+		return t.Format(dateLayout(arg(0))), nil
+	case "toTimezone":
+		loc, err := loadTimeZone(arg(0))
+		if err != nil {
+			return nil, err
+		}
+		return t.In(loc), nil
+	case "addDuration", "subtractDuration":
+		return addDateDuration(t, arg(0), name == "subtractDuration")
+	case "startOfDay", "endOfDay", "startOfMonth", "endOfMonth":
+		loc, err := loadTimeZone(arg(0))
+		if err != nil {
+			return nil, err
+		}
+		if arg(0) != "" {
+			t = t.In(loc)
+		}
+		return dateBoundary(t, name), nil
+	case "isBefore", "isAfter", "isSameDay", "dateDiff":
+		other, err := parseDateValue(arg(0), t.Location())
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid argument: %w", name, err)
+		}
+		return compareDates(name, t, other, arg(1))
+	case "toUnix":
+		return t.Unix(), nil
+	case "toUnixMs":
+		return t.UnixMilli(), nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownFunction, name)
+}
+
+// parseDateValue reads a time from a value. Strings without a zone are taken
+// in loc (UTC when nil); "now" and "today" give the current time and day.
+func parseDateValue(value interface{}, loc *time.Location) (time.Time, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case nil:
+		return time.Time{}, fmt.Errorf("no date given")
+	}
+
+	s := strings.TrimSpace(fmt.Sprintf("%v", value))
+	switch strings.ToLower(s) {
+	case "now":
+		return time.Now().In(loc), nil
+	case "today":
+		return dateBoundary(time.Now().In(loc), "startOfDay"), nil
+	}
+	for _, layout := range autoDateLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized date %q", s)
+}
+
+// dateLayout returns the Go layout for a layout name, pattern or Go layout
+func dateLayout(layout string) string {
+	if named, ok := namedDateLayouts[strings.ToLower(layout)]; ok {
+		return named
+	}
+	if strings.Contains(layout, "2006") || strings.Contains(layout, "15:04") {
+		return layout
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(layout); {
+		matched := false
+		for _, t := range datePatternTokens {
+			if strings.HasPrefix(layout[i:], t.token) {
+				// SSS follows a separator that the Go layout already includes
+				if t.token == "SSS" && strings.HasSuffix(b.String(), ".") {
+					b.WriteString("000")
+				} else {
+					b.WriteString(t.layout)
+				}
+				i += len(t.token)
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			b.WriteByte(layout[i])
+			i++
+		}
+	}
+	return b.String()
+}
+
+// loadTimeZone returns a time zone by IANA name; an empty name is UTC
+func loadTimeZone(name string) (*time.Location, error) {
+	if name == "" || strings.EqualFold(name, "UTC") {
+		return time.UTC, nil
+	}
+
+	timeZones.Lock()
+	defer timeZones.Unlock()
+	if loc, ok := timeZones.byName[name]; ok {
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %q", name)
+	}
+	timeZones.byName[name] = loc
+	return loc, nil
+}
+
+// addDateDuration adds an amount such as "90m", "7d" or "1y2M" to a time.
+// Days, weeks, months and years follow the calendar of the time's zone.
+func addDateDuration(t time.Time, amount string, subtract bool) (time.Time, error) {
+	parts := durationPartRegex.FindAllStringSubmatch(amount, -1)
+	if len(parts) == 0 || len(strings.Join(strings.Fields(durationPartRegex.ReplaceAllString(amount, "")), "")) > 0 {
+		return t, fmt.Errorf("invalid duration %q: expected amounts such as 30m, 7d or 1M", amount)
+	}
+
+	sign := 1.0
+	if subtract {
+		sign = -1
+	}
+	for _, part := range parts {
+		n, _ := strconv.ParseFloat(part[1], 64)
+		switch part[2] {
+		case "d", "w", "M", "y":
+			// Calendar units have no fixed length to take a fraction of
+			if n != math.Trunc(n) {
+				return t, fmt.Errorf("invalid duration %q: %s needs a whole number", amount, part[2])
+			}
+		}
+		n *= sign
+		switch part[2] {
+		case "y":
+			t = addMonths(t, int(n)*12)
+		case "M":
+			t = addMonths(t, int(n))
+		case "w":
+			t = t.AddDate(0, 0, int(n)*7)
+		case "d":
+			t = t.AddDate(0, 0, int(n))
+		case "h":
+			t = t.Add(time.Duration(n * float64(time.Hour)))
+		case "m":
+			t = t.Add(time.Duration(n * float64(time.Minute)))
+		case "s":
+			t = t.Add(time.Duration(n * float64(time.Second)))
+		case "ms":
+			t = t.Add(time.Duration(n * float64(time.Millisecond)))
+		}
+	}
+	return t, nil
+}
+
+// addMonths adds months to a time, keeping the day within the target month
+// (January 31 plus one month is the last day of February).
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, minute, sec := t.Clock()
+	first := time.Date(year, month+time.Month(months), 1, hour, minute, sec, t.Nanosecond(), t.Location())
+	if last := dateBoundary(first, "endOfMonth").Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// dateBoundary returns the start or end of the day or month of a time
+func dateBoundary(t time.Time, boundary string) time.Time {
+	year, month, day := t.Date()
+	switch boundary {
+	case "startOfDay":
+		return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+	case "endOfDay":
+		return time.Date(year, month, day+1, 0, 0, 0, 0, t.Location()).Add(-time.Nanosecond)
+	case "startOfMonth":
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(year, month+1, 1, 0, 0, 0, 0, t.Location()).Add(-time.Nanosecond)
+	}
+}
+
+// compareDates implements isBefore, isAfter, isSameDay and dateDiff
+func compareDates(name string, t, other time.Time, option string) (interface{}, error) {
+	switch name {
+	case "isBefore":
+		return t.Before(other), nil
+	case "isAfter":
+		return t.After(other), nil
+	case "isSameDay":
+		loc, err := loadTimeZone(option)
+		if err != nil {
+			return nil, err
+		}
+		if option == "" {
+			loc = t.Location()
+		}
+		y1, m1, d1 := t.In(loc).Date()
+		y2, m2, d2 := other.In(loc).Date()
+		return y1 == y2 && m1 == m2 && d1 == d2, nil
+	}
+
+	diff := other.Sub(t)
+	switch option {
+	case "seconds":
+		return math.Trunc(diff.Seconds()), nil
+	case "minutes":
+		return math.Trunc(diff.Minutes()), nil
+	case "hours":
+		return math.Trunc(diff.Hours()), nil
+	case "", "days":
+		return math.Trunc(diff.Hours() / 24), nil
+	}
+	return nil, fmt.Errorf("invalid dateDiff unit %q: expected seconds, minutes, hours or days", option)
+}
+
+// dateConditionsMatch reports whether any of the dateTime conditions of an if
+// node holds. Each compares value1 with value2 (a time, now or today) using
+// before, after or sameDay. Every condition is checked, so that a misspelled
+// operation fails the node even when another condition matches.
+func (we *WorkflowEngine) dateConditionsMatch(raw interface{}) (bool, error) {
+	conditions, _ := raw.([]interface{})
+	matched := false
+	for i, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		operation, _ := condition["operation"].(string)
+		value1 := we.resolveTemplateRaw(condition["value1"])
+		value2 := we.resolveTemplateRaw(condition["value2"])
+		result, err := compareValues(value1, operation, value2)
+		if err != nil {
+			return false, fmt.Errorf("dateTime condition %d: %w", i+1, err)
+		}
+		matched = matched || result
+	}
+	return matched, nil
+}
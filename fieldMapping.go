@@ -0,0 +1,393 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var (
+	fieldMappingsCollection *mongo.Collection
+
+	// ErrFieldMappingNotFound is returned when a field mapping (or version) does not exist
+	ErrFieldMappingNotFound = errors.New("field mapping not found")
+)
+
+// FieldMapping is a reusable set of rules that turns source items into target
+// records. Every save stores a new version; workflows use the latest version
+// unless their map node pins one.
+type FieldMapping struct {
+	Name        string             `bson:"name" json:"name"`
+	Version     int                `bson:"version" json:"version"`
+	Description string             `bson:"description" json:"description"`
+	Fields      []FieldMappingRule `bson:"fields" json:"fields"`
+	CreatedAt   time.Time          `bson:"createdAt" json:"createdAt"`
+}
+
+// FieldMappingRule fills one target field. The value is read from source (a
+// path in the item) or computed from value (a template that can use $item),
+// passed through the transform pipeline (template functions such as
+// "countryToAlpha3 | truncate:3"), and replaced by default when it ends up empty.
+type FieldMappingRule struct {
+	Target    string      `bson:"target" json:"target"`
+	Source    string      `bson:"source,omitempty" json:"source,omitempty"`
+	Value     interface{} `bson:"value,omitempty" json:"value,omitempty"`
+	Transform string      `bson:"transform,omitempty" json:"transform,omitempty"`
+	Default   interface{} `bson:"default,omitempty" json:"default,omitempty"`
+	Required  bool        `bson:"required,omitempty" json:"required,omitempty"`
+}
+
+// FieldMappingInfo is the listing view of a field mapping
+type FieldMappingInfo struct {
+	Name        string    `bson:"name" json:"name"`
+	Version     int       `bson:"version" json:"version"`
+	Description string    `bson:"description" json:"description"`
+	FieldCount  int       `bson:"fieldCount" json:"fieldCount"`
+	CreatedAt   time.Time `bson:"createdAt" json:"createdAt"`
+}
+
+// InitFieldMappingStore prepares the field mappings collection
+func InitFieldMappingStore() error {
+	fieldMappingsCollection = database.Collection("field_mappings")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := fieldMappingsCollection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "name", Value: 1}, {Key: "version", Value: -1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create field mapping index: %w", err)
+	}
+	return nil
+}
+
+// validateFieldMapping returns every problem found in a field mapping
+func validateFieldMapping(mapping FieldMapping) []string {
+	var problems []string
+	if !credentialNameRegex.MatchString(mapping.Name) {
+		problems = append(problems, "name must start with a letter and contain only letters, digits, '_' or '-'")
+	}
+	if len(mapping.Fields) == 0 {
+		problems = append(problems, "at least one field is required")
+	}
+
+	targets := make(map[string]bool)
+	for i, rule := range mapping.Fields {
+		prefix := fmt.Sprintf("field %d", i+1)
+		if rule.Target == "" {
+			problems = append(problems, prefix+": target is required")
+		} else {
+			prefix += " (" + rule.Target + ")"
+			if targets[rule.Target] {
+				problems = append(problems, prefix+": target is mapped more than once")
+			}
+			targets[rule.Target] = true
+		}
+		if (rule.Source == "") == (rule.Value == nil) {
+			problems = append(problems, prefix+": exactly one of source and value is required")
+		}
+		for _, call := range splitPipeline(rule.Transform) {
+			// Unknown functions and missing arguments are caught before the mapping is used
+			if _, _, err := checkTemplateFunctionCall(call); err != nil {
+				problems = append(problems, prefix+": "+err.Error())
+			}
+		}
+	}
+	return problems
+}
+
+// splitPipeline splits a transform pipeline into its function calls
+func splitPipeline(pipeline string) []string {
+	var calls []string
+	for _, call := range splitTopLevel(pipeline, '|') {
+		if call = strings.TrimSpace(call); call != "" {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// newDetachedEngine returns an engine that only resolves templates, for use
+// outside of a workflow run
+func newDetachedEngine() *WorkflowEngine {
+	return &WorkflowEngine{
+		workflow: &Workflow{},
+		context: &ExecutionContext{
+			NodeResults:     make(map[string]map[string]interface{}),
+			Config:          make(map[string]interface{}),
+			Credentials:     make(map[string]map[string]interface{}),
+			CredentialTypes: make(map[string]string),
+		},
+	}
+}
+
+// applyFieldMapping maps one item into a new record
+func (we *WorkflowEngine) applyFieldMapping(fields []FieldMappingRule, item interface{}) (map[string]interface{}, error) {
+	record := make(map[string]interface{})
+	for _, rule := range fields {
+		var value interface{}
+		if rule.Source != "" {
+			value = lookupPath(item, rule.Source)
+		} else {
+			value = we.resolveForItem(rule.Value, item)
+		}
+
+		if calls := splitPipeline(rule.Transform); len(calls) > 0 && !isEmptyValue(value) {
+			we.context.Item = item
+			transformed, err := we.applyTemplateFunctions(value, calls)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", rule.Target, err)
+			}
+			value = transformed
+		}
+
+		if isEmptyValue(value) && rule.Default != nil {
+			value = rule.Default
+		}
+		if rule.Required && isEmptyValue(value) {
+			return nil, fmt.Errorf("%s: required value is empty", rule.Target)
+		}
+		setPath(record, rule.Target, value)
+	}
+	return record, nil
+}
+
+// executeMap applies a field mapping to the items of sourceArray. The mapping
+// is a stored mapping name (with an optional version) or inline fields. Items
+// that fail are reported in errors when onError is "skip"; by default the node
+// fails. A single source object also gives the mapped record in record.
+func (we *WorkflowEngine) executeMap(node *Node) error {
+	fields, version, err := we.mapNodeFields(node.Parameters)
+	if err != nil {
+		return err
+	}
+	onError, _ := node.Parameters["onError"].(string)
+	if onError != "" && onError != "fail" && onError != "skip" {
+		return fmt.Errorf("invalid onError %q: expected fail or skip", onError)
+	}
+
+	items, single, err := we.transformInput(node.Parameters["sourceArray"])
+	if err != nil {
+		return err
+	}
+
+	output := []interface{}{}
+	failures := []map[string]interface{}{}
+	for i, item := range items {
+		record, err := we.applyFieldMapping(fields, item)
+		if err != nil {
+			if onError != "skip" {
+				we.context.Item = nil
+				return fmt.Errorf("item %d: %w", i, err)
+			}
+			failures = append(failures, map[string]interface{}{"index": i, "error": err.Error()})
+			continue
+		}
+		output = append(output, record)
+	}
+	we.context.Item = nil
+
+	result := map[string]interface{}{
+		"output":  output,
+		"count":   len(output),
+		"errors":  failures,
+		"version": version,
+	}
+	if single && len(output) == 1 {
+		result["record"] = output[0]
+	}
+	we.context.NodeResults[node.ID] = result
+	return nil
+}
+
+// mapNodeFields returns the rules of a map node and the version they come from
+// (0 for inline fields).
+func (we *WorkflowEngine) mapNodeFields(params map[string]interface{}) ([]FieldMappingRule, int, error) {
+	if inline, ok := params["fields"]; ok {
+		var mapping FieldMapping
+		if err := remarshal(inline, &mapping.Fields); err != nil {
+			return nil, 0, fmt.Errorf("invalid fields: %w", err)
+		}
+		mapping.Name = "inline"
+		if problems := validateFieldMapping(mapping); len(problems) > 0 {
+			return nil, 0, fmt.Errorf("invalid fields: %s", strings.Join(problems, "; "))
+		}
+		return mapping.Fields, 0, nil
+	}
+
+	name, _ := we.resolveTemplateValue(params["mapping"]).(string)
+	if name == "" {
+		return nil, 0, fmt.Errorf("either 'mapping' or 'fields' is required")
+	}
+	version := 0
+	if v, err := toNumber(params["version"]); err == nil {
+		version = int(v)
+	}
+	mapping, err := GetFieldMappingFromDB(name, version)
+	if err != nil {
+		return nil, 0, fmt.Errorf("field mapping %s: %w", name, err)
+	}
+	return mapping.Fields, mapping.Version, nil
+}
+
+// remarshal converts a decoded JSON value into a typed value
+func remarshal(value interface{}, target interface{}) error {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, target)
+}
+
+// PreviewFieldMapping validates a mapping and applies it to sample items
+func PreviewFieldMapping(mapping FieldMapping, samples []interface{}) ([]string, []interface{}) {
+	problems := validateFieldMapping(mapping)
+	if len(problems) > 0 {
+		return problems, nil
+	}
+
+	we := newDetachedEngine()
+	results := make([]interface{}, 0, len(samples))
+	for _, sample := range samples {
+		record, err := we.applyFieldMapping(mapping.Fields, sample)
+		if err != nil {
+			results = append(results, map[string]interface{}{"error": err.Error()})
+			continue
+		}
+		results = append(results, record)
+	}
+	return []string{}, results
+}
+
+// SaveFieldMappingToDB stores a new version of a field mapping. When create is
+// true the mapping must not exist yet; otherwise it must exist.
+func SaveFieldMappingToDB(mapping FieldMapping, create bool) (FieldMapping, error) {
+	if problems := validateFieldMapping(mapping); len(problems) > 0 {
+		return FieldMapping{}, fmt.Errorf("invalid field mapping: %s", strings.Join(problems, "; "))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	latest, err := GetFieldMappingFromDB(mapping.Name, 0)
+	switch {
+	case create && err == nil:
+		return FieldMapping{}, fmt.Errorf("field mapping %s already exists", mapping.Name)
+	case create && errors.Is(err, ErrFieldMappingNotFound):
+	case err != nil:
+		return FieldMapping{}, err
+	}
+
+	mapping.Version = latest.Version + 1
+	mapping.CreatedAt = time.Now()
+	if _, err := fieldMappingsCollection.InsertOne(ctx, mapping); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return FieldMapping{}, fmt.Errorf("field mapping %s was changed concurrently, retry the save", mapping.Name)
+		}
+		return FieldMapping{}, fmt.Errorf("failed to save field mapping: %w", err)
+	}
+	return mapping, nil
+}
+
+// GetFieldMappingFromDB returns a version of a field mapping, or the latest when version is 0
+func GetFieldMappingFromDB(name string, version int) (FieldMapping, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	filter := bson.M{"name": name}
+	if version > 0 {
+		filter["version"] = version
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}})
+
+	var mapping FieldMapping
+	if err := fieldMappingsCollection.FindOne(ctx, filter, opts).Decode(&mapping); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return mapping, ErrFieldMappingNotFound
+		}
+		return mapping, fmt.Errorf("failed to get field mapping: %w", err)
+	}
+	return mapping, nil
+}
+
+// ListFieldMappingsFromDB returns the latest version of every field mapping
+func ListFieldMappingsFromDB() ([]FieldMappingInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$sort", Value: bson.D{{Key: "name", Value: 1}, {Key: "version", Value: -1}}}},
+		{{Key: "$group", Value: bson.D{
+			{Key: "_id", Value: "$name"},
+			{Key: "name", Value: bson.M{"$first": "$name"}},
+			{Key: "version", Value: bson.M{"$first": "$version"}},
+			{Key: "description", Value: bson.M{"$first": "$description"}},
+			{Key: "fieldCount", Value: bson.M{"$first": bson.M{"$size": "$fields"}}},
+			{Key: "createdAt", Value: bson.M{"$first": "$createdAt"}},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "name", Value: 1}}}},
+	}
+	return findFieldMappingInfos(ctx, pipeline)
+}
+
+// ListFieldMappingVersionsFromDB returns every version of a field mapping, newest first
+func ListFieldMappingVersionsFromDB(name string) ([]FieldMappingInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"name": name}}},
+		{{Key: "$sort", Value: bson.D{{Key: "version", Value: -1}}}},
+		{{Key: "$project", Value: bson.M{
+			"name":        1,
+			"version":     1,
+			"description": 1,
+			"fieldCount":  bson.M{"$size": "$fields"},
+			"createdAt":   1,
+		}}},
+	}
+	infos, err := findFieldMappingInfos(ctx, pipeline)
+	if err == nil && len(infos) == 0 {
+		return nil, ErrFieldMappingNotFound
+	}
+	return infos, err
+}
+
+// findFieldMappingInfos runs a listing pipeline
+func findFieldMappingInfos(ctx context.Context, pipeline mongo.Pipeline) ([]FieldMappingInfo, error) {
+	cursor, err := fieldMappingsCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list field mappings: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	infos := []FieldMappingInfo{}
+	if err := cursor.All(ctx, &infos); err != nil {
+		return nil, fmt.Errorf("failed to decode field mappings: %w", err)
+	}
+	return infos, nil
+}
+
+// DeleteFieldMappingFromDB deletes every version of a field mapping
+func DeleteFieldMappingFromDB(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := fieldMappingsCollection.DeleteMany(ctx, bson.M{"name": name})
+	if err != nil {
+		return fmt.Errorf("failed to delete field mapping: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrFieldMappingNotFound
+	}
+	return nil
+}
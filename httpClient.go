@@ -0,0 +1,278 @@
+package main
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultHTTPTimeout bounds a single outbound HTTP call
+const defaultHTTPTimeout = 30 * time.Second
+
+// HTTPClientConfig holds the TLS and proxy settings of outbound calls. Values
+// come from the credential used by a node (caCert, clientCert, clientKey,
+// tlsMinVersion, tlsServerName, insecureSkipVerify, proxy) and may be
+// overridden by the node's "tls" and "proxy" parameters:
+//
+//	"tls": {"caCert": "-----BEGIN CERTIFICATE-----...", "minVersion": "1.2"},
+//	"proxy": "http://proxy.internal:3128"
+//
+// Certificates and keys are PEM encoded. proxy "none" disables the proxy that
+// HTTPS_PROXY/HTTP_PROXY would otherwise select.
+type HTTPClientConfig struct {
+	CACert             string `json:"caCert,omitempty"`
+	ClientCert         string `json:"clientCert,omitempty"`
+	ClientKey          string `json:"clientKey,omitempty"`
+	MinVersion         string `json:"minVersion,omitempty"`
+	ServerName         string `json:"serverName,omitempty"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify,omitempty"`
+	Proxy              string `json:"proxy,omitempty"`
+}
+
+// tlsVersions maps the accepted minVersion values
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// httpTransports shares one pooled transport per distinct configuration across
+// nodes and executions, so connections (and TLS sessions) are reused.
+var httpTransports = struct {
+	sync.Mutex
+	byConfig map[string]*sharedHTTPTransport
+}{byConfig: make(map[string]*sharedHTTPTransport)}
+
+// sharedHTTPTransport is a cached transport and the credentials whose settings
+// it was built from, so that it can be dropped when they change.
+type sharedHTTPTransport struct {
+	transport   *http.Transport
+	credentials map[string]bool
+	anonymous   bool // Also used by nodes without a credential
+}
+
+// allowInsecureTLS permits insecureSkipVerify; meant for development only
+var allowInsecureTLS = os.Getenv("WORKFLOW_ALLOW_INSECURE_TLS") == "true"
+
+// httpClientConfigFromCredential reads the TLS and proxy fields of credential data.
+func httpClientConfigFromCredential(data map[string]interface{}) HTTPClientConfig {
+	str := func(key string) string {
+		value, _ := data[key].(string)
+		return value
+	}
+	insecure, _ := toBoolean(data["insecureSkipVerify"])
+	return HTTPClientConfig{
+		CACert:             str("caCert"),
+		ClientCert:         str("clientCert"),
+		ClientKey:          str("clientKey"),
+		MinVersion:         str("tlsMinVersion"),
+		ServerName:         str("tlsServerName"),
+		InsecureSkipVerify: insecure,
+		Proxy:              str("proxy"),
+	}
+}
+
+// withNodeParams overrides the configuration with a node's "tls" and "proxy" parameters.
+func (c HTTPClientConfig) withNodeParams(params map[string]interface{}) HTTPClientConfig {
+	if proxy, ok := params["proxy"].(string); ok && proxy != "" {
+		c.Proxy = proxy
+	}
+	tlsParams, ok := params["tls"].(map[string]interface{})
+	if !ok {
+		return c
+	}
+	for key, target := range map[string]*string{
+		"caCert":     &c.CACert,
+		"clientCert": &c.ClientCert,
+		"clientKey":  &c.ClientKey,
+		"minVersion": &c.MinVersion,
+		"serverName": &c.ServerName,
+	} {
+		if value, ok := tlsParams[key].(string); ok && value != "" {
+			*target = value
+		}
+	}
+	if value, ok := tlsParams["insecureSkipVerify"]; ok {
+		c.InsecureSkipVerify, _ = toBoolean(value)
+	}
+	return c
+}
+
+// key identifies a configuration in the transport cache.
+func (c HTTPClientConfig) key() string {
+	data, _ := json.Marshal(c)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// newHTTPClient returns a client for the configuration that shares its transport
+// with every other client of the same configuration. credentialName is the
+// credential the configuration comes from, if any. All its connections are
+// subject to the outbound policy.
+func newHTTPClient(config HTTPClientConfig, credentialName string, timeout time.Duration) (*http.Client, error) {
+	transport, err := sharedTransport(config, credentialName)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: &policyTransport{base: transport}, Timeout: timeout}, nil
+}
+
+// sharedTransport returns the pooled transport of a configuration, creating it on first use.
+func sharedTransport(config HTTPClientConfig, credentialName string) (*http.Transport, error) {
+	key := config.key()
+
+	httpTransports.Lock()
+	defer httpTransports.Unlock()
+
+	shared, ok := httpTransports.byConfig[key]
+	if !ok {
+		transport, err := buildTransport(config)
+		if err != nil {
+			return nil, err
+		}
+		shared = &sharedHTTPTransport{transport: transport, credentials: make(map[string]bool)}
+		httpTransports.byConfig[key] = shared
+	}
+	if credentialName == "" {
+		shared.anonymous = true
+	} else {
+		shared.credentials[credentialName] = true
+	}
+	return shared.transport, nil
+}
+
+// invalidateHTTPTransports drops the transports built from the settings of a
+// credential, unless other credentials or nodes without one use them too. Their
+// idle connections are closed; requests in flight finish on them.
+func invalidateHTTPTransports(credentialName string) {
+	httpTransports.Lock()
+	defer httpTransports.Unlock()
+
+	for key, shared := range httpTransports.byConfig {
+		if !shared.credentials[credentialName] {
+			continue
+		}
+		delete(shared.credentials, credentialName)
+		if len(shared.credentials) == 0 && !shared.anonymous {
+			delete(httpTransports.byConfig, key)
+			shared.transport.CloseIdleConnections()
+		}
+	}
+}
+
+// buildTransport creates a tuned transport for a configuration.
+func buildTransport(config HTTPClientConfig) (*http.Transport, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: config.ServerName}
+
+	if config.MinVersion != "" {
+		version, ok := tlsVersions[strings.TrimPrefix(config.MinVersion, "TLS")]
+		if !ok {
+			return nil, fmt.Errorf("invalid TLS minVersion: %s", config.MinVersion)
+		}
+		tlsConfig.MinVersion = version
+	}
+
+	if config.CACert != "" || globalCABundle != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if globalCABundle != nil && !pool.AppendCertsFromPEM(globalCABundle) {
+			return nil, fmt.Errorf("WORKFLOW_CA_FILE contains no PEM certificates")
+		}
+		if config.CACert != "" && !pool.AppendCertsFromPEM([]byte(config.CACert)) {
+			return nil, fmt.Errorf("invalid caCert: no PEM certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCert != "" || config.ClientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(config.ClientCert), []byte(config.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if config.InsecureSkipVerify {
+		if !allowInsecureTLS {
+			return nil, fmt.Errorf("insecureSkipVerify requires WORKFLOW_ALLOW_INSECURE_TLS=true")
+		}
+		log.Printf("Warning: TLS certificate verification is disabled for an outbound connection")
+		tlsConfig.InsecureSkipVerify = true
+	}
+
+	proxy := http.ProxyFromEnvironment
+	switch config.Proxy {
+	case "":
+	case "none":
+		proxy = nil
+	default:
+		proxyURL, err := url.Parse(config.Proxy)
+		if err != nil || proxyURL.Host == "" {
+			return nil, fmt.Errorf("invalid proxy URL")
+		}
+		proxy = http.ProxyURL(proxyURL)
+	}
+
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	return &http.Transport{
+		Proxy:                 proxy,
+		DialContext:           policyDialContext(dialer),
+		TLSClientConfig:       tlsConfig,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          200,
+		MaxIdleConnsPerHost:   32,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}, nil
+}
+
+// globalCABundle holds extra trusted CAs read from WORKFLOW_CA_FILE, e.g. an internal CA
+var globalCABundle = loadGlobalCABundle()
+
+// loadGlobalCABundle reads WORKFLOW_CA_FILE when it is set.
+func loadGlobalCABundle() []byte {
+	path := os.Getenv("WORKFLOW_CA_FILE")
+	if path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Printf("Warning: failed to read WORKFLOW_CA_FILE: %v", err)
+		return nil
+	}
+	return data
+}
+
+// CloseHTTPTransports closes the idle connections of all shared transports; called on shutdown.
+func CloseHTTPTransports() {
+	httpTransports.Lock()
+	defer httpTransports.Unlock()
+	for _, shared := range httpTransports.byConfig {
+		shared.transport.CloseIdleConnections()
+	}
+}
+
+// httpClient returns the client for a node's calls, combining the TLS and proxy
+// settings of its credential with those of the node.
+func (we *WorkflowEngine) httpClient(params map[string]interface{}, credentialName string) (*http.Client, error) {
+	var config HTTPClientConfig
+	if credentialName != "" {
+		config = httpClientConfigFromCredential(we.context.Credentials[credentialName])
+	}
+	return newHTTPClient(config.withNodeParams(params), credentialName, defaultHTTPTimeout)
+}
@@ -0,0 +1,299 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// appendQueryParams adds the "query" parameter of an HTTP node to a URL. Values
+// are URL encoded, arrays become repeated parameters and nil values are skipped.
+func appendQueryParams(target string, params map[string]interface{}) string {
+	names := keys(params)
+	sort.Strings(names)
+
+	var parts []string
+	for _, name := range names {
+		values, ok := params[name].([]interface{})
+		if !ok {
+			values = []interface{}{params[name]}
+		}
+		for _, value := range values {
+			if value == nil {
+				continue
+			}
+			parts = append(parts, escapeODataQueryValue(name)+"="+escapeODataQueryValue(formatParamValue(value)))
+		}
+	}
+	return appendQuery(target, strings.Join(parts, "&"))
+}
+
+// formatParamValue renders a query or form value without exponent notation for numbers.
+func formatParamValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case map[string]interface{}, []interface{}:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(data)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// buildHTTPBody encodes the "body" parameter of an HTTP node and returns the
+// matching Content-Type. Body types:
+//   - json (default): the body is marshaled as JSON
+//   - form:      an object sent as application/x-www-form-urlencoded
+//   - multipart: an object sent as multipart/form-data; values that are objects
+//     with a "filename" become file parts, with "content" given as text or, with
+//     "encoding": "base64", as base64 (e.g. a binary response of another node)
+//   - raw:       a string sent as is, with the node's contentType (text/plain by default)
+func buildHTTPBody(bodyType string, bodyData interface{}, contentType string) ([]byte, string, error) {
+	switch bodyType {
+	case "", "json":
+		body, err := json.Marshal(bodyData)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		return body, "application/json", nil
+
+	case "form":
+		fields, ok := bodyData.(map[string]interface{})
+		if !ok {
+			return nil, "", fmt.Errorf("form body must be an object")
+		}
+		form := url.Values{}
+		for name, value := range fields {
+			values, ok := value.([]interface{})
+			if !ok {
+				values = []interface{}{value}
+			}
+			for _, item := range values {
+				if item != nil {
+					form.Add(name, formatParamValue(item))
+				}
+			}
+		}
+		return []byte(form.Encode()), "application/x-www-form-urlencoded", nil
+
+	case "multipart":
+		fields, ok := bodyData.(map[string]interface{})
+		if !ok {
+			return nil, "", fmt.Errorf("multipart body must be an object")
+		}
+		return buildMultipartBody(fields)
+
+	case "raw":
+		if contentType == "" {
+			contentType = "text/plain; charset=utf-8"
+		}
+		if text, ok := bodyData.(string); ok {
+			return []byte(text), contentType, nil
+		}
+		return []byte(formatParamValue(bodyData)), contentType, nil
+
+	default:
+		return nil, "", fmt.Errorf("unsupported bodyType: %s", bodyType)
+	}
+}
+
+// buildMultipartBody writes form fields and file parts in a stable order.
+func buildMultipartBody(fields map[string]interface{}) ([]byte, string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	names := keys(fields)
+	sort.Strings(names)
+	for _, name := range names {
+		file, isFile := fields[name].(map[string]interface{})
+		if isFile {
+			_, isFile = file["filename"]
+		}
+
+		if !isFile {
+			if err := writer.WriteField(name, formatParamValue(fields[name])); err != nil {
+				return nil, "", fmt.Errorf("failed to write multipart field %s: %w", name, err)
+			}
+			continue
+		}
+
+		filename := fmt.Sprintf("%v", file["filename"])
+		content := fmt.Sprintf("%v", file["content"])
+		data := []byte(content)
+		if encoding, _ := file["encoding"].(string); encoding == "base64" {
+			decoded, err := base64.StdEncoding.DecodeString(content)
+			if err != nil {
+				return nil, "", fmt.Errorf("invalid base64 content for multipart file %s: %w", name, err)
+			}
+			data = decoded
+		}
+		partType, _ := file["contentType"].(string)
+		if partType == "" {
+			partType = "application/octet-stream"
+		}
+
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+			escapeMultipartQuotes(name), escapeMultipartQuotes(filename)))
+		header.Set("Content-Type", partType)
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to write multipart file %s: %w", name, err)
+		}
+		if _, err := part.Write(data); err != nil {
+			return nil, "", fmt.Errorf("failed to write multipart file %s: %w", name, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("failed to finish multipart body: %w", err)
+	}
+	return buf.Bytes(), writer.FormDataContentType(), nil
+}
+
+// escapeMultipartQuotes escapes a name for a Content-Disposition header.
+func escapeMultipartQuotes(s string) string {
+	return strings.NewReplacer("\\", "\\\\", `"`, "\\\"").Replace(s)
+}
+
+// headerValue returns a header of a node's header map, matching the name case-insensitively.
+func headerValue(headers map[string]interface{}, name string) (string, bool) {
+	for key, value := range headers {
+		if strings.EqualFold(key, name) {
+			return fmt.Sprintf("%v", value), true
+		}
+	}
+	return "", false
+}
+
+// buildHTTPResponseResult builds a node result according to the node's
+// responseFormat:
+//   - auto (default): JSON bodies are parsed, anything else is kept as text
+//   - json:   the body must be JSON
+//   - text:   the body is kept as text
+//   - xml:    the body is parsed into "xml" (see parseXMLToMap)
+//   - binary: the body is returned base64 encoded with its size and contentType
+func buildHTTPResponseResult(format string, statusCode int, headers http.Header, respBody []byte) (map[string]interface{}, error) {
+	switch format {
+	case "", "auto":
+		return buildHTTPResult(statusCode, headers, respBody), nil
+	case "json":
+		result := buildHTTPResult(statusCode, headers, respBody)
+		if _, ok := result["json"]; !ok && statusCode < 400 {
+			return result, fmt.Errorf("response is not valid JSON")
+		}
+		return result, nil
+	}
+
+	result := map[string]interface{}{
+		"httpStatusCode": statusCode,
+		"headers":        headers,
+	}
+	switch format {
+	case "text":
+		result["body"] = string(respBody)
+	case "xml":
+		result["body"] = string(respBody)
+		parsed, err := parseXMLToMap(respBody)
+		if err != nil {
+			if statusCode < 400 {
+				return result, fmt.Errorf("response is not valid XML: %w", err)
+			}
+			break
+		}
+		result["xml"] = parsed
+	case "binary":
+		result["body"] = base64.StdEncoding.EncodeToString(respBody)
+		result["binary"] = true
+		result["size"] = len(respBody)
+		result["contentType"] = headers.Get("Content-Type")
+	default:
+		return nil, fmt.Errorf("unsupported responseFormat: %s", format)
+	}
+	return result, nil
+}
+
+// parseXMLToMap converts an XML document into maps keyed by element name.
+// Attributes become "@name" keys, repeated elements arrays, and elements with
+// only text their text; text next to child elements is kept under "#text".
+func parseXMLToMap(data []byte) (map[string]interface{}, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("no root element")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			value, err := parseXMLElement(decoder, start)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{start.Name.Local: value}, nil
+		}
+	}
+}
+
+// parseXMLElement reads the content of an element up to its end tag.
+func parseXMLElement(decoder *xml.Decoder, start xml.StartElement) (interface{}, error) {
+	node := make(map[string]interface{})
+	for _, attr := range start.Attr {
+		node["@"+attr.Name.Local] = attr.Value
+	}
+
+	var text strings.Builder
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			child, err := parseXMLElement(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+			name := t.Name.Local
+			switch existing := node[name].(type) {
+			case nil:
+				node[name] = child
+			case []interface{}:
+				node[name] = append(existing, child)
+			default:
+				node[name] = []interface{}{existing, child}
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			content := strings.TrimSpace(text.String())
+			if len(node) == 0 {
+				return content, nil
+			}
+			if content != "" {
+				node["#text"] = content
+			}
+			return node, nil
+		}
+	}
+}
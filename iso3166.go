@@ -0,0 +1,417 @@
+package main
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// isoCountry is an ISO 3166-1 country with the names it is commonly written as.
+type isoCountry struct {
+	alpha2     string
+	alpha3     string
+	numeric    string // Three digits; empty for codes outside the standard
+	name       string // Common English name
+	hebrew     string
+	alternates []string // Official, former and local names
+}
+
+// isoSubdivision is an ISO 3166-2 subdivision.
+type isoSubdivision struct {
+	code       string // Country alpha-2 code, a dash and the subdivision code
+	name       string
+	parent     string // Full code of the enclosing subdivision, if any
+	alternates []string
+}
+
+// isoCountries lists every ISO 3166-1 country, plus Kosovo (XK), which is
+// widely used although it is not part of the standard.
+var isoCountries = []isoCountry{
+	{"AF", "AFG", "004", "Afghanistan", "אפגניסטן", nil},
+	{"AX", "ALA", "248", "Åland Islands", "איי אולנד", []string{"Aland"}},
+	{"AL", "ALB", "008", "Albania", "אלבניה", []string{"Shqipëria"}},
+	{"DZ", "DZA", "012", "Algeria", "אלג'יריה", nil},
+	{"AS", "ASM", "016", "American Samoa", "סמואה האמריקנית", nil},
+	{"AD", "AND", "020", "Andorra", "אנדורה", nil},
+	{"AO", "AGO", "024", "Angola", "אנגולה", nil},
+	{"AI", "AIA", "660", "Anguilla", "אנגווילה", nil},
+	{"AQ", "ATA", "010", "Antarctica", "אנטארקטיקה", nil},
+	{"AG", "ATG", "028", "Antigua and Barbuda", "אנטיגואה וברבודה", nil},
+	{"AR", "ARG", "032", "Argentina", "ארגנטינה", nil},
+	{"AM", "ARM", "051", "Armenia", "ארמניה", nil},
+	{"AW", "ABW", "533", "Aruba", "ארובה", nil},
+	{"AU", "AUS", "036", "Australia", "אוסטרליה", nil},
+	{"AT", "AUT", "040", "Austria", "אוסטריה", []string{"Österreich"}},
+	{"AZ", "AZE", "031", "Azerbaijan", "אזרבייג'ן", nil},
+	{"BS", "BHS", "044", "Bahamas", "איי בהאמה", []string{"בהאמה"}},
+	{"BH", "BHR", "048", "Bahrain", "בחריין", nil},
+	{"BD", "BGD", "050", "Bangladesh", "בנגלדש", nil},
+	{"BB", "BRB", "052", "Barbados", "ברבדוס", nil},
+	{"BY", "BLR", "112", "Belarus", "בלארוס", []string{"Byelorussia", "בלרוס"}},
+	{"BE", "BEL", "056", "Belgium", "בלגיה", []string{"België", "Belgique"}},
+	{"BZ", "BLZ", "084", "Belize", "בליז", nil},
+	{"BJ", "BEN", "204", "Benin", "בנין", nil},
+	{"BM", "BMU", "060", "Bermuda", "ברמודה", nil},
+	{"BT", "BTN", "064", "Bhutan", "בהוטן", nil},
+	{"BO", "BOL", "068", "Bolivia", "בוליביה", []string{"Bolivia (Plurinational State of)", "Plurinational State of Bolivia"}},
+	{"BQ", "BES", "535", "Bonaire, Sint Eustatius and Saba", "האיים הקריביים ההולנדיים", []string{"Caribbean Netherlands", "Bonaire"}},
+	{"BA", "BIH", "070", "Bosnia and Herzegovina", "בוסניה והרצגובינה", []string{"Bosnia"}},
+	{"BW", "BWA", "072", "Botswana", "בוטסואנה", nil},
+	{"BV", "BVT", "074", "Bouvet Island", "האי בובה", nil},
+	{"BR", "BRA", "076", "Brazil", "ברזיל", []string{"Brasil"}},
+	{"IO", "IOT", "086", "British Indian Ocean Territory", "הטריטוריה הבריטית באוקיינוס ההודי", nil},
+	{"BN", "BRN", "096", "Brunei", "ברוניי", []string{"Brunei Darussalam"}},
+	{"BG", "BGR", "100", "Bulgaria", "בולגריה", nil},
+	{"BF", "BFA", "854", "Burkina Faso", "בורקינה פאסו", nil},
+	{"BI", "BDI", "108", "Burundi", "בורונדי", nil},
+	{"CV", "CPV", "132", "Cabo Verde", "כף ורדה", []string{"Cape Verde"}},
+	{"KH", "KHM", "116", "Cambodia", "קמבודיה", nil},
+	{"CM", "CMR", "120", "Cameroon", "קמרון", nil},
+	{"CA", "CAN", "124", "Canada", "קנדה", nil},
+	{"KY", "CYM", "136", "Cayman Islands", "איי קיימן", nil},
+	{"CF", "CAF", "140", "Central African Republic", "הרפובליקה המרכז-אפריקאית", nil},
+	{"TD", "TCD", "148", "Chad", "צ'אד", nil},
+	{"CL", "CHL", "152", "Chile", "צ'ילה", nil},
+	{"CN", "CHN", "156", "China", "סין", []string{"People's Republic of China", "PRC"}},
+	{"CX", "CXR", "162", "Christmas Island", "אי חג המולד", nil},
+	{"CC", "CCK", "166", "Cocos (Keeling) Islands", "איי קוקוס", []string{"Cocos Islands", "Keeling Islands"}},
+	{"CO", "COL", "170", "Colombia", "קולומביה", nil},
+	{"KM", "COM", "174", "Comoros", "קומורו", nil},
+	{"CG", "COG", "178", "Congo", "הרפובליקה של קונגו", []string{"Republic of the Congo", "Congo-Brazzaville", "קונגו"}},
+	{"CD", "COD", "180", "Democratic Republic of the Congo", "הרפובליקה הדמוקרטית של קונגו", []string{"Congo, Democratic Republic of the", "Congo-Kinshasa", "DR Congo", "DRC", "Zaire"}},
+	{"CK", "COK", "184", "Cook Islands", "איי קוק", nil},
+	{"CR", "CRI", "188", "Costa Rica", "קוסטה ריקה", nil},
+	{"CI", "CIV", "384", "Côte d'Ivoire", "חוף השנהב", []string{"Ivory Coast"}},
+	{"HR", "HRV", "191", "Croatia", "קרואטיה", []string{"Hrvatska"}},
+	{"CU", "CUB", "192", "Cuba", "קובה", nil},
+	{"CW", "CUW", "531", "Curaçao", "קוראסאו", nil},
+	{"CY", "CYP", "196", "Cyprus", "קפריסין", nil},
+	{"CZ", "CZE", "203", "Czechia", "צ'כיה", []string{"Czech Republic", "Česko"}},
+	{"DK", "DNK", "208", "Denmark", "דנמרק", []string{"Danmark"}},
+	{"DJ", "DJI", "262", "Djibouti", "ג'יבוטי", nil},
+	{"DM", "DMA", "212", "Dominica", "דומיניקה", nil},
+	{"DO", "DOM", "214", "Dominican Republic", "הרפובליקה הדומיניקנית", nil},
+	{"EC", "ECU", "218", "Ecuador", "אקוודור", nil},
+	{"EG", "EGY", "818", "Egypt", "מצרים", nil},
+	{"SV", "SLV", "222", "El Salvador", "אל סלוודור", nil},
+	{"GQ", "GNQ", "226", "Equatorial Guinea", "גינאה המשוונית", nil},
+	{"ER", "ERI", "232", "Eritrea", "אריתריאה", nil},
+	{"EE", "EST", "233", "Estonia", "אסטוניה", []string{"Eesti"}},
+	{"SZ", "SWZ", "748", "Eswatini", "אסוואטיני", []string{"Swaziland", "סווזילנד"}},
+	{"ET", "ETH", "231", "Ethiopia", "אתיופיה", nil},
+	{"FK", "FLK", "238", "Falkland Islands", "איי פוקלנד", []string{"Falkland Islands (Malvinas)", "Malvinas"}},
+	{"FO", "FRO", "234", "Faroe Islands", "איי פארו", []string{"Faeroe Islands"}},
+	{"FJ", "FJI", "242", "Fiji", "פיג'י", nil},
+	{"FI", "FIN", "246", "Finland", "פינלנד", []string{"Suomi"}},
+	{"FR", "FRA", "250", "France", "צרפת", nil},
+	{"GF", "GUF", "254", "French Guiana", "גיאנה הצרפתית", nil},
+	{"PF", "PYF", "258", "French Polynesia", "פולינזיה הצרפתית", nil},
+	{"TF", "ATF", "260", "French Southern Territories", "הארצות הדרומיות והאנטארקטיות של צרפת", nil},
+	{"GA", "GAB", "266", "Gabon", "גבון", nil},
+	{"GM", "GMB", "270", "Gambia", "גמביה", nil},
+	{"GE", "GEO", "268", "Georgia", "גאורגיה", nil},
+	{"DE", "DEU", "276", "Germany", "גרמניה", []string{"Deutschland"}},
+	{"GH", "GHA", "288", "Ghana", "גאנה", nil},
+	{"GI", "GIB", "292", "Gibraltar", "גיברלטר", nil},
+	{"GR", "GRC", "300", "Greece", "יוון", []string{"Hellas", "Ellada"}},
+	{"GL", "GRL", "304", "Greenland", "גרינלנד", nil},
+	{"GD", "GRD", "308", "Grenada", "גרנדה", nil},
+	{"GP", "GLP", "312", "Guadeloupe", "גוואדלופ", nil},
+	{"GU", "GUM", "316", "Guam", "גואם", nil},
+	{"GT", "GTM", "320", "Guatemala", "גואטמלה", nil},
+	{"GG", "GGY", "831", "Guernsey", "גרנזי", nil},
+	{"GN", "GIN", "324", "Guinea", "גינאה", nil},
+	{"GW", "GNB", "624", "Guinea-Bissau", "גינאה ביסאו", nil},
+	{"GY", "GUY", "328", "Guyana", "גיאנה", nil},
+	{"HT", "HTI", "332", "Haiti", "האיטי", nil},
+	{"HM", "HMD", "334", "Heard Island and McDonald Islands", "האי הרד ואיי מקדונלד", nil},
+	{"VA", "VAT", "336", "Vatican City", "הוותיקן", []string{"Holy See", "Vatican"}},
+	{"HN", "HND", "340", "Honduras", "הונדורס", nil},
+	{"HK", "HKG", "344", "Hong Kong", "הונג קונג", nil},
+	{"HU", "HUN", "348", "Hungary", "הונגריה", []string{"Magyarország"}},
+	{"IS", "ISL", "352", "Iceland", "איסלנד", []string{"Ísland"}},
+	{"IN", "IND", "356", "India", "הודו", []string{"Bharat"}},
+	{"ID", "IDN", "360", "Indonesia", "אינדונזיה", nil},
+	{"IR", "IRN", "364", "Iran", "איראן", []string{"Iran (Islamic Republic of)", "Islamic Republic of Iran", "Persia"}},
+	{"IQ", "IRQ", "368", "Iraq", "עיראק", nil},
+	{"IE", "IRL", "372", "Ireland", "אירלנד", []string{"Republic of Ireland", "Éire"}},
+	{"IM", "IMN", "833", "Isle of Man", "האי מאן", nil},
+	{"IL", "ISR", "376", "Israel", "ישראל", []string{"State of Israel", "מדינת ישראל"}},
+	{"IT", "ITA", "380", "Italy", "איטליה", []string{"Italia"}},
+	{"JM", "JAM", "388", "Jamaica", "ג'מייקה", nil},
+	{"JP", "JPN", "392", "Japan", "יפן", []string{"Nippon"}},
+	{"JE", "JEY", "832", "Jersey", "ג'רזי", nil},
+	{"JO", "JOR", "400", "Jordan", "ירדן", nil},
+	{"KZ", "KAZ", "398", "Kazakhstan", "קזחסטן", nil},
+	{"KE", "KEN", "404", "Kenya", "קניה", nil},
+	{"KI", "KIR", "296", "Kiribati", "קיריבטי", nil},
+	{"KP", "PRK", "408", "North Korea", "קוריאה הצפונית", []string{"Korea (Democratic People's Republic of)", "Democratic People's Republic of Korea", "DPRK", "צפון קוריאה"}},
+	{"KR", "KOR", "410", "South Korea", "קוריאה הדרומית", []string{"Korea, Republic of", "Republic of Korea", "Korea", "דרום קוריאה"}},
+	{"KW", "KWT", "414", "Kuwait", "כווית", nil},
+	{"KG", "KGZ", "417", "Kyrgyzstan", "קירגיזסטן", nil},
+	{"LA", "LAO", "418", "Laos", "לאוס", []string{"Lao People's Democratic Republic"}},
+	{"LV", "LVA", "428", "Latvia", "לטביה", []string{"Latvija"}},
+	{"LB", "LBN", "422", "Lebanon", "לבנון", nil},
+	{"LS", "LSO", "426", "Lesotho", "לסוטו", nil},
+	{"LR", "LBR", "430", "Liberia", "ליבריה", nil},
+	{"LY", "LBY", "434", "Libya", "לוב", nil},
+	{"LI", "LIE", "438", "Liechtenstein", "ליכטנשטיין", nil},
+	{"LT", "LTU", "440", "Lithuania", "ליטא", []string{"Lietuva"}},
+	{"LU", "LUX", "442", "Luxembourg", "לוקסמבורג", nil},
+	{"MO", "MAC", "446", "Macao", "מקאו", []string{"Macau"}},
+	{"MG", "MDG", "450", "Madagascar", "מדגסקר", nil},
+	{"MW", "MWI", "454", "Malawi", "מלאווי", nil},
+	{"MY", "MYS", "458", "Malaysia", "מלזיה", nil},
+	{"MV", "MDV", "462", "Maldives", "האיים המלדיביים", []string{"מלדיביים"}},
+	{"ML", "MLI", "466", "Mali", "מאלי", nil},
+	{"MT", "MLT", "470", "Malta", "מלטה", nil},
+	{"MH", "MHL", "584", "Marshall Islands", "איי מרשל", nil},
+	{"MQ", "MTQ", "474", "Martinique", "מרטיניק", nil},
+	{"MR", "MRT", "478", "Mauritania", "מאוריטניה", nil},
+	{"MU", "MUS", "480", "Mauritius", "מאוריציוס", nil},
+	{"YT", "MYT", "175", "Mayotte", "מיוט", nil},
+	{"MX", "MEX", "484", "Mexico", "מקסיקו", []string{"México"}},
+	{"FM", "FSM", "583", "Micronesia", "מיקרונזיה", []string{"Micronesia (Federated States of)", "Federated States of Micronesia"}},
+	{"MD", "MDA", "498", "Moldova", "מולדובה", []string{"Moldova, Republic of", "Republic of Moldova"}},
+	{"MC", "MCO", "492", "Monaco", "מונקו", nil},
+	{"MN", "MNG", "496", "Mongolia", "מונגוליה", nil},
+	{"ME", "MNE", "499", "Montenegro", "מונטנגרו", nil},
+	{"MS", "MSR", "500", "Montserrat", "מונסראט", nil},
+	{"MA", "MAR", "504", "Morocco", "מרוקו", nil},
+	{"MZ", "MOZ", "508", "Mozambique", "מוזמביק", nil},
+	{"MM", "MMR", "104", "Myanmar", "מיאנמר", []string{"Burma", "בורמה"}},
+	{"NA", "NAM", "516", "Namibia", "נמיביה", nil},
+	{"NR", "NRU", "520", "Nauru", "נאורו", nil},
+	{"NP", "NPL", "524", "Nepal", "נפאל", nil},
+	{"NL", "NLD", "528", "Netherlands", "הולנד", []string{"Netherlands (Kingdom of the)", "Holland", "Nederland"}},
+	{"NC", "NCL", "540", "New Caledonia", "קלדוניה החדשה", nil},
+	{"NZ", "NZL", "554", "New Zealand", "ניו זילנד", []string{"Aotearoa"}},
+	{"NI", "NIC", "558", "Nicaragua", "ניקרגואה", nil},
+	{"NE", "NER", "562", "Niger", "ניז'ר", nil},
+	{"NG", "NGA", "566", "Nigeria", "ניגריה", nil},
+	{"NU", "NIU", "570", "Niue", "ניואה", nil},
+	{"NF", "NFK", "574", "Norfolk Island", "האי נורפוק", nil},
+	{"MK", "MKD", "807", "North Macedonia", "צפון מקדוניה", []string{"Macedonia", "מקדוניה"}},
+	{"MP", "MNP", "580", "Northern Mariana Islands", "איי מריאנה הצפוניים", nil},
+	{"NO", "NOR", "578", "Norway", "נורווגיה", []string{"Norge"}},
+	{"OM", "OMN", "512", "Oman", "עומאן", nil},
+	{"PK", "PAK", "586", "Pakistan", "פקיסטן", nil},
+	{"PW", "PLW", "585", "Palau", "פלאו", nil},
+	{"PS", "PSE", "275", "Palestine", "פלסטין", []string{"Palestine, State of", "State of Palestine", "Palestinian Territories", "הרשות הפלסטינית"}},
+	{"PA", "PAN", "591", "Panama", "פנמה", nil},
+	{"PG", "PNG", "598", "Papua New Guinea", "פפואה גינאה החדשה", nil},
+	{"PY", "PRY", "600", "Paraguay", "פרגוואי", nil},
+	{"PE", "PER", "604", "Peru", "פרו", nil},
+	{"PH", "PHL", "608", "Philippines", "הפיליפינים", []string{"פיליפינים"}},
+	{"PN", "PCN", "612", "Pitcairn", "איי פיטקרן", []string{"Pitcairn Islands"}},
+	{"PL", "POL", "616", "Poland", "פולין", []string{"Polska"}},
+	{"PT", "PRT", "620", "Portugal", "פורטוגל", nil},
+	{"PR", "PRI", "630", "Puerto Rico", "פוארטו ריקו", nil},
+	{"QA", "QAT", "634", "Qatar", "קטאר", []string{"קטר"}},
+	{"RE", "REU", "638", "Réunion", "ראוניון", nil},
+	{"RO", "ROU", "642", "Romania", "רומניה", nil},
+	{"RU", "RUS", "643", "Russia", "רוסיה", []string{"Russian Federation"}},
+	{"RW", "RWA", "646", "Rwanda", "רואנדה", nil},
+	{"BL", "BLM", "652", "Saint Barthélemy", "סן ברתלמי", []string{"St. Barts", "St Barths"}},
+	{"SH", "SHN", "654", "Saint Helena, Ascension and Tristan da Cunha", "סנט הלנה", []string{"Saint Helena", "St. Helena"}},
+	{"KN", "KNA", "659", "Saint Kitts and Nevis", "סנט קיטס ונוויס", []string{"St. Kitts and Nevis"}},
+	{"LC", "LCA", "662", "Saint Lucia", "סנט לוסיה", []string{"St. Lucia"}},
+	{"MF", "MAF", "663", "Saint Martin", "סן מרטן", []string{"Saint Martin (French part)", "St. Martin"}},
+	{"PM", "SPM", "666", "Saint Pierre and Miquelon", "סן פייר ומיקלון", []string{"St. Pierre and Miquelon"}},
+	{"VC", "VCT", "670", "Saint Vincent and the Grenadines", "סנט וינסנט והגרנדינים", []string{"St. Vincent and the Grenadines"}},
+	{"WS", "WSM", "882", "Samoa", "סמואה", nil},
+	{"SM", "SMR", "674", "San Marino", "סן מרינו", nil},
+	{"ST", "STP", "678", "Sao Tome and Principe", "סאו טומה ופרינסיפה", []string{"São Tomé and Príncipe"}},
+	{"SA", "SAU", "682", "Saudi Arabia", "ערב הסעודית", []string{"סעודיה"}},
+	{"SN", "SEN", "686", "Senegal", "סנגל", nil},
+	{"RS", "SRB", "688", "Serbia", "סרביה", []string{"Srbija"}},
+	{"SC", "SYC", "690", "Seychelles", "סיישל", nil},
+	{"SL", "SLE", "694", "Sierra Leone", "סיירה לאונה", nil},
+	{"SG", "SGP", "702", "Singapore", "סינגפור", nil},
+	{"SX", "SXM", "534", "Sint Maarten", "סינט מארטן", []string{"Sint Maarten (Dutch part)"}},
+	{"SK", "SVK", "703", "Slovakia", "סלובקיה", []string{"Slovak Republic", "Slovensko"}},
+	{"SI", "SVN", "705", "Slovenia", "סלובניה", []string{"Slovenija"}},
+	{"SB", "SLB", "090", "Solomon Islands", "איי שלמה", nil},
+	{"SO", "SOM", "706", "Somalia", "סומליה", nil},
+	{"ZA", "ZAF", "710", "South Africa", "דרום אפריקה", []string{"Republic of South Africa", "RSA"}},
+	{"GS", "SGS", "239", "South Georgia and the South Sandwich Islands", "איי ג'ורג'יה הדרומית ואיי סנדוויץ' הדרומיים", nil},
+	{"SS", "SSD", "728", "South Sudan", "דרום סודאן", nil},
+	{"ES", "ESP", "724", "Spain", "ספרד", []string{"España"}},
+	{"LK", "LKA", "144", "Sri Lanka", "סרי לנקה", nil},
+	{"SD", "SDN", "729", "Sudan", "סודאן", nil},
+	{"SR", "SUR", "740", "Suriname", "סורינאם", []string{"Surinam"}},
+	{"SJ", "SJM", "744", "Svalbard and Jan Mayen", "סבאלברד ויאן מאיין", nil},
+	{"SE", "SWE", "752", "Sweden", "שוודיה", []string{"Sverige"}},
+	{"CH", "CHE", "756", "Switzerland", "שווייץ", []string{"Schweiz", "Suisse", "Svizzera", "שוויץ"}},
+	{"SY", "SYR", "760", "Syria", "סוריה", []string{"Syrian Arab Republic"}},
+	{"TW", "TWN", "158", "Taiwan", "טאיוואן", []string{"Taiwan, Province of China", "Republic of China"}},
+	{"TJ", "TJK", "762", "Tajikistan", "טג'יקיסטן", nil},
+	{"TZ", "TZA", "834", "Tanzania", "טנזניה", []string{"Tanzania, United Republic of", "United Republic of Tanzania"}},
+	{"TH", "THA", "764", "Thailand", "תאילנד", nil},
+	{"TL", "TLS", "626", "Timor-Leste", "מזרח טימור", []string{"East Timor"}},
+	{"TG", "TGO", "768", "Togo", "טוגו", nil},
+	{"TK", "TKL", "772", "Tokelau", "טוקלאו", nil},
+	{"TO", "TON", "776", "Tonga", "טונגה", nil},
+	{"TT", "TTO", "780", "Trinidad and Tobago", "טרינידד וטובגו", nil},
+	{"TN", "TUN", "788", "Tunisia", "תוניסיה", nil},
+	{"TR", "TUR", "792", "Türkiye", "טורקיה", []string{"Turkey"}},
+	{"TM", "TKM", "795", "Turkmenistan", "טורקמניסטן", nil},
+	{"TC", "TCA", "796", "Turks and Caicos Islands", "איי טרקס וקייקוס", nil},
+	{"TV", "TUV", "798", "Tuvalu", "טובאלו", nil},
+	{"UG", "UGA", "800", "Uganda", "אוגנדה", nil},
+	{"UA", "UKR", "804", "Ukraine", "אוקראינה", nil},
+	{"AE", "ARE", "784", "United Arab Emirates", "איחוד האמירויות הערביות", []string{"UAE", "Emirates", "איחוד האמירויות"}},
+	{"GB", "GBR", "826", "United Kingdom", "בריטניה", []string{"United Kingdom of Great Britain and Northern Ireland", "UK", "Great Britain", "Britain", "הממלכה המאוחדת"}},
+	{"US", "USA", "840", "United States", "ארצות הברית", []string{"United States of America", "U.S.", "U.S.A.", "America", "ארה\"ב"}},
+	{"UM", "UMI", "581", "United States Minor Outlying Islands", "האיים המרוחקים הקטנים של ארצות הברית", nil},
+	{"UY", "URY", "858", "Uruguay", "אורוגוואי", nil},
+	{"UZ", "UZB", "860", "Uzbekistan", "אוזבקיסטן", nil},
+	{"VU", "VUT", "548", "Vanuatu", "ונואטו", nil},
+	{"VE", "VEN", "862", "Venezuela", "ונצואלה", []string{"Venezuela (Bolivarian Republic of)", "Bolivarian Republic of Venezuela"}},
+	{"VN", "VNM", "704", "Vietnam", "וייטנאם", []string{"Viet Nam"}},
+	{"VG", "VGB", "092", "British Virgin Islands", "איי הבתולה הבריטיים", []string{"Virgin Islands (British)"}},
+	{"VI", "VIR", "850", "U.S. Virgin Islands", "איי הבתולה של ארצות הברית", []string{"Virgin Islands (U.S.)", "United States Virgin Islands"}},
+	{"WF", "WLF", "876", "Wallis and Futuna", "ואליס ופוטונה", nil},
+	{"EH", "ESH", "732", "Western Sahara", "סהרה המערבית", nil},
+	{"YE", "YEM", "887", "Yemen", "תימן", nil},
+	{"ZM", "ZMB", "894", "Zambia", "זמביה", nil},
+	{"ZW", "ZWE", "716", "Zimbabwe", "זימבבואה", nil},
+	{"XK", "XKX", "", "Kosovo", "קוסובו", nil},
+}
+
+// Indexes built from isoCountries and isoSubdivisions, keyed by normalized name or code
+var (
+	isoCountryIndex     = make(map[string]*isoCountry)
+	isoSubdivisionIndex = make(map[string]*isoSubdivision) // Full codes, and "XX:name" per country
+)
+
+// regionNameReplacer folds the letters that do not decompose into a base letter and a
+// mark, and punctuation, so that names match however they are written
+var regionNameReplacer = strings.NewReplacer(
+	"ı", "i", "ł", "l", "ø", "o", "đ", "d", "ħ", "h", "ß", "ss", "æ", "ae", "œ", "oe",
+	"&", " and ", ".", "", "'", "", "’", "", "‘", "", "ʻ", "", "ʼ", "", "`", "", "\"", "", "״", "", "׳", "",
+	"-", " ", "_", " ", ",", " ", "(", " ", ")", " ", "/", " ",
+)
+
+func init() {
+	for i := range isoCountries {
+		country := &isoCountries[i]
+		for _, key := range append([]string{country.alpha2, country.alpha3, country.name, country.hebrew}, country.alternates...) {
+			isoCountryIndex[normalizeRegionName(key)] = country
+		}
+		if country.numeric != "" {
+			isoCountryIndex[country.numeric] = country
+		}
+	}
+
+	for i := range isoSubdivisions {
+		subdivision := &isoSubdivisions[i]
+		country, _, _ := strings.Cut(subdivision.code, "-")
+		isoSubdivisionIndex[normalizeRegionName(subdivision.code)] = subdivision
+		for _, name := range append([]string{subdivision.name}, subdivision.alternates...) {
+			if strings.HasPrefix(name, country+"-") {
+				// A code from an earlier edition of the standard
+				isoSubdivisionIndex[normalizeRegionName(name)] = subdivision
+			}
+			key := country + ":" + normalizeRegionName(name)
+			if existing, ok := isoSubdivisionIndex[key]; ok && (existing.parent == "" || subdivision.parent != "") {
+				// Names shared within a country, like a region and its capital
+				// district, go to the first top-level subdivision that has them
+				continue
+			}
+			isoSubdivisionIndex[key] = subdivision
+		}
+	}
+}
+
+// normalizeRegionName returns the form of a country or subdivision name used for matching
+func normalizeRegionName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if unicode.Is(unicode.Mn, r) {
+			return -1
+		}
+		return r
+	}, norm.NFD.String(strings.ToLower(strings.TrimSpace(name))))
+	name = regionNameReplacer.Replace(name)
+	name = strings.Join(strings.Fields(name), " ")
+	return strings.TrimPrefix(name, "the ")
+}
+
+// findCountry finds a country by alpha-2, alpha-3 or numeric code, or by any of its names.
+func findCountry(value string) *isoCountry {
+	key := normalizeRegionName(value)
+	if key == "" {
+		return nil
+	}
+	if len(key) < 3 && strings.Trim(key, "0123456789") == "" {
+		key = strings.Repeat("0", 3-len(key)) + key
+	}
+	return isoCountryIndex[key]
+}
+
+// findSubdivision finds a subdivision by its full code (US-CA), or by its own
+// code or name within a country given in any form findCountry accepts.
+func findSubdivision(value, country string) *isoSubdivision {
+	key := normalizeRegionName(value)
+	if key == "" {
+		return nil
+	}
+	if subdivision, ok := isoSubdivisionIndex[key]; ok {
+		return subdivision
+	}
+
+	c := findCountry(country)
+	if c == nil {
+		return nil
+	}
+	if subdivision, ok := isoSubdivisionIndex[normalizeRegionName(c.alpha2+"-"+key)]; ok {
+		return subdivision
+	}
+	return isoSubdivisionIndex[c.alpha2+":"+key]
+}
+
+// countryCode converts a country code or name to the given form: alpha2,
+// alpha3, numeric, name or hebrew. Unknown countries give fallback.
+func countryCode(value, form, fallback string) string {
+	country := findCountry(value)
+	if country == nil {
+		return fallback
+	}
+
+	var result string
+	switch form {
+	case "alpha2":
+		result = country.alpha2
+	case "alpha3":
+		result = country.alpha3
+	case "numeric":
+		result = country.numeric
+	case "hebrew":
+		result = country.hebrew
+	default:
+		result = country.name
+	}
+	if result == "" {
+		return fallback
+	}
+	return result
+}
+
+// subdivisionCode converts a subdivision name or code to its ISO 3166-2 code,
+// or returns fallback if it is not known.
+func subdivisionCode(value, country, fallback string) string {
+	if subdivision := findSubdivision(value, country); subdivision != nil {
+		return subdivision.code
+	}
+	return fallback
+}
+
+// subdivisionName converts a subdivision code or name to its name, or returns
+// fallback if it is not known.
+func subdivisionName(value, country, fallback string) string {
+	if subdivision := findSubdivision(value, country); subdivision != nil {
+		return subdivision.name
+	}
+	return fallback
+}
@@ -0,0 +1,5534 @@
+package main
+
+// isoSubdivisions lists every ISO 3166-2 subdivision, as published in the
+// iso-codes 4.15.0 data. Common English names replace the ISO names of the
+// countries our customers mostly deal with; the ISO names, translations and
+// codes replaced by newer editions are alternates.
+var isoSubdivisions = []isoSubdivision{
+	// Andorra
+	{"AD-02", "Canillo", "", nil},
+	{"AD-03", "Encamp", "", nil},
+	{"AD-04", "La Massana", "", nil},
+	{"AD-05", "Ordino", "", nil},
+	{"AD-06", "Sant Julià de Lòria", "", nil},
+	{"AD-07", "Andorra la Vella", "", nil},
+	{"AD-08", "Escaldes-Engordany", "", nil},
+
+	// United Arab Emirates
+	{"AE-AJ", "‘Ajmān", "", nil},
+	{"AE-AZ", "Abū Z̧aby", "", nil},
+	{"AE-DU", "Dubayy", "", nil},
+	{"AE-FU", "Al Fujayrah", "", nil},
+	{"AE-RK", "Ra’s al Khaymah", "", nil},
+	{"AE-SH", "Ash Shāriqah", "", nil},
+	{"AE-UQ", "Umm al Qaywayn", "", nil},
+
+	// Afghanistan
+	{"AF-BAL", "Balkh", "", nil},
+	{"AF-BAM", "Bāmyān", "", nil},
+	{"AF-BDG", "Bādghīs", "", nil},
+	{"AF-BDS", "Badakhshān", "", nil},
+	{"AF-BGL", "Baghlān", "", nil},
+	{"AF-DAY", "Dāykundī", "", nil},
+	{"AF-FRA", "Farāh", "", nil},
+	{"AF-FYB", "Fāryāb", "", nil},
+	{"AF-GHA", "Ghaznī", "", nil},
+	{"AF-GHO", "Ghōr", "", nil},
+	{"AF-HEL", "Helmand", "", nil},
+	{"AF-HER", "Herāt", "", nil},
+	{"AF-JOW", "Jowzjān", "", nil},
+	{"AF-KAB", "Kābul", "", nil},
+	{"AF-KAN", "Kandahār", "", nil},
+	{"AF-KAP", "Kāpīsā", "", nil},
+	{"AF-KDZ", "Kunduz", "", nil},
+	{"AF-KHO", "Khōst", "", nil},
+	{"AF-KNR", "Kunaṟ", "", nil},
+	{"AF-LAG", "Laghmān", "", nil},
+	{"AF-LOG", "Lōgar", "", nil},
+	{"AF-NAN", "Nangarhār", "", nil},
+	{"AF-NIM", "Nīmrōz", "", nil},
+	{"AF-NUR", "Nūristān", "", nil},
+	{"AF-PAN", "Panjshayr", "", nil},
+	{"AF-PAR", "Parwān", "", nil},
+	{"AF-PIA", "Paktiyā", "", nil},
+	{"AF-PKA", "Paktīkā", "", nil},
+	{"AF-SAM", "Samangān", "", nil},
+	{"AF-SAR", "Sar-e Pul", "", nil},
+	{"AF-TAK", "Takhār", "", nil},
+	{"AF-URU", "Uruzgān", "", nil},
+	{"AF-WAR", "Wardak", "", nil},
+	{"AF-ZAB", "Zābul", "", nil},
+
+	// Antigua and Barbuda
+	{"AG-03", "Saint George", "", nil},
+	{"AG-04", "Saint John", "", nil},
+	{"AG-05", "Saint Mary", "", nil},
+	{"AG-06", "Saint Paul", "", nil},
+	{"AG-07", "Saint Peter", "", nil},
+	{"AG-08", "Saint Philip", "", nil},
+	{"AG-10", "Barbuda", "", nil},
+	{"AG-11", "Redonda", "", nil},
+
+	// Albania
+	{"AL-01", "Berat", "", nil},
+	{"AL-02", "Durrës", "", nil},
+	{"AL-03", "Elbasan", "", nil},
+	{"AL-04", "Fier", "", nil},
+	{"AL-05", "Gjirokastër", "", nil},
+	{"AL-06", "Korçë", "", nil},
+	{"AL-07", "Kukës", "", nil},
+	{"AL-08", "Lezhë", "", nil},
+	{"AL-09", "Dibër", "", nil},
+	{"AL-10", "Shkodër", "", nil},
+	{"AL-11", "Tiranë", "", nil},
+	{"AL-12", "Vlorë", "", nil},
+
+	// Armenia
+	{"AM-AG", "Aragac̣otn", "", nil},
+	{"AM-AR", "Ararat", "", nil},
+	{"AM-AV", "Armavir", "", nil},
+	{"AM-ER", "Erevan", "", nil},
+	{"AM-GR", "Geġark'unik'", "", nil},
+	{"AM-KT", "Kotayk'", "", nil},
+	{"AM-LO", "Loṙi", "", nil},
+	{"AM-SH", "Širak", "", nil},
+	{"AM-SU", "Syunik'", "", nil},
+	{"AM-TV", "Tavuš", "", nil},
+	{"AM-VD", "Vayoć Jor", "", nil},
+
+	// Angola
+	{"AO-BGO", "Bengo", "", nil},
+	{"AO-BGU", "Benguela", "", nil},
+	{"AO-BIE", "Bié", "", nil},
+	{"AO-CAB", "Cabinda", "", nil},
+	{"AO-CCU", "Cuando Cubango", "", nil},
+	{"AO-CNN", "Cunene", "", nil},
+	{"AO-CNO", "Cuanza-Norte", "", nil},
+	{"AO-CUS", "Cuanza-Sul", "", nil},
+	{"AO-HUA", "Huambo", "", nil},
+	{"AO-HUI", "Huíla", "", nil},
+	{"AO-LNO", "Lunda-Norte", "", nil},
+	{"AO-LSU", "Lunda-Sul", "", nil},
+	{"AO-LUA", "Luanda", "", nil},
+	{"AO-MAL", "Malange", "", nil},
+	{"AO-MOX", "Moxico", "", nil},
+	{"AO-NAM", "Namibe", "", nil},
+	{"AO-UIG", "Uíge", "", nil},
+	{"AO-ZAI", "Zaire", "", nil},
+
+	// Argentina
+	{"AR-A", "Salta", "", nil},
+	{"AR-B", "Buenos Aires", "", nil},
+	{"AR-C", "Ciudad Autónoma de Buenos Aires", "", nil},
+	{"AR-D", "San Luis", "", nil},
+	{"AR-E", "Entre Ríos", "", nil},
+	{"AR-F", "La Rioja", "", nil},
+	{"AR-G", "Santiago del Estero", "", nil},
+	{"AR-H", "Chaco", "", nil},
+	{"AR-J", "San Juan", "", nil},
+	{"AR-K", "Catamarca", "", nil},
+	{"AR-L", "La Pampa", "", nil},
+	{"AR-M", "Mendoza", "", nil},
+	{"AR-N", "Misiones", "", nil},
+	{"AR-P", "Formosa", "", nil},
+	{"AR-Q", "Neuquén", "", nil},
+	{"AR-R", "Río Negro", "", nil},
+	{"AR-S", "Santa Fe", "", nil},
+	{"AR-T", "Tucumán", "", nil},
+	{"AR-U", "Chubut", "", nil},
+	{"AR-V", "Tierra del Fuego", "", nil},
+	{"AR-W", "Corrientes", "", nil},
+	{"AR-X", "Córdoba", "", nil},
+	{"AR-Y", "Jujuy", "", nil},
+	{"AR-Z", "Santa Cruz", "", nil},
+
+	// Austria
+	{"AT-1", "Burgenland", "", nil},
+	{"AT-2", "Kärnten", "", nil},
+	{"AT-3", "Niederösterreich", "", nil},
+	{"AT-4", "Oberösterreich", "", nil},
+	{"AT-5", "Salzburg", "", nil},
+	{"AT-6", "Steiermark", "", nil},
+	{"AT-7", "Tirol", "", nil},
+	{"AT-8", "Vorarlberg", "", nil},
+	{"AT-9", "Wien", "", nil},
+
+	// Australia
+	{"AU-ACT", "Australian Capital Territory", "", nil},
+	{"AU-NSW", "New South Wales", "", nil},
+	{"AU-NT", "Northern Territory", "", nil},
+	{"AU-QLD", "Queensland", "", nil},
+	{"AU-SA", "South Australia", "", nil},
+	{"AU-TAS", "Tasmania", "", nil},
+	{"AU-VIC", "Victoria", "", nil},
+	{"AU-WA", "Western Australia", "", nil},
+
+	// Azerbaijan
+	{"AZ-ABS", "Abşeron", "", nil},
+	{"AZ-AGA", "Ağstafa", "", nil},
+	{"AZ-AGC", "Ağcabədi", "", nil},
+	{"AZ-AGM", "Ağdam", "", nil},
+	{"AZ-AGS", "Ağdaş", "", nil},
+	{"AZ-AGU", "Ağsu", "", nil},
+	{"AZ-AST", "Astara", "", nil},
+	{"AZ-BA", "Bakı", "", nil},
+	{"AZ-BAB", "Babək", "AZ-NX", nil},
+	{"AZ-BAL", "Balakən", "", nil},
+	{"AZ-BAR", "Bərdə", "", nil},
+	{"AZ-BEY", "Beyləqan", "", nil},
+	{"AZ-BIL", "Biləsuvar", "", nil},
+	{"AZ-CAB", "Cəbrayıl", "", nil},
+	{"AZ-CAL", "Cəlilabad", "", nil},
+	{"AZ-CUL", "Culfa", "AZ-NX", nil},
+	{"AZ-DAS", "Daşkəsən", "", nil},
+	{"AZ-FUZ", "Füzuli", "", nil},
+	{"AZ-GA", "Gəncə", "", nil},
+	{"AZ-GAD", "Gədəbəy", "", nil},
+	{"AZ-GOR", "Goranboy", "", nil},
+	{"AZ-GOY", "Göyçay", "", nil},
+	{"AZ-GYG", "Göygöl", "", nil},
+	{"AZ-HAC", "Hacıqabul", "", nil},
+	{"AZ-IMI", "İmişli", "", nil},
+	{"AZ-ISM", "İsmayıllı", "", nil},
+	{"AZ-KAL", "Kəlbəcər", "", nil},
+	{"AZ-KAN", "Kǝngǝrli", "AZ-NX", nil},
+	{"AZ-KUR", "Kürdəmir", "", nil},
+	{"AZ-LA", "Lənkəran", "", nil},
+	{"AZ-LAC", "Laçın", "", nil},
+	{"AZ-LAN", "Lənkəran", "", nil},
+	{"AZ-LER", "Lerik", "", nil},
+	{"AZ-MAS", "Masallı", "", nil},
+	{"AZ-MI", "Mingəçevir", "", nil},
+	{"AZ-NA", "Naftalan", "", nil},
+	{"AZ-NEF", "Neftçala", "", nil},
+	{"AZ-NV", "Naxçıvan", "AZ-NX", nil},
+	{"AZ-NX", "Naxçıvan", "", nil},
+	{"AZ-OGU", "Oğuz", "", nil},
+	{"AZ-ORD", "Ordubad", "AZ-NX", nil},
+	{"AZ-QAB", "Qəbələ", "", nil},
+	{"AZ-QAX", "Qax", "", nil},
+	{"AZ-QAZ", "Qazax", "", nil},
+	{"AZ-QBA", "Quba", "", nil},
+	{"AZ-QBI", "Qubadlı", "", nil},
+	{"AZ-QOB", "Qobustan", "", nil},
+	{"AZ-QUS", "Qusar", "", nil},
+	{"AZ-SA", "Şəki", "", nil},
+	{"AZ-SAB", "Sabirabad", "", nil},
+	{"AZ-SAD", "Sədərək", "AZ-NX", nil},
+	{"AZ-SAH", "Şahbuz", "AZ-NX", nil},
+	{"AZ-SAK", "Şəki", "", nil},
+	{"AZ-SAL", "Salyan", "", nil},
+	{"AZ-SAR", "Şərur", "AZ-NX", nil},
+	{"AZ-SAT", "Saatlı", "", nil},
+	{"AZ-SBN", "Şabran", "", nil},
+	{"AZ-SIY", "Siyəzən", "", nil},
+	{"AZ-SKR", "Şəmkir", "", nil},
+	{"AZ-SM", "Sumqayıt", "", nil},
+	{"AZ-SMI", "Şamaxı", "", nil},
+	{"AZ-SMX", "Samux", "", nil},
+	{"AZ-SR", "Şirvan", "", nil},
+	{"AZ-SUS", "Şuşa", "", nil},
+	{"AZ-TAR", "Tərtər", "", nil},
+	{"AZ-TOV", "Tovuz", "", nil},
+	{"AZ-UCA", "Ucar", "", nil},
+	{"AZ-XA", "Xankəndi", "", nil},
+	{"AZ-XAC", "Xaçmaz", "", nil},
+	{"AZ-XCI", "Xocalı", "", nil},
+	{"AZ-XIZ", "Xızı", "", nil},
+	{"AZ-XVD", "Xocavənd", "", nil},
+	{"AZ-YAR", "Yardımlı", "", nil},
+	{"AZ-YE", "Yevlax", "", nil},
+	{"AZ-YEV", "Yevlax", "", nil},
+	{"AZ-ZAN", "Zəngilan", "", nil},
+	{"AZ-ZAQ", "Zaqatala", "", nil},
+	{"AZ-ZAR", "Zərdab", "", nil},
+
+	// Bosnia and Herzegovina
+	{"BA-BIH", "Federacija Bosne i Hercegovine", "", nil},
+	{"BA-BRC", "Brčko distrikt", "", nil},
+	{"BA-SRP", "Republika Srpska", "", nil},
+
+	// Barbados
+	{"BB-01", "Christ Church", "", nil},
+	{"BB-02", "Saint Andrew", "", nil},
+	{"BB-03", "Saint George", "", nil},
+	{"BB-04", "Saint James", "", nil},
+	{"BB-05", "Saint John", "", nil},
+	{"BB-06", "Saint Joseph", "", nil},
+	{"BB-07", "Saint Lucy", "", nil},
+	{"BB-08", "Saint Michael", "", nil},
+	{"BB-09", "Saint Peter", "", nil},
+	{"BB-10", "Saint Philip", "", nil},
+	{"BB-11", "Saint Thomas", "", nil},
+
+	// Bangladesh
+	{"BD-01", "Bandarban", "BD-B", nil},
+	{"BD-02", "Barguna", "BD-A", nil},
+	{"BD-03", "Bogura", "BD-E", nil},
+	{"BD-04", "Brahmanbaria", "BD-B", nil},
+	{"BD-05", "Bagerhat", "BD-D", nil},
+	{"BD-06", "Barishal", "BD-A", nil},
+	{"BD-07", "Bhola", "BD-A", nil},
+	{"BD-08", "Cumilla", "BD-B", nil},
+	{"BD-09", "Chandpur", "BD-B", nil},
+	{"BD-10", "Chattogram", "BD-B", nil},
+	{"BD-11", "Cox's Bazar", "BD-B", nil},
+	{"BD-12", "Chuadanga", "BD-D", nil},
+	{"BD-13", "Dhaka", "BD-C", nil},
+	{"BD-14", "Dinajpur", "BD-F", nil},
+	{"BD-15", "Faridpur", "BD-C", nil},
+	{"BD-16", "Feni", "BD-B", nil},
+	{"BD-17", "Gopalganj", "BD-C", nil},
+	{"BD-18", "Gazipur", "BD-C", nil},
+	{"BD-19", "Gaibandha", "BD-F", nil},
+	{"BD-20", "Habiganj", "BD-G", nil},
+	{"BD-21", "Jamalpur", "BD-H", nil},
+	{"BD-22", "Jashore", "BD-D", nil},
+	{"BD-23", "Jhenaidah", "BD-D", nil},
+	{"BD-24", "Joypurhat", "BD-E", nil},
+	{"BD-25", "Jhalakathi", "BD-A", nil},
+	{"BD-26", "Kishoreganj", "BD-C", nil},
+	{"BD-27", "Khulna", "BD-D", nil},
+	{"BD-28", "Kurigram", "BD-F", nil},
+	{"BD-29", "Khagrachhari", "BD-B", nil},
+	{"BD-30", "Kushtia", "BD-D", nil},
+	{"BD-31", "Lakshmipur", "BD-B", nil},
+	{"BD-32", "Lalmonirhat", "BD-F", nil},
+	{"BD-33", "Manikganj", "BD-C", nil},
+	{"BD-34", "Mymensingh", "BD-H", nil},
+	{"BD-35", "Munshiganj", "BD-C", nil},
+	{"BD-36", "Madaripur", "BD-C", nil},
+	{"BD-37", "Magura", "BD-D", nil},
+	{"BD-38", "Moulvibazar", "BD-G", nil},
+	{"BD-39", "Meherpur", "BD-D", nil},
+	{"BD-40", "Narayanganj", "BD-C", nil},
+	{"BD-41", "Netrakona", "BD-H", nil},
+	{"BD-42", "Narsingdi", "BD-C", nil},
+	{"BD-43", "Narail", "BD-D", nil},
+	{"BD-44", "Natore", "BD-E", nil},
+	{"BD-45", "Chapai Nawabganj", "BD-E", nil},
+	{"BD-46", "Nilphamari", "BD-F", nil},
+	{"BD-47", "Noakhali", "BD-B", nil},
+	{"BD-48", "Naogaon", "BD-E", nil},
+	{"BD-49", "Pabna", "BD-E", nil},
+	{"BD-50", "Pirojpur", "BD-A", nil},
+	{"BD-51", "Patuakhali", "BD-A", nil},
+	{"BD-52", "Panchagarh", "BD-F", nil},
+	{"BD-53", "Rajbari", "BD-C", nil},
+	{"BD-54", "Rajshahi", "BD-E", nil},
+	{"BD-55", "Rangpur", "BD-F", nil},
+	{"BD-56", "Rangamati", "BD-B", nil},
+	{"BD-57", "Sherpur", "BD-H", nil},
+	{"BD-58", "Satkhira", "BD-D", nil},
+	{"BD-59", "Sirajganj", "BD-E", nil},
+	{"BD-60", "Sylhet", "BD-G", nil},
+	{"BD-61", "Sunamganj", "BD-G", nil},
+	{"BD-62", "Shariatpur", "BD-C", nil},
+	{"BD-63", "Tangail", "BD-C", nil},
+	{"BD-64", "Thakurgaon", "BD-F", nil},
+	{"BD-A", "Barishal", "", nil},
+	{"BD-B", "Chattogram", "", nil},
+	{"BD-C", "Dhaka", "", nil},
+	{"BD-D", "Khulna", "", nil},
+	{"BD-E", "Rajshahi", "", nil},
+	{"BD-F", "Rangpur", "", nil},
+	{"BD-G", "Sylhet", "", nil},
+	{"BD-H", "Mymensingh", "", nil},
+
+	// Belgium
+	{"BE-BRU", "Brussels Hoofdstedelijk Gewest", "", nil},
+	{"BE-VAN", "Antwerpen", "BE-VLG", nil},
+	{"BE-VBR", "Vlaams-Brabant", "BE-VLG", nil},
+	{"BE-VLG", "Vlaams Gewest", "", nil},
+	{"BE-VLI", "Limburg", "BE-VLG", nil},
+	{"BE-VOV", "Oost-Vlaanderen", "BE-VLG", nil},
+	{"BE-VWV", "West-Vlaanderen", "BE-VLG", nil},
+	{"BE-WAL", "wallonne, Région", "", nil},
+	{"BE-WBR", "Brabant wallon", "BE-WAL", nil},
+	{"BE-WHT", "Hainaut", "BE-WAL", nil},
+	{"BE-WLG", "Liège", "BE-WAL", nil},
+	{"BE-WLX", "Luxembourg", "BE-WAL", nil},
+	{"BE-WNA", "Namur", "BE-WAL", nil},
+
+	// Burkina Faso
+	{"BF-01", "Boucle du Mouhoun", "", nil},
+	{"BF-02", "Cascades", "", nil},
+	{"BF-03", "Centre", "", nil},
+	{"BF-04", "Centre-Est", "", nil},
+	{"BF-05", "Centre-Nord", "", nil},
+	{"BF-06", "Centre-Ouest", "", nil},
+	{"BF-07", "Centre-Sud", "", nil},
+	{"BF-08", "Est", "", nil},
+	{"BF-09", "Hauts-Bassins", "", nil},
+	{"BF-10", "Nord", "", nil},
+	{"BF-11", "Plateau-Central", "", nil},
+	{"BF-12", "Sahel", "", nil},
+	{"BF-13", "Sud-Ouest", "", nil},
+	{"BF-BAL", "Balé", "BF-01", nil},
+	{"BF-BAM", "Bam", "BF-05", nil},
+	{"BF-BAN", "Banwa", "BF-01", nil},
+	{"BF-BAZ", "Bazèga", "BF-07", nil},
+	{"BF-BGR", "Bougouriba", "BF-13", nil},
+	{"BF-BLG", "Boulgou", "BF-04", nil},
+	{"BF-BLK", "Boulkiemdé", "BF-06", nil},
+	{"BF-COM", "Comoé", "BF-02", nil},
+	{"BF-GAN", "Ganzourgou", "BF-11", nil},
+	{"BF-GNA", "Gnagna", "BF-08", nil},
+	{"BF-GOU", "Gourma", "BF-08", nil},
+	{"BF-HOU", "Houet", "BF-09", nil},
+	{"BF-IOB", "Ioba", "BF-13", nil},
+	{"BF-KAD", "Kadiogo", "BF-03", nil},
+	{"BF-KEN", "Kénédougou", "BF-09", nil},
+	{"BF-KMD", "Komondjari", "BF-08", nil},
+	{"BF-KMP", "Kompienga", "BF-08", nil},
+	{"BF-KOP", "Koulpélogo", "BF-04", nil},
+	{"BF-KOS", "Kossi", "BF-01", nil},
+	{"BF-KOT", "Kouritenga", "BF-04", nil},
+	{"BF-KOW", "Kourwéogo", "BF-11", nil},
+	{"BF-LER", "Léraba", "BF-02", nil},
+	{"BF-LOR", "Loroum", "BF-10", nil},
+	{"BF-MOU", "Mouhoun", "BF-01", nil},
+	{"BF-NAM", "Namentenga", "BF-05", nil},
+	{"BF-NAO", "Nahouri", "BF-07", nil},
+	{"BF-NAY", "Nayala", "BF-01", nil},
+	{"BF-NOU", "Noumbiel", "BF-13", nil},
+	{"BF-OUB", "Oubritenga", "BF-11", nil},
+	{"BF-OUD", "Oudalan", "BF-12", nil},
+	{"BF-PAS", "Passoré", "BF-10", nil},
+	{"BF-PON", "Poni", "BF-13", nil},
+	{"BF-SEN", "Séno", "BF-12", nil},
+	{"BF-SIS", "Sissili", "BF-06", nil},
+	{"BF-SMT", "Sanmatenga", "BF-05", nil},
+	{"BF-SNG", "Sanguié", "BF-06", nil},
+	{"BF-SOM", "Soum", "BF-12", nil},
+	{"BF-SOR", "Sourou", "BF-01", nil},
+	{"BF-TAP", "Tapoa", "BF-08", nil},
+	{"BF-TUI", "Tuy", "BF-09", nil},
+	{"BF-YAG", "Yagha", "BF-12", nil},
+	{"BF-YAT", "Yatenga", "BF-10", nil},
+	{"BF-ZIR", "Ziro", "BF-06", nil},
+	{"BF-ZON", "Zondoma", "BF-10", nil},
+	{"BF-ZOU", "Zoundwéogo", "BF-07", nil},
+
+	// Bulgaria
+	{"BG-01", "Blagoevgrad", "", nil},
+	{"BG-02", "Burgas", "", nil},
+	{"BG-03", "Varna", "", nil},
+	{"BG-04", "Veliko Tarnovo", "", nil},
+	{"BG-05", "Vidin", "", nil},
+	{"BG-06", "Vratsa", "", nil},
+	{"BG-07", "Gabrovo", "", nil},
+	{"BG-08", "Dobrich", "", nil},
+	{"BG-09", "Kardzhali", "", nil},
+	{"BG-10", "Kyustendil", "", nil},
+	{"BG-11", "Lovech", "", nil},
+	{"BG-12", "Montana", "", nil},
+	{"BG-13", "Pazardzhik", "", nil},
+	{"BG-14", "Pernik", "", nil},
+	{"BG-15", "Pleven", "", nil},
+	{"BG-16", "Plovdiv", "", nil},
+	{"BG-17", "Razgrad", "", nil},
+	{"BG-18", "Ruse", "", nil},
+	{"BG-19", "Silistra", "", nil},
+	{"BG-20", "Sliven", "", nil},
+	{"BG-21", "Smolyan", "", nil},
+	{"BG-22", "Sofia (stolitsa)", "", []string{"Sofia"}},
+	{"BG-23", "Sofia", "", nil},
+	{"BG-24", "Stara Zagora", "", nil},
+	{"BG-25", "Targovishte", "", nil},
+	{"BG-26", "Haskovo", "", nil},
+	{"BG-27", "Shumen", "", nil},
+	{"BG-28", "Yambol", "", nil},
+
+	// Bahrain
+	{"BH-13", "Al ‘Āşimah", "", nil},
+	{"BH-14", "Al Janūbīyah", "", nil},
+	{"BH-15", "Al Muḩarraq", "", nil},
+	{"BH-17", "Ash Shamālīyah", "", nil},
+
+	// Burundi
+	{"BI-BB", "Bubanza", "", nil},
+	{"BI-BL", "Bujumbura Rural", "", nil},
+	{"BI-BM", "Bujumbura Mairie", "", nil},
+	{"BI-BR", "Bururi", "", nil},
+	{"BI-CA", "Cankuzo", "", nil},
+	{"BI-CI", "Cibitoke", "", nil},
+	{"BI-GI", "Gitega", "", nil},
+	{"BI-KI", "Kirundo", "", nil},
+	{"BI-KR", "Karuzi", "", nil},
+	{"BI-KY", "Kayanza", "", nil},
+	{"BI-MA", "Makamba", "", nil},
+	{"BI-MU", "Muramvya", "", nil},
+	{"BI-MW", "Mwaro", "", nil},
+	{"BI-MY", "Muyinga", "", nil},
+	{"BI-NG", "Ngozi", "", nil},
+	{"BI-RM", "Rumonge", "", nil},
+	{"BI-RT", "Rutana", "", nil},
+	{"BI-RY", "Ruyigi", "", nil},
+
+	// Benin
+	{"BJ-AK", "Atacora", "", nil},
+	{"BJ-AL", "Alibori", "", nil},
+	{"BJ-AQ", "Atlantique", "", nil},
+	{"BJ-BO", "Borgou", "", nil},
+	{"BJ-CO", "Collines", "", nil},
+	{"BJ-DO", "Donga", "", nil},
+	{"BJ-KO", "Couffo", "", nil},
+	{"BJ-LI", "Littoral", "", nil},
+	{"BJ-MO", "Mono", "", nil},
+	{"BJ-OU", "Ouémé", "", nil},
+	{"BJ-PL", "Plateau", "", nil},
+	{"BJ-ZO", "Zou", "", nil},
+
+	// Brunei
+	{"BN-BE", "Belait", "", nil},
+	{"BN-BM", "Brunei-Muara", "", nil},
+	{"BN-TE", "Temburong", "", nil},
+	{"BN-TU", "Tutong", "", nil},
+
+	// Bolivia
+	{"BO-B", "El Beni", "", nil},
+	{"BO-C", "Cochabamba", "", nil},
+	{"BO-H", "Chuquisaca", "", nil},
+	{"BO-L", "La Paz", "", nil},
+	{"BO-N", "Pando", "", nil},
+	{"BO-O", "Oruro", "", nil},
+	{"BO-P", "Potosí", "", nil},
+	{"BO-S", "Santa Cruz", "", nil},
+	{"BO-T", "Tarija", "", nil},
+
+	// Bonaire, Sint Eustatius and Saba
+	{"BQ-BO", "Bonaire", "", nil},
+	{"BQ-SA", "Saba", "", nil},
+	{"BQ-SE", "Sint Eustatius", "", nil},
+
+	// Brazil
+	{"BR-AC", "Acre", "", nil},
+	{"BR-AL", "Alagoas", "", nil},
+	{"BR-AM", "Amazonas", "", nil},
+	{"BR-AP", "Amapá", "", nil},
+	{"BR-BA", "Bahia", "", nil},
+	{"BR-CE", "Ceará", "", nil},
+	{"BR-DF", "Distrito Federal", "", []string{"Federal District"}},
+	{"BR-ES", "Espírito Santo", "", nil},
+	{"BR-GO", "Goiás", "", nil},
+	{"BR-MA", "Maranhão", "", nil},
+	{"BR-MG", "Minas Gerais", "", nil},
+	{"BR-MS", "Mato Grosso do Sul", "", nil},
+	{"BR-MT", "Mato Grosso", "", nil},
+	{"BR-PA", "Pará", "", nil},
+	{"BR-PB", "Paraíba", "", nil},
+	{"BR-PE", "Pernambuco", "", nil},
+	{"BR-PI", "Piauí", "", nil},
+	{"BR-PR", "Paraná", "", nil},
+	{"BR-RJ", "Rio de Janeiro", "", nil},
+	{"BR-RN", "Rio Grande do Norte", "", nil},
+	{"BR-RO", "Rondônia", "", nil},
+	{"BR-RR", "Roraima", "", nil},
+	{"BR-RS", "Rio Grande do Sul", "", nil},
+	{"BR-SC", "Santa Catarina", "", nil},
+	{"BR-SE", "Sergipe", "", nil},
+	{"BR-SP", "São Paulo", "", nil},
+	{"BR-TO", "Tocantins", "", nil},
+
+	// Bahamas
+	{"BS-AK", "Acklins", "", nil},
+	{"BS-BI", "Bimini", "", nil},
+	{"BS-BP", "Black Point", "", nil},
+	{"BS-BY", "Berry Islands", "", nil},
+	{"BS-CE", "Central Eleuthera", "", nil},
+	{"BS-CI", "Cat Island", "", nil},
+	{"BS-CK", "Crooked Island and Long Cay", "", nil},
+	{"BS-CO", "Central Abaco", "", nil},
+	{"BS-CS", "Central Andros", "", nil},
+	{"BS-EG", "East Grand Bahama", "", nil},
+	{"BS-EX", "Exuma", "", nil},
+	{"BS-FP", "City of Freeport", "", nil},
+	{"BS-GC", "Grand Cay", "", nil},
+	{"BS-HI", "Harbour Island", "", nil},
+	{"BS-HT", "Hope Town", "", nil},
+	{"BS-IN", "Inagua", "", nil},
+	{"BS-LI", "Long Island", "", nil},
+	{"BS-MC", "Mangrove Cay", "", nil},
+	{"BS-MG", "Mayaguana", "", nil},
+	{"BS-MI", "Moore's Island", "", nil},
+	{"BS-NE", "North Eleuthera", "", nil},
+	{"BS-NO", "North Abaco", "", nil},
+	{"BS-NP", "New Providence", "", nil},
+	{"BS-NS", "North Andros", "", nil},
+	{"BS-RC", "Rum Cay", "", nil},
+	{"BS-RI", "Ragged Island", "", nil},
+	{"BS-SA", "South Andros", "", nil},
+	{"BS-SE", "South Eleuthera", "", nil},
+	{"BS-SO", "South Abaco", "", nil},
+	{"BS-SS", "San Salvador", "", nil},
+	{"BS-SW", "Spanish Wells", "", nil},
+	{"BS-WG", "West Grand Bahama", "", nil},
+
+	// Bhutan
+	{"BT-11", "Paro", "", nil},
+	{"BT-12", "Chhukha", "", nil},
+	{"BT-13", "Haa", "", nil},
+	{"BT-14", "Samtse", "", nil},
+	{"BT-15", "Thimphu", "", nil},
+	{"BT-21", "Tsirang", "", nil},
+	{"BT-22", "Dagana", "", nil},
+	{"BT-23", "Punakha", "", nil},
+	{"BT-24", "Wangdue Phodrang", "", nil},
+	{"BT-31", "Sarpang", "", nil},
+	{"BT-32", "Trongsa", "", nil},
+	{"BT-33", "Bumthang", "", nil},
+	{"BT-34", "Zhemgang", "", nil},
+	{"BT-41", "Trashigang", "", nil},
+	{"BT-42", "Monggar", "", nil},
+	{"BT-43", "Pema Gatshel", "", nil},
+	{"BT-44", "Lhuentse", "", nil},
+	{"BT-45", "Samdrup Jongkhar", "", nil},
+	{"BT-GA", "Gasa", "", nil},
+	{"BT-TY", "Trashi Yangtse", "", nil},
+
+	// Botswana
+	{"BW-CE", "Central", "", nil},
+	{"BW-CH", "Chobe", "", nil},
+	{"BW-FR", "Francistown", "", nil},
+	{"BW-GA", "Gaborone", "", nil},
+	{"BW-GH", "Ghanzi", "", nil},
+	{"BW-JW", "Jwaneng", "", nil},
+	{"BW-KG", "Kgalagadi", "", nil},
+	{"BW-KL", "Kgatleng", "", nil},
+	{"BW-KW", "Kweneng", "", nil},
+	{"BW-LO", "Lobatse", "", nil},
+	{"BW-NE", "North East", "", nil},
+	{"BW-NW", "North West", "", nil},
+	{"BW-SE", "South East", "", nil},
+	{"BW-SO", "Southern", "", nil},
+	{"BW-SP", "Selibe Phikwe", "", nil},
+	{"BW-ST", "Sowa Town", "", nil},
+
+	// Belarus
+	{"BY-BR", "Bresckaja voblasć", "", nil},
+	{"BY-HM", "Gorod Minsk", "", nil},
+	{"BY-HO", "Gomel'skaja oblast'", "", nil},
+	{"BY-HR", "Grodnenskaja oblast'", "", nil},
+	{"BY-MA", "Mahilioŭskaja voblasć", "", nil},
+	{"BY-MI", "Minskaja oblast'", "", nil},
+	{"BY-VI", "Viciebskaja voblasć", "", nil},
+
+	// Belize
+	{"BZ-BZ", "Belize", "", nil},
+	{"BZ-CY", "Cayo", "", nil},
+	{"BZ-CZL", "Corozal", "", nil},
+	{"BZ-OW", "Orange Walk", "", nil},
+	{"BZ-SC", "Stann Creek", "", nil},
+	{"BZ-TOL", "Toledo", "", nil},
+
+	// Canada
+	{"CA-AB", "Alberta", "", nil},
+	{"CA-BC", "British Columbia", "", nil},
+	{"CA-MB", "Manitoba", "", nil},
+	{"CA-NB", "New Brunswick", "", nil},
+	{"CA-NL", "Newfoundland and Labrador", "", []string{"Newfoundland"}},
+	{"CA-NS", "Nova Scotia", "", nil},
+	{"CA-NT", "Northwest Territories", "", nil},
+	{"CA-NU", "Nunavut", "", nil},
+	{"CA-ON", "Ontario", "", nil},
+	{"CA-PE", "Prince Edward Island", "", nil},
+	{"CA-QC", "Quebec", "", []string{"Québec"}},
+	{"CA-SK", "Saskatchewan", "", nil},
+	{"CA-YT", "Yukon", "", nil},
+
+	// Democratic Republic of the Congo
+	{"CD-BC", "Kongo Central", "", nil},
+	{"CD-BU", "Bas-Uélé", "", nil},
+	{"CD-EQ", "Équateur", "", nil},
+	{"CD-HK", "Haut-Katanga", "", nil},
+	{"CD-HL", "Haut-Lomami", "", nil},
+	{"CD-HU", "Haut-Uélé", "", nil},
+	{"CD-IT", "Ituri", "", nil},
+	{"CD-KC", "Kasaï Central", "", nil},
+	{"CD-KE", "Kasaï Oriental", "", nil},
+	{"CD-KG", "Kwango", "", nil},
+	{"CD-KL", "Kwilu", "", nil},
+	{"CD-KN", "Kinshasa", "", nil},
+	{"CD-KS", "Kasaï", "", nil},
+	{"CD-LO", "Lomami", "", nil},
+	{"CD-LU", "Lualaba", "", nil},
+	{"CD-MA", "Maniema", "", nil},
+	{"CD-MN", "Mai-Ndombe", "", nil},
+	{"CD-MO", "Mongala", "", nil},
+	{"CD-NK", "Nord-Kivu", "", nil},
+	{"CD-NU", "Nord-Ubangi", "", nil},
+	{"CD-SA", "Sankuru", "", nil},
+	{"CD-SK", "Sud-Kivu", "", nil},
+	{"CD-SU", "Sud-Ubangi", "", nil},
+	{"CD-TA", "Tanganyika", "", nil},
+	{"CD-TO", "Tshopo", "", nil},
+	{"CD-TU", "Tshuapa", "", nil},
+
+	// Central African Republic
+	{"CF-AC", "Ouham", "", nil},
+	{"CF-BB", "Bamingui-Bangoran", "", nil},
+	{"CF-BGF", "Bangui", "", nil},
+	{"CF-BK", "Basse-Kotto", "", nil},
+	{"CF-HK", "Haute-Kotto", "", nil},
+	{"CF-HM", "Haut-Mbomou", "", nil},
+	{"CF-HS", "Haute-Sangha / Mambéré-Kadéï", "", []string{"Haute-Sangha", "Mambéré-Kadéï"}},
+	{"CF-KB", "Gribingui", "", nil},
+	{"CF-KG", "Kemö-Gïrïbïngï", "", nil},
+	{"CF-LB", "Lobaye", "", nil},
+	{"CF-MB", "Mbomou", "", nil},
+	{"CF-MP", "Ombella-Mpoko", "", nil},
+	{"CF-NM", "Nana-Mambéré", "", nil},
+	{"CF-OP", "Ouham-Pendé", "", nil},
+	{"CF-SE", "Sangha", "", nil},
+	{"CF-UK", "Ouaka", "", nil},
+	{"CF-VK", "Vakaga", "", nil},
+
+	// Congo
+	{"CG-11", "Bouenza", "", nil},
+	{"CG-12", "Pool", "", nil},
+	{"CG-13", "Sangha", "", nil},
+	{"CG-14", "Plateaux", "", nil},
+	{"CG-15", "Cuvette-Ouest", "", nil},
+	{"CG-16", "Pointe-Noire", "", nil},
+	{"CG-2", "Lékoumou", "", nil},
+	{"CG-5", "Kouilou", "", nil},
+	{"CG-7", "Likouala", "", nil},
+	{"CG-8", "Cuvette", "", nil},
+	{"CG-9", "Niari", "", nil},
+	{"CG-BZV", "Brazzaville", "", nil},
+
+	// Switzerland
+	{"CH-AG", "Aargau", "", nil},
+	{"CH-AI", "Appenzell Innerrhoden", "", nil},
+	{"CH-AR", "Appenzell Ausserrhoden", "", nil},
+	{"CH-BE", "Bern", "", nil},
+	{"CH-BL", "Basel-Landschaft", "", nil},
+	{"CH-BS", "Basel-Stadt", "", nil},
+	{"CH-FR", "Freiburg", "", nil},
+	{"CH-GE", "Genève", "", nil},
+	{"CH-GL", "Glarus", "", nil},
+	{"CH-GR", "Graubünden", "", nil},
+	{"CH-JU", "Jura", "", nil},
+	{"CH-LU", "Luzern", "", nil},
+	{"CH-NE", "Neuchâtel", "", nil},
+	{"CH-NW", "Nidwalden", "", nil},
+	{"CH-OW", "Obwalden", "", nil},
+	{"CH-SG", "Sankt Gallen", "", nil},
+	{"CH-SH", "Schaffhausen", "", nil},
+	{"CH-SO", "Solothurn", "", nil},
+	{"CH-SZ", "Schwyz", "", nil},
+	{"CH-TG", "Thurgau", "", nil},
+	{"CH-TI", "Ticino", "", nil},
+	{"CH-UR", "Uri", "", nil},
+	{"CH-VD", "Vaud", "", nil},
+	{"CH-VS", "Valais", "", nil},
+	{"CH-ZG", "Zug", "", nil},
+	{"CH-ZH", "Zürich", "", nil},
+
+	// Côte d'Ivoire
+	{"CI-AB", "Abidjan", "", nil},
+	{"CI-BS", "Bas-Sassandra", "", nil},
+	{"CI-CM", "Comoé", "", nil},
+	{"CI-DN", "Denguélé", "", nil},
+	{"CI-GD", "Gôh-Djiboua", "", nil},
+	{"CI-LC", "Lacs", "", nil},
+	{"CI-LG", "Lagunes", "", nil},
+	{"CI-MG", "Montagnes", "", nil},
+	{"CI-SM", "Sassandra-Marahoué", "", nil},
+	{"CI-SV", "Savanes", "", nil},
+	{"CI-VB", "Vallée du Bandama", "", nil},
+	{"CI-WR", "Woroba", "", nil},
+	{"CI-YM", "Yamoussoukro", "", nil},
+	{"CI-ZZ", "Zanzan", "", nil},
+
+	// Chile
+	{"CL-AI", "Aisén del General Carlos Ibañez del Campo", "", nil},
+	{"CL-AN", "Antofagasta", "", nil},
+	{"CL-AP", "Arica y Parinacota", "", nil},
+	{"CL-AR", "La Araucanía", "", nil},
+	{"CL-AT", "Atacama", "", nil},
+	{"CL-BI", "Biobío", "", nil},
+	{"CL-CO", "Coquimbo", "", nil},
+	{"CL-LI", "Libertador General Bernardo O'Higgins", "", nil},
+	{"CL-LL", "Los Lagos", "", nil},
+	{"CL-LR", "Los Ríos", "", nil},
+	{"CL-MA", "Magallanes", "", nil},
+	{"CL-ML", "Maule", "", nil},
+	{"CL-NB", "Ñuble", "", nil},
+	{"CL-RM", "Región Metropolitana de Santiago", "", nil},
+	{"CL-TA", "Tarapacá", "", nil},
+	{"CL-VS", "Valparaíso", "", nil},
+
+	// Cameroon
+	{"CM-AD", "Adamaoua", "", nil},
+	{"CM-CE", "Centre", "", nil},
+	{"CM-EN", "Far North", "", nil},
+	{"CM-ES", "East", "", nil},
+	{"CM-LT", "Littoral", "", nil},
+	{"CM-NO", "North", "", nil},
+	{"CM-NW", "North-West", "", nil},
+	{"CM-OU", "West", "", nil},
+	{"CM-SU", "South", "", nil},
+	{"CM-SW", "South-West", "", nil},
+
+	// China
+	{"CN-AH", "Anhui Sheng", "", nil},
+	{"CN-BJ", "Beijing Shi", "", nil},
+	{"CN-CQ", "Chongqing Shi", "", nil},
+	{"CN-FJ", "Fujian Sheng", "", nil},
+	{"CN-GD", "Guangdong Sheng", "", nil},
+	{"CN-GS", "Gansu Sheng", "", nil},
+	{"CN-GX", "Guangxi Zhuangzu Zizhiqu", "", nil},
+	{"CN-GZ", "Guizhou Sheng", "", nil},
+	{"CN-HA", "Henan Sheng", "", nil},
+	{"CN-HB", "Hubei Sheng", "", nil},
+	{"CN-HE", "Hebei Sheng", "", nil},
+	{"CN-HI", "Hainan Sheng", "", nil},
+	{"CN-HK", "Hong Kong SAR", "", nil},
+	{"CN-HL", "Heilongjiang Sheng", "", nil},
+	{"CN-HN", "Hunan Sheng", "", nil},
+	{"CN-JL", "Jilin Sheng", "", nil},
+	{"CN-JS", "Jiangsu Sheng", "", nil},
+	{"CN-JX", "Jiangxi Sheng", "", nil},
+	{"CN-LN", "Liaoning Sheng", "", nil},
+	{"CN-MO", "Macao SAR", "", nil},
+	{"CN-NM", "Nei Mongol Zizhiqu", "", nil},
+	{"CN-NX", "Ningxia Huizi Zizhiqu", "", nil},
+	{"CN-QH", "Qinghai Sheng", "", nil},
+	{"CN-SC", "Sichuan Sheng", "", nil},
+	{"CN-SD", "Shandong Sheng", "", nil},
+	{"CN-SH", "Shanghai Shi", "", nil},
+	{"CN-SN", "Shaanxi Sheng", "", nil},
+	{"CN-SX", "Shanxi Sheng", "", nil},
+	{"CN-TJ", "Tianjin Shi", "", nil},
+	{"CN-TW", "Taiwan Sheng", "", nil},
+	{"CN-XJ", "Xinjiang Uygur Zizhiqu", "", nil},
+	{"CN-XZ", "Xizang Zizhiqu", "", nil},
+	{"CN-YN", "Yunnan Sheng", "", nil},
+	{"CN-ZJ", "Zhejiang Sheng", "", nil},
+
+	// Colombia
+	{"CO-AMA", "Amazonas", "", nil},
+	{"CO-ANT", "Antioquia", "", nil},
+	{"CO-ARA", "Arauca", "", nil},
+	{"CO-ATL", "Atlántico", "", nil},
+	{"CO-BOL", "Bolívar", "", nil},
+	{"CO-BOY", "Boyacá", "", nil},
+	{"CO-CAL", "Caldas", "", nil},
+	{"CO-CAQ", "Caquetá", "", nil},
+	{"CO-CAS", "Casanare", "", nil},
+	{"CO-CAU", "Cauca", "", nil},
+	{"CO-CES", "Cesar", "", nil},
+	{"CO-CHO", "Chocó", "", nil},
+	{"CO-COR", "Córdoba", "", nil},
+	{"CO-CUN", "Cundinamarca", "", nil},
+	{"CO-DC", "Distrito Capital de Bogotá", "", nil},
+	{"CO-GUA", "Guainía", "", nil},
+	{"CO-GUV", "Guaviare", "", nil},
+	{"CO-HUI", "Huila", "", nil},
+	{"CO-LAG", "La Guajira", "", nil},
+	{"CO-MAG", "Magdalena", "", nil},
+	{"CO-MET", "Meta", "", nil},
+	{"CO-NAR", "Nariño", "", nil},
+	{"CO-NSA", "Norte de Santander", "", nil},
+	{"CO-PUT", "Putumayo", "", nil},
+	{"CO-QUI", "Quindío", "", nil},
+	{"CO-RIS", "Risaralda", "", nil},
+	{"CO-SAN", "Santander", "", nil},
+	{"CO-SAP", "San Andrés, Providencia y Santa Catalina", "", nil},
+	{"CO-SUC", "Sucre", "", nil},
+	{"CO-TOL", "Tolima", "", nil},
+	{"CO-VAC", "Valle del Cauca", "", nil},
+	{"CO-VAU", "Vaupés", "", nil},
+	{"CO-VID", "Vichada", "", nil},
+
+	// Costa Rica
+	{"CR-A", "Alajuela", "", nil},
+	{"CR-C", "Cartago", "", nil},
+	{"CR-G", "Guanacaste", "", nil},
+	{"CR-H", "Heredia", "", nil},
+	{"CR-L", "Limón", "", nil},
+	{"CR-P", "Puntarenas", "", nil},
+	{"CR-SJ", "San José", "", nil},
+
+	// Cuba
+	{"CU-01", "Pinar del Río", "", nil},
+	{"CU-03", "La Habana", "", nil},
+	{"CU-04", "Matanzas", "", nil},
+	{"CU-05", "Villa Clara", "", nil},
+	{"CU-06", "Cienfuegos", "", nil},
+	{"CU-07", "Sancti Spíritus", "", nil},
+	{"CU-08", "Ciego de Ávila", "", nil},
+	{"CU-09", "Camagüey", "", nil},
+	{"CU-10", "Las Tunas", "", nil},
+	{"CU-11", "Holguín", "", nil},
+	{"CU-12", "Granma", "", nil},
+	{"CU-13", "Santiago de Cuba", "", nil},
+	{"CU-14", "Guantánamo", "", nil},
+	{"CU-15", "Artemisa", "", nil},
+	{"CU-16", "Mayabeque", "", nil},
+	{"CU-99", "Isla de la Juventud", "", nil},
+
+	// Cabo Verde
+	{"CV-B", "Ilhas de Barlavento", "", nil},
+	{"CV-BR", "Brava", "CV-S", nil},
+	{"CV-BV", "Boa Vista", "CV-B", nil},
+	{"CV-CA", "Santa Catarina", "CV-S", nil},
+	{"CV-CF", "Santa Catarina do Fogo", "CV-S", nil},
+	{"CV-CR", "Santa Cruz", "CV-S", nil},
+	{"CV-MA", "Maio", "CV-S", nil},
+	{"CV-MO", "Mosteiros", "CV-S", nil},
+	{"CV-PA", "Paul", "CV-B", nil},
+	{"CV-PN", "Porto Novo", "CV-B", nil},
+	{"CV-PR", "Praia", "CV-S", nil},
+	{"CV-RB", "Ribeira Brava", "CV-B", nil},
+	{"CV-RG", "Ribeira Grande", "CV-B", nil},
+	{"CV-RS", "Ribeira Grande de Santiago", "CV-S", nil},
+	{"CV-S", "Ilhas de Sotavento", "", nil},
+	{"CV-SD", "São Domingos", "CV-S", nil},
+	{"CV-SF", "São Filipe", "CV-S", nil},
+	{"CV-SL", "Sal", "CV-B", nil},
+	{"CV-SM", "São Miguel", "CV-S", nil},
+	{"CV-SO", "São Lourenço dos Órgãos", "CV-S", nil},
+	{"CV-SS", "São Salvador do Mundo", "CV-S", nil},
+	{"CV-SV", "São Vicente", "CV-B", nil},
+	{"CV-TA", "Tarrafal", "CV-S", nil},
+	{"CV-TS", "Tarrafal de São Nicolau", "CV-B", nil},
+
+	// Cyprus
+	{"CY-01", "Lefkosia", "", nil},
+	{"CY-02", "Lemesos", "", nil},
+	{"CY-03", "Larnaka", "", nil},
+	{"CY-04", "Ammochostos", "", nil},
+	{"CY-05", "Baf", "", nil},
+	{"CY-06", "Girne", "", nil},
+
+	// Czechia
+	{"CZ-10", "Praha, Hlavní město", "", nil},
+	{"CZ-20", "Středočeský kraj", "", nil},
+	{"CZ-201", "Benešov", "CZ-20", nil},
+	{"CZ-202", "Beroun", "CZ-20", nil},
+	{"CZ-203", "Kladno", "CZ-20", nil},
+	{"CZ-204", "Kolín", "CZ-20", nil},
+	{"CZ-205", "Kutná Hora", "CZ-20", nil},
+	{"CZ-206", "Mělník", "CZ-20", nil},
+	{"CZ-207", "Mladá Boleslav", "CZ-20", nil},
+	{"CZ-208", "Nymburk", "CZ-20", nil},
+	{"CZ-209", "Praha-východ", "CZ-20", nil},
+	{"CZ-20A", "Praha-západ", "CZ-20", nil},
+	{"CZ-20B", "Příbram", "CZ-20", nil},
+	{"CZ-20C", "Rakovník", "CZ-20", nil},
+	{"CZ-31", "Jihočeský kraj", "", nil},
+	{"CZ-311", "České Budějovice", "CZ-31", nil},
+	{"CZ-312", "Český Krumlov", "CZ-31", nil},
+	{"CZ-313", "Jindřichův Hradec", "CZ-31", nil},
+	{"CZ-314", "Písek", "CZ-31", nil},
+	{"CZ-315", "Prachatice", "CZ-31", nil},
+	{"CZ-316", "Strakonice", "CZ-31", nil},
+	{"CZ-317", "Tábor", "CZ-31", nil},
+	{"CZ-32", "Plzeňský kraj", "", nil},
+	{"CZ-321", "Domažlice", "CZ-32", nil},
+	{"CZ-322", "Klatovy", "CZ-32", nil},
+	{"CZ-323", "Plzeň-město", "CZ-32", nil},
+	{"CZ-324", "Plzeň-jih", "CZ-32", nil},
+	{"CZ-325", "Plzeň-sever", "CZ-32", nil},
+	{"CZ-326", "Rokycany", "CZ-32", nil},
+	{"CZ-327", "Tachov", "CZ-32", nil},
+	{"CZ-41", "Karlovarský kraj", "", nil},
+	{"CZ-411", "Cheb", "CZ-41", nil},
+	{"CZ-412", "Karlovy Vary", "CZ-41", nil},
+	{"CZ-413", "Sokolov", "CZ-41", nil},
+	{"CZ-42", "Ústecký kraj", "", nil},
+	{"CZ-421", "Děčín", "CZ-42", nil},
+	{"CZ-422", "Chomutov", "CZ-42", nil},
+	{"CZ-423", "Litoměřice", "CZ-42", nil},
+	{"CZ-424", "Louny", "CZ-42", nil},
+	{"CZ-425", "Most", "CZ-42", nil},
+	{"CZ-426", "Teplice", "CZ-42", nil},
+	{"CZ-427", "Ústí nad Labem", "CZ-42", nil},
+	{"CZ-51", "Liberecký kraj", "", nil},
+	{"CZ-511", "Česká Lípa", "CZ-51", nil},
+	{"CZ-512", "Jablonec nad Nisou", "CZ-51", nil},
+	{"CZ-513", "Liberec", "CZ-51", nil},
+	{"CZ-514", "Semily", "CZ-51", nil},
+	{"CZ-52", "Královéhradecký kraj", "", nil},
+	{"CZ-521", "Hradec Králové", "CZ-52", nil},
+	{"CZ-522", "Jičín", "CZ-52", nil},
+	{"CZ-523", "Náchod", "CZ-52", nil},
+	{"CZ-524", "Rychnov nad Kněžnou", "CZ-52", nil},
+	{"CZ-525", "Trutnov", "CZ-52", nil},
+	{"CZ-53", "Pardubický kraj", "", nil},
+	{"CZ-531", "Chrudim", "CZ-53", nil},
+	{"CZ-532", "Pardubice", "CZ-53", nil},
+	{"CZ-533", "Svitavy", "CZ-53", nil},
+	{"CZ-534", "Ústí nad Orlicí", "CZ-53", nil},
+	{"CZ-63", "Kraj Vysočina", "", nil},
+	{"CZ-631", "Havlíčkův Brod", "CZ-63", nil},
+	{"CZ-632", "Jihlava", "CZ-63", nil},
+	{"CZ-633", "Pelhřimov", "CZ-63", nil},
+	{"CZ-634", "Třebíč", "CZ-63", nil},
+	{"CZ-635", "Žďár nad Sázavou", "CZ-63", nil},
+	{"CZ-64", "Jihomoravský kraj", "", nil},
+	{"CZ-641", "Blansko", "CZ-64", nil},
+	{"CZ-642", "Brno-město", "CZ-64", nil},
+	{"CZ-643", "Brno-venkov", "CZ-64", nil},
+	{"CZ-644", "Břeclav", "CZ-64", nil},
+	{"CZ-645", "Hodonín", "CZ-64", nil},
+	{"CZ-646", "Vyškov", "CZ-64", nil},
+	{"CZ-647", "Znojmo", "CZ-64", nil},
+	{"CZ-71", "Olomoucký kraj", "", nil},
+	{"CZ-711", "Jeseník", "CZ-71", nil},
+	{"CZ-712", "Olomouc", "CZ-71", nil},
+	{"CZ-713", "Prostějov", "CZ-71", nil},
+	{"CZ-714", "Přerov", "CZ-71", nil},
+	{"CZ-715", "Šumperk", "CZ-71", nil},
+	{"CZ-72", "Zlínský kraj", "", nil},
+	{"CZ-721", "Kroměříž", "CZ-72", nil},
+	{"CZ-722", "Uherské Hradiště", "CZ-72", nil},
+	{"CZ-723", "Vsetín", "CZ-72", nil},
+	{"CZ-724", "Zlín", "CZ-72", nil},
+	{"CZ-80", "Moravskoslezský kraj", "", nil},
+	{"CZ-801", "Bruntál", "CZ-80", nil},
+	{"CZ-802", "Frýdek-Místek", "CZ-80", nil},
+	{"CZ-803", "Karviná", "CZ-80", nil},
+	{"CZ-804", "Nový Jičín", "CZ-80", nil},
+	{"CZ-805", "Opava", "CZ-80", nil},
+	{"CZ-806", "Ostrava-město", "CZ-80", nil},
+
+	// Germany
+	{"DE-BB", "Brandenburg", "", nil},
+	{"DE-BE", "Berlin", "", nil},
+	{"DE-BW", "Baden-Württemberg", "", nil},
+	{"DE-BY", "Bavaria", "", []string{"Bayern"}},
+	{"DE-HB", "Bremen", "", nil},
+	{"DE-HE", "Hesse", "", []string{"Hessen"}},
+	{"DE-HH", "Hamburg", "", nil},
+	{"DE-MV", "Mecklenburg-Western Pomerania", "", []string{"Mecklenburg-Vorpommern"}},
+	{"DE-NI", "Lower Saxony", "", []string{"Niedersachsen"}},
+	{"DE-NW", "North Rhine-Westphalia", "", []string{"Nordrhein-Westfalen"}},
+	{"DE-RP", "Rhineland-Palatinate", "", []string{"Rheinland-Pfalz"}},
+	{"DE-SH", "Schleswig-Holstein", "", nil},
+	{"DE-SL", "Saarland", "", nil},
+	{"DE-SN", "Saxony", "", []string{"Sachsen"}},
+	{"DE-ST", "Saxony-Anhalt", "", []string{"Sachsen-Anhalt"}},
+	{"DE-TH", "Thuringia", "", []string{"Thüringen"}},
+
+	// Djibouti
+	{"DJ-AR", "Arta", "", nil},
+	{"DJ-AS", "Ali Sabieh", "", nil},
+	{"DJ-DI", "Dikhil", "", nil},
+	{"DJ-DJ", "Djibouti", "", nil},
+	{"DJ-OB", "Awbūk", "", nil},
+	{"DJ-TA", "Tadjourah", "", nil},
+
+	// Denmark
+	{"DK-81", "Nordjylland", "", nil},
+	{"DK-82", "Midtjylland", "", nil},
+	{"DK-83", "Syddanmark", "", nil},
+	{"DK-84", "Hovedstaden", "", nil},
+	{"DK-85", "Sjælland", "", nil},
+
+	// Dominica
+	{"DM-02", "Saint Andrew", "", nil},
+	{"DM-03", "Saint David", "", nil},
+	{"DM-04", "Saint George", "", nil},
+	{"DM-05", "Saint John", "", nil},
+	{"DM-06", "Saint Joseph", "", nil},
+	{"DM-07", "Saint Luke", "", nil},
+	{"DM-08", "Saint Mark", "", nil},
+	{"DM-09", "Saint Patrick", "", nil},
+	{"DM-10", "Saint Paul", "", nil},
+	{"DM-11", "Saint Peter", "", nil},
+
+	// Dominican Republic
+	{"DO-01", "Distrito Nacional (Santo Domingo)", "DO-40", []string{"Distrito Nacional"}},
+	{"DO-02", "Azua", "DO-41", nil},
+	{"DO-03", "Baoruco", "DO-38", nil},
+	{"DO-04", "Barahona", "DO-38", nil},
+	{"DO-05", "Dajabón", "DO-34", nil},
+	{"DO-06", "Duarte", "DO-33", nil},
+	{"DO-07", "Elías Piña", "DO-37", nil},
+	{"DO-08", "El Seibo", "DO-42", nil},
+	{"DO-09", "Espaillat", "DO-35", nil},
+	{"DO-10", "Independencia", "DO-38", nil},
+	{"DO-11", "La Altagracia", "DO-42", nil},
+	{"DO-12", "La Romana", "DO-42", nil},
+	{"DO-13", "La Vega", "DO-36", nil},
+	{"DO-14", "María Trinidad Sánchez", "DO-33", nil},
+	{"DO-15", "Monte Cristi", "DO-34", nil},
+	{"DO-16", "Pedernales", "DO-38", nil},
+	{"DO-17", "Peravia", "DO-41", nil},
+	{"DO-18", "Puerto Plata", "DO-35", nil},
+	{"DO-19", "Hermanas Mirabal", "DO-33", nil},
+	{"DO-20", "Samaná", "DO-33", nil},
+	{"DO-21", "San Cristóbal", "DO-41", nil},
+	{"DO-22", "San Juan", "DO-37", nil},
+	{"DO-23", "San Pedro de Macorís", "DO-39", nil},
+	{"DO-24", "Sánchez Ramírez", "DO-36", nil},
+	{"DO-25", "Santiago", "DO-35", nil},
+	{"DO-26", "Santiago Rodríguez", "DO-34", nil},
+	{"DO-27", "Valverde", "DO-34", nil},
+	{"DO-28", "Monseñor Nouel", "DO-36", nil},
+	{"DO-29", "Monte Plata", "DO-39", nil},
+	{"DO-30", "Hato Mayor", "DO-39", nil},
+	{"DO-31", "San José de Ocoa", "DO-41", nil},
+	{"DO-32", "Santo Domingo", "DO-40", nil},
+	{"DO-33", "Cibao Nordeste", "", nil},
+	{"DO-34", "Cibao Noroeste", "", nil},
+	{"DO-35", "Cibao Norte", "", nil},
+	{"DO-36", "Cibao Sur", "", nil},
+	{"DO-37", "El Valle", "", nil},
+	{"DO-38", "Enriquillo", "", nil},
+	{"DO-39", "Higuamo", "", nil},
+	{"DO-40", "Ozama", "", nil},
+	{"DO-41", "Valdesia", "", nil},
+	{"DO-42", "Yuma", "", nil},
+
+	// Algeria
+	{"DZ-01", "Adrar", "", nil},
+	{"DZ-02", "Chlef", "", nil},
+	{"DZ-03", "Laghouat", "", nil},
+	{"DZ-04", "Oum el Bouaghi", "", nil},
+	{"DZ-05", "Batna", "", nil},
+	{"DZ-06", "Béjaïa", "", nil},
+	{"DZ-07", "Biskra", "", nil},
+	{"DZ-08", "Béchar", "", nil},
+	{"DZ-09", "Blida", "", nil},
+	{"DZ-10", "Bouira", "", nil},
+	{"DZ-11", "Tamanrasset", "", nil},
+	{"DZ-12", "Tébessa", "", nil},
+	{"DZ-13", "Tlemcen", "", nil},
+	{"DZ-14", "Tiaret", "", nil},
+	{"DZ-15", "Tizi Ouzou", "", nil},
+	{"DZ-16", "Alger", "", nil},
+	{"DZ-17", "Djelfa", "", nil},
+	{"DZ-18", "Jijel", "", nil},
+	{"DZ-19", "Sétif", "", nil},
+	{"DZ-20", "Saïda", "", nil},
+	{"DZ-21", "Skikda", "", nil},
+	{"DZ-22", "Sidi Bel Abbès", "", nil},
+	{"DZ-23", "Annaba", "", nil},
+	{"DZ-24", "Guelma", "", nil},
+	{"DZ-25", "Constantine", "", nil},
+	{"DZ-26", "Médéa", "", nil},
+	{"DZ-27", "Mostaganem", "", nil},
+	{"DZ-28", "M'sila", "", nil},
+	{"DZ-29", "Mascara", "", nil},
+	{"DZ-30", "Ouargla", "", nil},
+	{"DZ-31", "Oran", "", nil},
+	{"DZ-32", "El Bayadh", "", nil},
+	{"DZ-33", "Illizi", "", nil},
+	{"DZ-34", "Bordj Bou Arréridj", "", nil},
+	{"DZ-35", "Boumerdès", "", nil},
+	{"DZ-36", "El Tarf", "", nil},
+	{"DZ-37", "Tindouf", "", nil},
+	{"DZ-38", "Tissemsilt", "", nil},
+	{"DZ-39", "El Oued", "", nil},
+	{"DZ-40", "Khenchela", "", nil},
+	{"DZ-41", "Souk Ahras", "", nil},
+	{"DZ-42", "Tipaza", "", nil},
+	{"DZ-43", "Mila", "", nil},
+	{"DZ-44", "Aïn Defla", "", nil},
+	{"DZ-45", "Naama", "", nil},
+	{"DZ-46", "Aïn Témouchent", "", nil},
+	{"DZ-47", "Ghardaïa", "", nil},
+	{"DZ-48", "Relizane", "", nil},
+
+	// Ecuador
+	{"EC-A", "Azuay", "", nil},
+	{"EC-B", "Bolívar", "", nil},
+	{"EC-C", "Carchi", "", nil},
+	{"EC-D", "Orellana", "", nil},
+	{"EC-E", "Esmeraldas", "", nil},
+	{"EC-F", "Cañar", "", nil},
+	{"EC-G", "Guayas", "", nil},
+	{"EC-H", "Chimborazo", "", nil},
+	{"EC-I", "Imbabura", "", nil},
+	{"EC-L", "Loja", "", nil},
+	{"EC-M", "Manabí", "", nil},
+	{"EC-N", "Napo", "", nil},
+	{"EC-O", "El Oro", "", nil},
+	{"EC-P", "Pichincha", "", nil},
+	{"EC-R", "Los Ríos", "", nil},
+	{"EC-S", "Morona Santiago", "", nil},
+	{"EC-SD", "Santo Domingo de los Tsáchilas", "", nil},
+	{"EC-SE", "Santa Elena", "", nil},
+	{"EC-T", "Tungurahua", "", nil},
+	{"EC-U", "Sucumbíos", "", nil},
+	{"EC-W", "Galápagos", "", nil},
+	{"EC-X", "Cotopaxi", "", nil},
+	{"EC-Y", "Pastaza", "", nil},
+	{"EC-Z", "Zamora Chinchipe", "", nil},
+
+	// Estonia
+	{"EE-130", "Alutaguse", "EE-45", nil},
+	{"EE-141", "Anija", "EE-37", nil},
+	{"EE-142", "Antsla", "EE-87", nil},
+	{"EE-171", "Elva", "EE-79", nil},
+	{"EE-184", "Haapsalu", "EE-56", nil},
+	{"EE-191", "Haljala", "EE-60", nil},
+	{"EE-198", "Harku", "EE-37", nil},
+	{"EE-205", "Hiiumaa", "EE-39", nil},
+	{"EE-214", "Häädemeeste", "EE-68", nil},
+	{"EE-245", "Jõelähtme", "EE-37", nil},
+	{"EE-247", "Jõgeva", "EE-50", nil},
+	{"EE-251", "Jõhvi", "EE-45", nil},
+	{"EE-255", "Järva", "EE-52", nil},
+	{"EE-272", "Kadrina", "EE-60", nil},
+	{"EE-283", "Kambja", "EE-79", nil},
+	{"EE-284", "Kanepi", "EE-64", nil},
+	{"EE-291", "Kastre", "EE-79", nil},
+	{"EE-293", "Kehtna", "EE-71", nil},
+	{"EE-296", "Keila", "EE-37", nil},
+	{"EE-303", "Kihnu", "EE-68", nil},
+	{"EE-305", "Kiili", "EE-37", nil},
+	{"EE-317", "Kohila", "EE-71", nil},
+	{"EE-321", "Kohtla-Järve", "EE-45", nil},
+	{"EE-338", "Kose", "EE-37", nil},
+	{"EE-353", "Kuusalu", "EE-37", nil},
+	{"EE-37", "Harjumaa", "", nil},
+	{"EE-39", "Hiiumaa", "", nil},
+	{"EE-424", "Loksa", "EE-37", nil},
+	{"EE-430", "Lääneranna", "EE-68", nil},
+	{"EE-431", "Lääne-Harju", "EE-37", nil},
+	{"EE-432", "Luunja", "EE-79", nil},
+	{"EE-441", "Lääne-Nigula", "EE-56", nil},
+	{"EE-442", "Lüganuse", "EE-45", nil},
+	{"EE-446", "Maardu", "EE-37", nil},
+	{"EE-45", "Ida-Virumaa", "", nil},
+	{"EE-478", "Muhu", "EE-74", nil},
+	{"EE-480", "Mulgi", "EE-84", nil},
+	{"EE-486", "Mustvee", "EE-50", nil},
+	{"EE-50", "Jõgevamaa", "", nil},
+	{"EE-503", "Märjamaa", "EE-71", nil},
+	{"EE-511", "Narva", "EE-45", nil},
+	{"EE-514", "Narva-Jõesuu", "EE-45", nil},
+	{"EE-52", "Järvamaa", "", nil},
+	{"EE-528", "Nõo", "EE-79", nil},
+	{"EE-557", "Otepää", "EE-81", nil},
+	{"EE-56", "Läänemaa", "", nil},
+	{"EE-567", "Paide", "EE-52", nil},
+	{"EE-586", "Peipsiääre", "EE-79", nil},
+	{"EE-60", "Lääne-Virumaa", "", nil},
+	{"EE-615", "Põhja-Sakala", "EE-84", nil},
+	{"EE-618", "Põltsamaa", "EE-50", nil},
+	{"EE-622", "Põlva", "EE-64", nil},
+	{"EE-624", "Pärnu", "EE-68", nil},
+	{"EE-638", "Põhja-Pärnumaa", "EE-68", nil},
+	{"EE-64", "Põlvamaa", "", nil},
+	{"EE-651", "Raasiku", "EE-37", nil},
+	{"EE-653", "Rae", "EE-37", nil},
+	{"EE-661", "Rakvere", "EE-60", nil},
+	{"EE-663", "Rakvere", "EE-60", nil},
+	{"EE-668", "Rapla", "EE-71", nil},
+	{"EE-68", "Pärnumaa", "", nil},
+	{"EE-689", "Ruhnu", "EE-74", nil},
+	{"EE-698", "Rõuge", "EE-87", nil},
+	{"EE-708", "Räpina", "EE-64", nil},
+	{"EE-71", "Raplamaa", "", nil},
+	{"EE-712", "Saarde", "EE-68", nil},
+	{"EE-714", "Saaremaa", "EE-74", nil},
+	{"EE-719", "Saku", "EE-37", nil},
+	{"EE-726", "Saue", "EE-37", nil},
+	{"EE-732", "Setomaa", "EE-87", nil},
+	{"EE-735", "Sillamäe", "EE-45", nil},
+	{"EE-74", "Saaremaa", "", nil},
+	{"EE-784", "Tallinn", "EE-37", nil},
+	{"EE-79", "Tartumaa", "", nil},
+	{"EE-792", "Tapa", "EE-60", nil},
+	{"EE-793", "Tartu", "EE-79", nil},
+	{"EE-796", "Tartu", "EE-79", nil},
+	{"EE-803", "Toila", "EE-45", nil},
+	{"EE-809", "Tori", "EE-68", nil},
+	{"EE-81", "Valgamaa", "", nil},
+	{"EE-824", "Tõrva", "EE-81", nil},
+	{"EE-834", "Türi", "EE-52", nil},
+	{"EE-84", "Viljandimaa", "", nil},
+	{"EE-855", "Valga", "EE-81", nil},
+	{"EE-87", "Võrumaa", "", nil},
+	{"EE-890", "Viimsi", "EE-37", nil},
+	{"EE-897", "Viljandi", "EE-84", nil},
+	{"EE-899", "Viljandi", "EE-84", nil},
+	{"EE-901", "Vinni", "EE-60", nil},
+	{"EE-903", "Viru-Nigula", "EE-60", nil},
+	{"EE-907", "Vormsi", "EE-56", nil},
+	{"EE-917", "Võru", "EE-87", nil},
+	{"EE-919", "Võru", "EE-87", nil},
+	{"EE-928", "Väike-Maarja", "EE-60", nil},
+
+	// Egypt
+	{"EG-ALX", "Al Iskandarīyah", "", nil},
+	{"EG-ASN", "Aswān", "", nil},
+	{"EG-AST", "Asyūţ", "", nil},
+	{"EG-BA", "Al Baḩr al Aḩmar", "", nil},
+	{"EG-BH", "Al Buḩayrah", "", nil},
+	{"EG-BNS", "Banī Suwayf", "", nil},
+	{"EG-C", "Al Qāhirah", "", nil},
+	{"EG-DK", "Ad Daqahlīyah", "", nil},
+	{"EG-DT", "Dumyāţ", "", nil},
+	{"EG-FYM", "Al Fayyūm", "", nil},
+	{"EG-GH", "Al Gharbīyah", "", nil},
+	{"EG-GZ", "Al Jīzah", "", nil},
+	{"EG-IS", "Al Ismā'īlīyah", "", nil},
+	{"EG-JS", "Janūb Sīnā'", "", nil},
+	{"EG-KB", "Al Qalyūbīyah", "", nil},
+	{"EG-KFS", "Kafr ash Shaykh", "", nil},
+	{"EG-KN", "Qinā", "", nil},
+	{"EG-LX", "Al Uqşur", "", nil},
+	{"EG-MN", "Al Minyā", "", nil},
+	{"EG-MNF", "Al Minūfīyah", "", nil},
+	{"EG-MT", "Maţrūḩ", "", nil},
+	{"EG-PTS", "Būr Sa‘īd", "", nil},
+	{"EG-SHG", "Sūhāj", "", nil},
+	{"EG-SHR", "Ash Sharqīyah", "", nil},
+	{"EG-SIN", "Shamāl Sīnā'", "", nil},
+	{"EG-SUZ", "As Suways", "", nil},
+	{"EG-WAD", "Al Wādī al Jadīd", "", nil},
+
+	// Eritrea
+	{"ER-AN", "Ansabā", "", nil},
+	{"ER-DK", "Debubawi K’eyyĭḥ Baḥri", "", nil},
+	{"ER-DU", "Al Janūbī", "", nil},
+	{"ER-GB", "Gash-Barka", "", nil},
+	{"ER-MA", "Al Awsaţ", "", nil},
+	{"ER-SK", "Semienawi K’eyyĭḥ Baḥri", "", nil},
+
+	// Spain
+	{"ES-A", "Alacant*", "ES-VC", nil},
+	{"ES-AB", "Albacete", "ES-CM", nil},
+	{"ES-AL", "Almería", "ES-AN", nil},
+	{"ES-AN", "Andalucía", "", nil},
+	{"ES-AR", "Aragón", "", nil},
+	{"ES-AS", "Asturias, Principado de", "", nil},
+	{"ES-AV", "Ávila", "ES-CL", nil},
+	{"ES-B", "Barcelona", "ES-CT", nil},
+	{"ES-BA", "Badajoz", "ES-EX", nil},
+	{"ES-BI", "Bizkaia", "ES-PV", nil},
+	{"ES-BU", "Burgos", "ES-CL", nil},
+	{"ES-C", "A Coruña", "ES-GA", []string{"La Coruña"}},
+	{"ES-CA", "Cádiz", "ES-AN", nil},
+	{"ES-CB", "Cantabria", "", nil},
+	{"ES-CC", "Cáceres", "ES-EX", nil},
+	{"ES-CE", "Ceuta", "", nil},
+	{"ES-CL", "Castilla y León", "", nil},
+	{"ES-CM", "Castilla-La Mancha", "", nil},
+	{"ES-CN", "Canarias", "", nil},
+	{"ES-CO", "Córdoba", "ES-AN", nil},
+	{"ES-CR", "Ciudad Real", "ES-CM", nil},
+	{"ES-CS", "Castelló*", "ES-VC", nil},
+	{"ES-CT", "Catalunya", "", []string{"Cataluña"}},
+	{"ES-CU", "Cuenca", "ES-CM", nil},
+	{"ES-EX", "Extremadura", "", nil},
+	{"ES-GA", "Galicia", "", nil},
+	{"ES-GC", "Las Palmas", "ES-CN", nil},
+	{"ES-GI", "Girona", "ES-CT", []string{"Gerona"}},
+	{"ES-GR", "Granada", "ES-AN", nil},
+	{"ES-GU", "Guadalajara", "ES-CM", nil},
+	{"ES-H", "Huelva", "ES-AN", nil},
+	{"ES-HU", "Huesca", "ES-AR", nil},
+	{"ES-IB", "Illes Balears", "", []string{"Islas Baleares"}},
+	{"ES-J", "Jaén", "ES-AN", nil},
+	{"ES-L", "Lleida", "ES-CT", []string{"Lérida"}},
+	{"ES-LE", "León", "ES-CL", nil},
+	{"ES-LO", "La Rioja", "ES-RI", nil},
+	{"ES-LU", "Lugo", "ES-GA", nil},
+	{"ES-M", "Madrid", "ES-MD", nil},
+	{"ES-MA", "Málaga", "ES-AN", nil},
+	{"ES-MC", "Murcia, Región de", "", nil},
+	{"ES-MD", "Madrid, Comunidad de", "", nil},
+	{"ES-ML", "Melilla", "", nil},
+	{"ES-MU", "Murcia", "ES-MC", nil},
+	{"ES-NA", "Nafarroa*", "ES-NC", nil},
+	{"ES-NC", "Nafarroako Foru Komunitatea*", "", nil},
+	{"ES-O", "Asturias", "ES-AS", nil},
+	{"ES-OR", "Ourense", "ES-GA", []string{"Orense"}},
+	{"ES-P", "Palencia", "ES-CL", nil},
+	{"ES-PM", "Illes Balears", "ES-IB", []string{"Islas Baleares"}},
+	{"ES-PO", "Pontevedra", "ES-GA", nil},
+	{"ES-PV", "Euskal Herria", "", nil},
+	{"ES-RI", "La Rioja", "", nil},
+	{"ES-S", "Cantabria", "ES-CB", nil},
+	{"ES-SA", "Salamanca", "ES-CL", nil},
+	{"ES-SE", "Sevilla", "ES-AN", nil},
+	{"ES-SG", "Segovia", "ES-CL", nil},
+	{"ES-SO", "Soria", "ES-CL", nil},
+	{"ES-SS", "Gipuzkoa", "ES-PV", nil},
+	{"ES-T", "Tarragona", "ES-CT", nil},
+	{"ES-TE", "Teruel", "ES-AR", nil},
+	{"ES-TF", "Santa Cruz de Tenerife", "ES-CN", nil},
+	{"ES-TO", "Toledo", "ES-CM", nil},
+	{"ES-V", "Valencia", "ES-VC", nil},
+	{"ES-VA", "Valladolid", "ES-CL", nil},
+	{"ES-VC", "Valenciana, Comunidad", "", nil},
+	{"ES-VI", "Araba*", "ES-PV", nil},
+	{"ES-Z", "Zaragoza", "ES-AR", nil},
+	{"ES-ZA", "Zamora", "ES-CL", nil},
+
+	// Ethiopia
+	{"ET-AA", "Addis Ababa", "", nil},
+	{"ET-AF", "Afar", "", nil},
+	{"ET-AM", "Amara", "", nil},
+	{"ET-BE", "Benshangul-Gumaz", "", nil},
+	{"ET-DD", "Dire Dawa", "", nil},
+	{"ET-GA", "Gambela Peoples", "", nil},
+	{"ET-HA", "Harari People", "", nil},
+	{"ET-OR", "Oromia", "", nil},
+	{"ET-SN", "Southern Nations, Nationalities and Peoples", "", nil},
+	{"ET-SO", "Somali", "", nil},
+	{"ET-TI", "Tigrai", "", nil},
+
+	// Finland
+	{"FI-01", "Åland", "", nil},
+	{"FI-02", "Etelä-Karjala", "", nil},
+	{"FI-03", "Etelä-Pohjanmaa", "", nil},
+	{"FI-04", "Etelä-Savo", "", nil},
+	{"FI-05", "Kainuu", "", nil},
+	{"FI-06", "Kanta-Häme", "", nil},
+	{"FI-07", "Keski-Pohjanmaa", "", nil},
+	{"FI-08", "Keski-Suomi", "", nil},
+	{"FI-09", "Kymenlaakso", "", nil},
+	{"FI-10", "Lappi", "", nil},
+	{"FI-11", "Pirkanmaa", "", nil},
+	{"FI-12", "Pohjanmaa", "", nil},
+	{"FI-13", "Pohjois-Karjala", "", nil},
+	{"FI-14", "Pohjois-Pohjanmaa", "", nil},
+	{"FI-15", "Pohjois-Savo", "", nil},
+	{"FI-16", "Päijät-Häme", "", nil},
+	{"FI-17", "Satakunta", "", nil},
+	{"FI-18", "Uusimaa", "", nil},
+	{"FI-19", "Varsinais-Suomi", "", nil},
+
+	// Fiji
+	{"FJ-01", "Ba", "FJ-W", nil},
+	{"FJ-02", "Bua", "FJ-N", nil},
+	{"FJ-03", "Cakaudrove", "FJ-N", nil},
+	{"FJ-04", "Kadavu", "FJ-E", nil},
+	{"FJ-05", "Lau", "FJ-E", nil},
+	{"FJ-06", "Lomaiviti", "FJ-E", nil},
+	{"FJ-07", "Macuata", "FJ-N", nil},
+	{"FJ-08", "Nadroga and Navosa", "FJ-W", nil},
+	{"FJ-09", "Naitasiri", "FJ-C", nil},
+	{"FJ-10", "Namosi", "FJ-C", nil},
+	{"FJ-11", "Ra", "FJ-W", nil},
+	{"FJ-12", "Rewa", "FJ-C", nil},
+	{"FJ-13", "Serua", "FJ-C", nil},
+	{"FJ-14", "Tailevu", "FJ-C", nil},
+	{"FJ-C", "Central", "", nil},
+	{"FJ-E", "Eastern", "", nil},
+	{"FJ-N", "Northern", "", nil},
+	{"FJ-R", "Rotuma", "", nil},
+	{"FJ-W", "Western", "", nil},
+
+	// Micronesia
+	{"FM-KSA", "Kosrae", "", nil},
+	{"FM-PNI", "Pohnpei", "", nil},
+	{"FM-TRK", "Chuuk", "", nil},
+	{"FM-YAP", "Yap", "", nil},
+
+	// France
+	{"FR-01", "Ain", "FR-ARA", nil},
+	{"FR-02", "Aisne", "FR-HDF", nil},
+	{"FR-03", "Allier", "FR-ARA", nil},
+	{"FR-04", "Alpes-de-Haute-Provence", "FR-PAC", nil},
+	{"FR-05", "Hautes-Alpes", "FR-PAC", nil},
+	{"FR-06", "Alpes-Maritimes", "FR-PAC", nil},
+	{"FR-07", "Ardèche", "FR-ARA", nil},
+	{"FR-08", "Ardennes", "FR-GES", nil},
+	{"FR-09", "Ariège", "FR-OCC", nil},
+	{"FR-10", "Aube", "FR-GES", nil},
+	{"FR-11", "Aude", "FR-OCC", nil},
+	{"FR-12", "Aveyron", "FR-OCC", nil},
+	{"FR-13", "Bouches-du-Rhône", "FR-PAC", nil},
+	{"FR-14", "Calvados", "FR-NOR", nil},
+	{"FR-15", "Cantal", "FR-ARA", nil},
+	{"FR-16", "Charente", "FR-NAQ", nil},
+	{"FR-17", "Charente-Maritime", "FR-NAQ", nil},
+	{"FR-18", "Cher", "FR-CVL", nil},
+	{"FR-19", "Corrèze", "FR-NAQ", nil},
+	{"FR-20R", "Corse", "", nil},
+	{"FR-21", "Côte-d'Or", "FR-BFC", nil},
+	{"FR-22", "Côtes-d'Armor", "FR-BRE", nil},
+	{"FR-23", "Creuse", "FR-NAQ", nil},
+	{"FR-24", "Dordogne", "FR-NAQ", nil},
+	{"FR-25", "Doubs", "FR-BFC", nil},
+	{"FR-26", "Drôme", "FR-ARA", nil},
+	{"FR-27", "Eure", "FR-NOR", nil},
+	{"FR-28", "Eure-et-Loir", "FR-CVL", nil},
+	{"FR-29", "Finistère", "FR-BRE", nil},
+	{"FR-2A", "Corse-du-Sud", "FR-20R", nil},
+	{"FR-2B", "Haute-Corse", "FR-20R", nil},
+	{"FR-30", "Gard", "FR-OCC", nil},
+	{"FR-31", "Haute-Garonne", "FR-OCC", nil},
+	{"FR-32", "Gers", "FR-OCC", nil},
+	{"FR-33", "Gironde", "FR-NAQ", nil},
+	{"FR-34", "Hérault", "FR-OCC", nil},
+	{"FR-35", "Ille-et-Vilaine", "FR-BRE", nil},
+	{"FR-36", "Indre", "FR-CVL", nil},
+	{"FR-37", "Indre-et-Loire", "FR-CVL", nil},
+	{"FR-38", "Isère", "FR-ARA", nil},
+	{"FR-39", "Jura", "FR-BFC", nil},
+	{"FR-40", "Landes", "FR-NAQ", nil},
+	{"FR-41", "Loir-et-Cher", "FR-CVL", nil},
+	{"FR-42", "Loire", "FR-ARA", nil},
+	{"FR-43", "Haute-Loire", "FR-ARA", nil},
+	{"FR-44", "Loire-Atlantique", "FR-PDL", nil},
+	{"FR-45", "Loiret", "FR-CVL", nil},
+	{"FR-46", "Lot", "FR-OCC", nil},
+	{"FR-47", "Lot-et-Garonne", "FR-NAQ", nil},
+	{"FR-48", "Lozère", "FR-OCC", nil},
+	{"FR-49", "Maine-et-Loire", "FR-PDL", nil},
+	{"FR-50", "Manche", "FR-NOR", nil},
+	{"FR-51", "Marne", "FR-GES", nil},
+	{"FR-52", "Haute-Marne", "FR-GES", nil},
+	{"FR-53", "Mayenne", "FR-PDL", nil},
+	{"FR-54", "Meurthe-et-Moselle", "FR-GES", nil},
+	{"FR-55", "Meuse", "FR-GES", nil},
+	{"FR-56", "Morbihan", "FR-BRE", nil},
+	{"FR-57", "Moselle", "FR-GES", nil},
+	{"FR-58", "Nièvre", "FR-BFC", nil},
+	{"FR-59", "Nord", "FR-HDF", nil},
+	{"FR-60", "Oise", "FR-HDF", nil},
+	{"FR-61", "Orne", "FR-NOR", nil},
+	{"FR-62", "Pas-de-Calais", "FR-HDF", nil},
+	{"FR-63", "Puy-de-Dôme", "FR-ARA", nil},
+	{"FR-64", "Pyrénées-Atlantiques", "FR-NAQ", nil},
+	{"FR-65", "Hautes-Pyrénées", "FR-OCC", nil},
+	{"FR-66", "Pyrénées-Orientales", "FR-OCC", nil},
+	{"FR-67", "Bas-Rhin", "FR-GES", nil},
+	{"FR-68", "Haut-Rhin", "FR-GES", nil},
+	{"FR-69", "Rhône", "FR-ARA", nil},
+	{"FR-70", "Haute-Saône", "FR-BFC", nil},
+	{"FR-71", "Saône-et-Loire", "FR-BFC", nil},
+	{"FR-72", "Sarthe", "FR-PDL", nil},
+	{"FR-73", "Savoie", "FR-ARA", nil},
+	{"FR-74", "Haute-Savoie", "FR-ARA", nil},
+	{"FR-75", "Paris", "FR-IDF", nil},
+	{"FR-76", "Seine-Maritime", "FR-NOR", nil},
+	{"FR-77", "Seine-et-Marne", "FR-IDF", nil},
+	{"FR-78", "Yvelines", "FR-IDF", nil},
+	{"FR-79", "Deux-Sèvres", "FR-NAQ", nil},
+	{"FR-80", "Somme", "FR-HDF", nil},
+	{"FR-81", "Tarn", "FR-OCC", nil},
+	{"FR-82", "Tarn-et-Garonne", "FR-OCC", nil},
+	{"FR-83", "Var", "FR-PAC", nil},
+	{"FR-84", "Vaucluse", "FR-PAC", nil},
+	{"FR-85", "Vendée", "FR-PDL", nil},
+	{"FR-86", "Vienne", "FR-NAQ", nil},
+	{"FR-87", "Haute-Vienne", "FR-NAQ", nil},
+	{"FR-88", "Vosges", "FR-GES", nil},
+	{"FR-89", "Yonne", "FR-BFC", nil},
+	{"FR-90", "Territoire de Belfort", "FR-BFC", nil},
+	{"FR-91", "Essonne", "FR-IDF", nil},
+	{"FR-92", "Hauts-de-Seine", "FR-IDF", nil},
+	{"FR-93", "Seine-Saint-Denis", "FR-IDF", nil},
+	{"FR-94", "Val-de-Marne", "FR-IDF", nil},
+	{"FR-95", "Val-d'Oise", "FR-IDF", nil},
+	{"FR-971", "Guadeloupe", "FR-GP", nil},
+	{"FR-972", "Martinique", "FR-MQ", nil},
+	{"FR-973", "Guyane (française)", "FR-GF", []string{"Guyane"}},
+	{"FR-974", "La Réunion", "FR-RE", nil},
+	{"FR-976", "Mayotte", "FR-YT", nil},
+	{"FR-ARA", "Auvergne-Rhône-Alpes", "", nil},
+	{"FR-BFC", "Bourgogne-Franche-Comté", "", nil},
+	{"FR-BL", "Saint-Barthélemy", "", nil},
+	{"FR-BRE", "Bretagne", "", nil},
+	{"FR-CP", "Clipperton", "", nil},
+	{"FR-CVL", "Centre-Val de Loire", "", nil},
+	{"FR-GES", "Grand-Est", "", nil},
+	{"FR-GF", "Guyane (française)", "", []string{"Guyane"}},
+	{"FR-GP", "Guadeloupe", "", nil},
+	{"FR-HDF", "Hauts-de-France", "", nil},
+	{"FR-IDF", "Île-de-France", "", nil},
+	{"FR-MF", "Saint-Martin", "", nil},
+	{"FR-MQ", "Martinique", "", nil},
+	{"FR-NAQ", "Nouvelle-Aquitaine", "", nil},
+	{"FR-NC", "Nouvelle-Calédonie", "", nil},
+	{"FR-NOR", "Normandie", "", nil},
+	{"FR-OCC", "Occitanie", "", nil},
+	{"FR-PAC", "Provence-Alpes-Côte-d’Azur", "", nil},
+	{"FR-PDL", "Pays-de-la-Loire", "", nil},
+	{"FR-PF", "Polynésie française", "", nil},
+	{"FR-PM", "Saint-Pierre-et-Miquelon", "", nil},
+	{"FR-RE", "La Réunion", "", nil},
+	{"FR-TF", "Terres australes françaises", "", nil},
+	{"FR-WF", "Wallis-et-Futuna", "", nil},
+	{"FR-YT", "Mayotte", "", nil},
+
+	// Gabon
+	{"GA-1", "Estuaire", "", nil},
+	{"GA-2", "Haut-Ogooué", "", nil},
+	{"GA-3", "Moyen-Ogooué", "", nil},
+	{"GA-4", "Ngounié", "", nil},
+	{"GA-5", "Nyanga", "", nil},
+	{"GA-6", "Ogooué-Ivindo", "", nil},
+	{"GA-7", "Ogooué-Lolo", "", nil},
+	{"GA-8", "Ogooué-Maritime", "", nil},
+	{"GA-9", "Woleu-Ntem", "", nil},
+
+	// United Kingdom
+	{"GB-ABC", "Armagh City, Banbridge and Craigavon", "GB-NIR", nil},
+	{"GB-ABD", "Aberdeenshire", "GB-SCT", nil},
+	{"GB-ABE", "Aberdeen City", "GB-SCT", nil},
+	{"GB-AGB", "Argyll and Bute", "GB-SCT", nil},
+	{"GB-AGY", "Isle of Anglesey", "GB-WLS", []string{"Sir Ynys Môn", "GB-YNM"}},
+	{"GB-AND", "Ards and North Down", "GB-NIR", nil},
+	{"GB-ANN", "Antrim and Newtownabbey", "GB-NIR", nil},
+	{"GB-ANS", "Angus", "GB-SCT", nil},
+	{"GB-BAS", "Bath and North East Somerset", "GB-ENG", nil},
+	{"GB-BBD", "Blackburn with Darwen", "GB-ENG", nil},
+	{"GB-BCP", "Bournemouth, Christchurch and Poole", "GB-ENG", nil},
+	{"GB-BDF", "Bedford", "GB-ENG", nil},
+	{"GB-BDG", "Barking and Dagenham", "GB-ENG", nil},
+	{"GB-BEN", "Brent", "GB-ENG", nil},
+	{"GB-BEX", "Bexley", "GB-ENG", nil},
+	{"GB-BFS", "Belfast City", "GB-NIR", nil},
+	{"GB-BGE", "Bridgend", "GB-WLS", []string{"Pen-y-bont ar Ogwr", "GB-POG"}},
+	{"GB-BGW", "Blaenau Gwent", "GB-WLS", nil},
+	{"GB-BIR", "Birmingham", "GB-ENG", nil},
+	{"GB-BKM", "Buckinghamshire", "GB-ENG", nil},
+	{"GB-BNE", "Barnet", "GB-ENG", nil},
+	{"GB-BNH", "Brighton and Hove", "GB-ENG", nil},
+	{"GB-BNS", "Barnsley", "GB-ENG", nil},
+	{"GB-BOL", "Bolton", "GB-ENG", nil},
+	{"GB-BPL", "Blackpool", "GB-ENG", nil},
+	{"GB-BRC", "Bracknell Forest", "GB-ENG", nil},
+	{"GB-BRD", "Bradford", "GB-ENG", nil},
+	{"GB-BRY", "Bromley", "GB-ENG", nil},
+	{"GB-BST", "Bristol, City of", "GB-ENG", nil},
+	{"GB-BUR", "Bury", "GB-ENG", nil},
+	{"GB-CAM", "Cambridgeshire", "GB-ENG", nil},
+	{"GB-CAY", "Caerphilly", "GB-WLS", []string{"Caerffili", "GB-CAF"}},
+	{"GB-CBF", "Central Bedfordshire", "GB-ENG", nil},
+	{"GB-CCG", "Causeway Coast and Glens", "GB-NIR", nil},
+	{"GB-CGN", "Ceredigion", "GB-WLS", []string{"Sir Ceredigion"}},
+	{"GB-CHE", "Cheshire East", "GB-ENG", nil},
+	{"GB-CHW", "Cheshire West and Chester", "GB-ENG", nil},
+	{"GB-CLD", "Calderdale", "GB-ENG", nil},
+	{"GB-CLK", "Clackmannanshire", "GB-SCT", nil},
+	{"GB-CMA", "Cumbria", "GB-ENG", nil},
+	{"GB-CMD", "Camden", "GB-ENG", nil},
+	{"GB-CMN", "Carmarthenshire", "GB-WLS", []string{"Sir Gaerfyrddin", "GB-GFY"}},
+	{"GB-CON", "Cornwall", "GB-ENG", nil},
+	{"GB-COV", "Coventry", "GB-ENG", nil},
+	{"GB-CRF", "Cardiff", "GB-WLS", []string{"Caerdydd", "GB-CRD"}},
+	{"GB-CRY", "Croydon", "GB-ENG", nil},
+	{"GB-CWY", "Conwy", "GB-WLS", nil},
+	{"GB-DAL", "Darlington", "GB-ENG", nil},
+	{"GB-DBY", "Derbyshire", "GB-ENG", nil},
+	{"GB-DEN", "Denbighshire", "GB-WLS", []string{"Sir Ddinbych", "GB-DDB"}},
+	{"GB-DER", "Derby", "GB-ENG", nil},
+	{"GB-DEV", "Devon", "GB-ENG", nil},
+	{"GB-DGY", "Dumfries and Galloway", "GB-SCT", nil},
+	{"GB-DNC", "Doncaster", "GB-ENG", nil},
+	{"GB-DND", "Dundee City", "GB-SCT", nil},
+	{"GB-DOR", "Dorset", "GB-ENG", nil},
+	{"GB-DRS", "Derry and Strabane", "GB-NIR", nil},
+	{"GB-DUD", "Dudley", "GB-ENG", nil},
+	{"GB-DUR", "Durham, County", "GB-ENG", nil},
+	{"GB-EAL", "Ealing", "GB-ENG", nil},
+	{"GB-EAY", "East Ayrshire", "GB-SCT", nil},
+	{"GB-EDH", "Edinburgh, City of", "GB-SCT", nil},
+	{"GB-EDU", "East Dunbartonshire", "GB-SCT", nil},
+	{"GB-ELN", "East Lothian", "GB-SCT", nil},
+	{"GB-ELS", "Eilean Siar", "GB-SCT", nil},
+	{"GB-ENF", "Enfield", "GB-ENG", nil},
+	{"GB-ENG", "England", "", nil},
+	{"GB-ERW", "East Renfrewshire", "GB-SCT", nil},
+	{"GB-ERY", "East Riding of Yorkshire", "GB-ENG", nil},
+	{"GB-ESS", "Essex", "GB-ENG", nil},
+	{"GB-ESX", "East Sussex", "GB-ENG", nil},
+	{"GB-FAL", "Falkirk", "GB-SCT", nil},
+	{"GB-FIF", "Fife", "GB-SCT", nil},
+	{"GB-FLN", "Flintshire", "GB-WLS", []string{"Sir y Fflint", "GB-FFL"}},
+	{"GB-FMO", "Fermanagh and Omagh", "GB-NIR", nil},
+	{"GB-GAT", "Gateshead", "GB-ENG", nil},
+	{"GB-GLG", "Glasgow City", "GB-SCT", nil},
+	{"GB-GLS", "Gloucestershire", "GB-ENG", nil},
+	{"GB-GRE", "Greenwich", "GB-ENG", nil},
+	{"GB-GWN", "Gwynedd", "GB-WLS", nil},
+	{"GB-HAL", "Halton", "GB-ENG", nil},
+	{"GB-HAM", "Hampshire", "GB-ENG", nil},
+	{"GB-HAV", "Havering", "GB-ENG", nil},
+	{"GB-HCK", "Hackney", "GB-ENG", nil},
+	{"GB-HEF", "Herefordshire", "GB-ENG", nil},
+	{"GB-HIL", "Hillingdon", "GB-ENG", nil},
+	{"GB-HLD", "Highland", "GB-SCT", nil},
+	{"GB-HMF", "Hammersmith and Fulham", "GB-ENG", nil},
+	{"GB-HNS", "Hounslow", "GB-ENG", nil},
+	{"GB-HPL", "Hartlepool", "GB-ENG", nil},
+	{"GB-HRT", "Hertfordshire", "GB-ENG", nil},
+	{"GB-HRW", "Harrow", "GB-ENG", nil},
+	{"GB-HRY", "Haringey", "GB-ENG", nil},
+	{"GB-IOS", "Isles of Scilly", "GB-ENG", nil},
+	{"GB-IOW", "Isle of Wight", "GB-ENG", nil},
+	{"GB-ISL", "Islington", "GB-ENG", nil},
+	{"GB-IVC", "Inverclyde", "GB-SCT", nil},
+	{"GB-KEC", "Kensington and Chelsea", "GB-ENG", nil},
+	{"GB-KEN", "Kent", "GB-ENG", nil},
+	{"GB-KHL", "Kingston upon Hull", "GB-ENG", nil},
+	{"GB-KIR", "Kirklees", "GB-ENG", nil},
+	{"GB-KTT", "Kingston upon Thames", "GB-ENG", nil},
+	{"GB-KWL", "Knowsley", "GB-ENG", nil},
+	{"GB-LAN", "Lancashire", "GB-ENG", nil},
+	{"GB-LBC", "Lisburn and Castlereagh", "GB-NIR", nil},
+	{"GB-LBH", "Lambeth", "GB-ENG", nil},
+	{"GB-LCE", "Leicester", "GB-ENG", nil},
+	{"GB-LDS", "Leeds", "GB-ENG", nil},
+	{"GB-LEC", "Leicestershire", "GB-ENG", nil},
+	{"GB-LEW", "Lewisham", "GB-ENG", nil},
+	{"GB-LIN", "Lincolnshire", "GB-ENG", nil},
+	{"GB-LIV", "Liverpool", "GB-ENG", nil},
+	{"GB-LND", "London, City of", "GB-ENG", nil},
+	{"GB-LUT", "Luton", "GB-ENG", nil},
+	{"GB-MAN", "Manchester", "GB-ENG", nil},
+	{"GB-MDB", "Middlesbrough", "GB-ENG", nil},
+	{"GB-MDW", "Medway", "GB-ENG", nil},
+	{"GB-MEA", "Mid and East Antrim", "GB-NIR", nil},
+	{"GB-MIK", "Milton Keynes", "GB-ENG", nil},
+	{"GB-MLN", "Midlothian", "GB-SCT", nil},
+	{"GB-MON", "Monmouthshire", "GB-WLS", []string{"Sir Fynwy", "GB-FYN"}},
+	{"GB-MRT", "Merton", "GB-ENG", nil},
+	{"GB-MRY", "Moray", "GB-SCT", nil},
+	{"GB-MTY", "Merthyr Tydfil", "GB-WLS", []string{"Merthyr Tudful", "GB-MTU"}},
+	{"GB-MUL", "Mid-Ulster", "GB-NIR", nil},
+	{"GB-NAY", "North Ayrshire", "GB-SCT", nil},
+	{"GB-NBL", "Northumberland", "GB-ENG", nil},
+	{"GB-NEL", "North East Lincolnshire", "GB-ENG", nil},
+	{"GB-NET", "Newcastle upon Tyne", "GB-ENG", nil},
+	{"GB-NFK", "Norfolk", "GB-ENG", nil},
+	{"GB-NGM", "Nottingham", "GB-ENG", nil},
+	{"GB-NIR", "Northern Ireland", "", nil},
+	{"GB-NLK", "North Lanarkshire", "GB-SCT", nil},
+	{"GB-NLN", "North Lincolnshire", "GB-ENG", nil},
+	{"GB-NMD", "Newry, Mourne and Down", "GB-NIR", nil},
+	{"GB-NSM", "North Somerset", "GB-ENG", nil},
+	{"GB-NTH", "Northamptonshire", "GB-ENG", nil},
+	{"GB-NTL", "Neath Port Talbot", "GB-WLS", []string{"Castell-nedd Port Talbot", "GB-CTL"}},
+	{"GB-NTT", "Nottinghamshire", "GB-ENG", nil},
+	{"GB-NTY", "North Tyneside", "GB-ENG", nil},
+	{"GB-NWM", "Newham", "GB-ENG", nil},
+	{"GB-NWP", "Newport", "GB-WLS", []string{"Casnewydd", "GB-CNW"}},
+	{"GB-NYK", "North Yorkshire", "GB-ENG", nil},
+	{"GB-OLD", "Oldham", "GB-ENG", nil},
+	{"GB-ORK", "Orkney Islands", "GB-SCT", nil},
+	{"GB-OXF", "Oxfordshire", "GB-ENG", nil},
+	{"GB-PEM", "Pembrokeshire", "GB-WLS", []string{"Sir Benfro", "GB-BNF"}},
+	{"GB-PKN", "Perth and Kinross", "GB-SCT", nil},
+	{"GB-PLY", "Plymouth", "GB-ENG", nil},
+	{"GB-POR", "Portsmouth", "GB-ENG", nil},
+	{"GB-POW", "Powys", "GB-WLS", nil},
+	{"GB-PTE", "Peterborough", "GB-ENG", nil},
+	{"GB-RCC", "Redcar and Cleveland", "GB-ENG", nil},
+	{"GB-RCH", "Rochdale", "GB-ENG", nil},
+	{"GB-RCT", "Rhondda Cynon Taff", "GB-WLS", []string{"Rhondda CynonTaf"}},
+	{"GB-RDB", "Redbridge", "GB-ENG", nil},
+	{"GB-RDG", "Reading", "GB-ENG", nil},
+	{"GB-RFW", "Renfrewshire", "GB-SCT", nil},
+	{"GB-RIC", "Richmond upon Thames", "GB-ENG", nil},
+	{"GB-ROT", "Rotherham", "GB-ENG", nil},
+	{"GB-RUT", "Rutland", "GB-ENG", nil},
+	{"GB-SAW", "Sandwell", "GB-ENG", nil},
+	{"GB-SAY", "South Ayrshire", "GB-SCT", nil},
+	{"GB-SCB", "Scottish Borders", "GB-SCT", nil},
+	{"GB-SCT", "Scotland", "", nil},
+	{"GB-SFK", "Suffolk", "GB-ENG", nil},
+	{"GB-SFT", "Sefton", "GB-ENG", nil},
+	{"GB-SGC", "South Gloucestershire", "GB-ENG", nil},
+	{"GB-SHF", "Sheffield", "GB-ENG", nil},
+	{"GB-SHN", "St. Helens", "GB-ENG", nil},
+	{"GB-SHR", "Shropshire", "GB-ENG", nil},
+	{"GB-SKP", "Stockport", "GB-ENG", nil},
+	{"GB-SLF", "Salford", "GB-ENG", nil},
+	{"GB-SLG", "Slough", "GB-ENG", nil},
+	{"GB-SLK", "South Lanarkshire", "GB-SCT", nil},
+	{"GB-SND", "Sunderland", "GB-ENG", nil},
+	{"GB-SOL", "Solihull", "GB-ENG", nil},
+	{"GB-SOM", "Somerset", "GB-ENG", nil},
+	{"GB-SOS", "Southend-on-Sea", "GB-ENG", nil},
+	{"GB-SRY", "Surrey", "GB-ENG", nil},
+	{"GB-STE", "Stoke-on-Trent", "GB-ENG", nil},
+	{"GB-STG", "Stirling", "GB-SCT", nil},
+	{"GB-STH", "Southampton", "GB-ENG", nil},
+	{"GB-STN", "Sutton", "GB-ENG", nil},
+	{"GB-STS", "Staffordshire", "GB-ENG", nil},
+	{"GB-STT", "Stockton-on-Tees", "GB-ENG", nil},
+	{"GB-STY", "South Tyneside", "GB-ENG", nil},
+	{"GB-SWA", "Swansea", "GB-WLS", []string{"Abertawe", "GB-ATA"}},
+	{"GB-SWD", "Swindon", "GB-ENG", nil},
+	{"GB-SWK", "Southwark", "GB-ENG", nil},
+	{"GB-TAM", "Tameside", "GB-ENG", nil},
+	{"GB-TFW", "Telford and Wrekin", "GB-ENG", nil},
+	{"GB-THR", "Thurrock", "GB-ENG", nil},
+	{"GB-TOB", "Torbay", "GB-ENG", nil},
+	{"GB-TOF", "Torfaen", "GB-WLS", []string{"Tor-faen"}},
+	{"GB-TRF", "Trafford", "GB-ENG", nil},
+	{"GB-TWH", "Tower Hamlets", "GB-ENG", nil},
+	{"GB-VGL", "Vale of Glamorgan, The", "GB-WLS", []string{"Bro Morgannwg", "GB-BMG", "Vale of Glamorgan"}},
+	{"GB-WAR", "Warwickshire", "GB-ENG", nil},
+	{"GB-WBK", "West Berkshire", "GB-ENG", nil},
+	{"GB-WDU", "West Dunbartonshire", "GB-SCT", nil},
+	{"GB-WFT", "Waltham Forest", "GB-ENG", nil},
+	{"GB-WGN", "Wigan", "GB-ENG", nil},
+	{"GB-WIL", "Wiltshire", "GB-ENG", nil},
+	{"GB-WKF", "Wakefield", "GB-ENG", nil},
+	{"GB-WLL", "Walsall", "GB-ENG", nil},
+	{"GB-WLN", "West Lothian", "GB-SCT", nil},
+	{"GB-WLS", "Wales", "", []string{"Cymru", "GB-CYM"}},
+	{"GB-WLV", "Wolverhampton", "GB-ENG", nil},
+	{"GB-WND", "Wandsworth", "GB-ENG", nil},
+	{"GB-WNM", "Windsor and Maidenhead", "GB-ENG", nil},
+	{"GB-WOK", "Wokingham", "GB-ENG", nil},
+	{"GB-WOR", "Worcestershire", "GB-ENG", nil},
+	{"GB-WRL", "Wirral", "GB-ENG", nil},
+	{"GB-WRT", "Warrington", "GB-ENG", nil},
+	{"GB-WRX", "Wrexham", "GB-WLS", []string{"Wrecsam", "GB-WRC"}},
+	{"GB-WSM", "Westminster", "GB-ENG", nil},
+	{"GB-WSX", "West Sussex", "GB-ENG", nil},
+	{"GB-YOR", "York", "GB-ENG", nil},
+	{"GB-ZET", "Shetland Islands", "GB-SCT", nil},
+
+	// Grenada
+	{"GD-01", "Saint Andrew", "", nil},
+	{"GD-02", "Saint David", "", nil},
+	{"GD-03", "Saint George", "", nil},
+	{"GD-04", "Saint John", "", nil},
+	{"GD-05", "Saint Mark", "", nil},
+	{"GD-06", "Saint Patrick", "", nil},
+	{"GD-10", "Southern Grenadine Islands", "", nil},
+
+	// Georgia
+	{"GE-AB", "Abkhazia", "", nil},
+	{"GE-AJ", "Ajaria", "", nil},
+	{"GE-GU", "Guria", "", nil},
+	{"GE-IM", "Imereti", "", nil},
+	{"GE-KA", "K'akheti", "", nil},
+	{"GE-KK", "Kvemo Kartli", "", nil},
+	{"GE-MM", "Mtskheta-Mtianeti", "", nil},
+	{"GE-RL", "Rach'a-Lechkhumi-Kvemo Svaneti", "", nil},
+	{"GE-SJ", "Samtskhe-Javakheti", "", nil},
+	{"GE-SK", "Shida Kartli", "", nil},
+	{"GE-SZ", "Samegrelo-Zemo Svaneti", "", nil},
+	{"GE-TB", "Tbilisi", "", nil},
+
+	// Ghana
+	{"GH-AA", "Greater Accra", "", nil},
+	{"GH-AF", "Ahafo", "", nil},
+	{"GH-AH", "Ashanti", "", nil},
+	{"GH-BE", "Bono East", "", nil},
+	{"GH-BO", "Bono", "", nil},
+	{"GH-CP", "Central", "", nil},
+	{"GH-EP", "Eastern", "", nil},
+	{"GH-NE", "North East", "", nil},
+	{"GH-NP", "Northern", "", nil},
+	{"GH-OT", "Oti", "", nil},
+	{"GH-SV", "Savannah", "", nil},
+	{"GH-TV", "Volta", "", nil},
+	{"GH-UE", "Upper East", "", nil},
+	{"GH-UW", "Upper West", "", nil},
+	{"GH-WN", "Western North", "", nil},
+	{"GH-WP", "Western", "", nil},
+
+	// Greenland
+	{"GL-AV", "Avannaata Kommunia", "", nil},
+	{"GL-KU", "Kommune Kujalleq", "", nil},
+	{"GL-QE", "Qeqqata Kommunia", "", nil},
+	{"GL-QT", "Kommune Qeqertalik", "", nil},
+	{"GL-SM", "Kommuneqarfik Sermersooq", "", nil},
+
+	// Gambia
+	{"GM-B", "Banjul", "", nil},
+	{"GM-L", "Lower River", "", nil},
+	{"GM-M", "Central River", "", nil},
+	{"GM-N", "North Bank", "", nil},
+	{"GM-U", "Upper River", "", nil},
+	{"GM-W", "Western", "", nil},
+
+	// Guinea
+	{"GN-B", "Boké", "", nil},
+	{"GN-BE", "Beyla", "GN-N", nil},
+	{"GN-BF", "Boffa", "GN-B", nil},
+	{"GN-BK", "Boké", "GN-B", nil},
+	{"GN-C", "Conakry", "", nil},
+	{"GN-CO", "Coyah", "GN-D", nil},
+	{"GN-D", "Kindia", "", nil},
+	{"GN-DB", "Dabola", "GN-F", nil},
+	{"GN-DI", "Dinguiraye", "GN-F", nil},
+	{"GN-DL", "Dalaba", "GN-M", nil},
+	{"GN-DU", "Dubréka", "GN-D", nil},
+	{"GN-F", "Faranah", "", nil},
+	{"GN-FA", "Faranah", "GN-F", nil},
+	{"GN-FO", "Forécariah", "GN-D", nil},
+	{"GN-FR", "Fria", "GN-B", nil},
+	{"GN-GA", "Gaoual", "GN-B", nil},
+	{"GN-GU", "Guékédou", "GN-N", nil},
+	{"GN-K", "Kankan", "", nil},
+	{"GN-KA", "Kankan", "GN-K", nil},
+	{"GN-KB", "Koubia", "GN-L", nil},
+	{"GN-KD", "Kindia", "GN-D", nil},
+	{"GN-KE", "Kérouané", "GN-K", nil},
+	{"GN-KN", "Koundara", "GN-B", nil},
+	{"GN-KO", "Kouroussa", "GN-K", nil},
+	{"GN-KS", "Kissidougou", "GN-F", nil},
+	{"GN-L", "Labé", "", nil},
+	{"GN-LA", "Labé", "GN-L", nil},
+	{"GN-LE", "Lélouma", "GN-L", nil},
+	{"GN-LO", "Lola", "GN-N", nil},
+	{"GN-M", "Mamou", "", nil},
+	{"GN-MC", "Macenta", "GN-N", nil},
+	{"GN-MD", "Mandiana", "GN-K", nil},
+	{"GN-ML", "Mali", "GN-L", nil},
+	{"GN-MM", "Mamou", "GN-M", nil},
+	{"GN-N", "Nzérékoré", "", nil},
+	{"GN-NZ", "Nzérékoré", "GN-N", nil},
+	{"GN-PI", "Pita", "GN-M", nil},
+	{"GN-SI", "Siguiri", "GN-K", nil},
+	{"GN-TE", "Télimélé", "GN-D", nil},
+	{"GN-TO", "Tougué", "GN-L", nil},
+	{"GN-YO", "Yomou", "GN-N", nil},
+
+	// Equatorial Guinea
+	{"GQ-AN", "Annobon", "GQ-I", nil},
+	{"GQ-BN", "Bioko Nord", "GQ-I", nil},
+	{"GQ-BS", "Bioko Sud", "GQ-I", nil},
+	{"GQ-C", "Região Continental", "", nil},
+	{"GQ-CS", "Centro Sud", "GQ-C", nil},
+	{"GQ-DJ", "Djibloho", "GQ-C", nil},
+	{"GQ-I", "Região Insular", "", nil},
+	{"GQ-KN", "Kié-Ntem", "GQ-C", nil},
+	{"GQ-LI", "Litoral", "GQ-C", nil},
+	{"GQ-WN", "Wele-Nzas", "GQ-C", nil},
+
+	// Greece
+	{"GR-69", "Ágion Óros", "", nil},
+	{"GR-A", "Anatolikí Makedonía kai Thráki", "", nil},
+	{"GR-B", "Kentrikí Makedonía", "", nil},
+	{"GR-C", "Dytikí Makedonía", "", nil},
+	{"GR-D", "Ípeiros", "", nil},
+	{"GR-E", "Thessalía", "", nil},
+	{"GR-F", "Ionía Nísia", "", nil},
+	{"GR-G", "Dytikí Elláda", "", nil},
+	{"GR-H", "Stereá Elláda", "", nil},
+	{"GR-I", "Attikí", "", nil},
+	{"GR-J", "Pelopónnisos", "", nil},
+	{"GR-K", "Vóreio Aigaío", "", nil},
+	{"GR-L", "Nótio Aigaío", "", nil},
+	{"GR-M", "Kríti", "", nil},
+
+	// Guatemala
+	{"GT-AV", "Alta Verapaz", "", nil},
+	{"GT-BV", "Baja Verapaz", "", nil},
+	{"GT-CM", "Chimaltenango", "", nil},
+	{"GT-CQ", "Chiquimula", "", nil},
+	{"GT-ES", "Escuintla", "", nil},
+	{"GT-GU", "Guatemala", "", nil},
+	{"GT-HU", "Huehuetenango", "", nil},
+	{"GT-IZ", "Izabal", "", nil},
+	{"GT-JA", "Jalapa", "", nil},
+	{"GT-JU", "Jutiapa", "", nil},
+	{"GT-PE", "Petén", "", nil},
+	{"GT-PR", "El Progreso", "", nil},
+	{"GT-QC", "Quiché", "", nil},
+	{"GT-QZ", "Quetzaltenango", "", nil},
+	{"GT-RE", "Retalhuleu", "", nil},
+	{"GT-SA", "Sacatepéquez", "", nil},
+	{"GT-SM", "San Marcos", "", nil},
+	{"GT-SO", "Sololá", "", nil},
+	{"GT-SR", "Santa Rosa", "", nil},
+	{"GT-SU", "Suchitepéquez", "", nil},
+	{"GT-TO", "Totonicapán", "", nil},
+	{"GT-ZA", "Zacapa", "", nil},
+
+	// Guinea-Bissau
+	{"GW-BA", "Bafatá", "GW-L", nil},
+	{"GW-BL", "Bolama / Bijagós", "GW-S", []string{"Bolama", "Bijagós"}},
+	{"GW-BM", "Biombo", "GW-N", nil},
+	{"GW-BS", "Bissau", "", nil},
+	{"GW-CA", "Cacheu", "GW-N", nil},
+	{"GW-GA", "Gabú", "GW-L", nil},
+	{"GW-L", "Leste", "", nil},
+	{"GW-N", "Norte", "", nil},
+	{"GW-OI", "Oio", "GW-N", nil},
+	{"GW-QU", "Quinara", "GW-S", nil},
+	{"GW-S", "Sul", "", nil},
+	{"GW-TO", "Tombali", "GW-S", nil},
+
+	// Guyana
+	{"GY-BA", "Barima-Waini", "", nil},
+	{"GY-CU", "Cuyuni-Mazaruni", "", nil},
+	{"GY-DE", "Demerara-Mahaica", "", nil},
+	{"GY-EB", "East Berbice-Corentyne", "", nil},
+	{"GY-ES", "Essequibo Islands-West Demerara", "", nil},
+	{"GY-MA", "Mahaica-Berbice", "", nil},
+	{"GY-PM", "Pomeroon-Supenaam", "", nil},
+	{"GY-PT", "Potaro-Siparuni", "", nil},
+	{"GY-UD", "Upper Demerara-Berbice", "", nil},
+	{"GY-UT", "Upper Takutu-Upper Essequibo", "", nil},
+
+	// Honduras
+	{"HN-AT", "Atlántida", "", nil},
+	{"HN-CH", "Choluteca", "", nil},
+	{"HN-CL", "Colón", "", nil},
+	{"HN-CM", "Comayagua", "", nil},
+	{"HN-CP", "Copán", "", nil},
+	{"HN-CR", "Cortés", "", nil},
+	{"HN-EP", "El Paraíso", "", nil},
+	{"HN-FM", "Francisco Morazán", "", nil},
+	{"HN-GD", "Gracias a Dios", "", nil},
+	{"HN-IB", "Islas de la Bahía", "", nil},
+	{"HN-IN", "Intibucá", "", nil},
+	{"HN-LE", "Lempira", "", nil},
+	{"HN-LP", "La Paz", "", nil},
+	{"HN-OC", "Ocotepeque", "", nil},
+	{"HN-OL", "Olancho", "", nil},
+	{"HN-SB", "Santa Bárbara", "", nil},
+	{"HN-VA", "Valle", "", nil},
+	{"HN-YO", "Yoro", "", nil},
+
+	// Croatia
+	{"HR-01", "Zagrebačka županija", "", nil},
+	{"HR-02", "Krapinsko-zagorska županija", "", nil},
+	{"HR-03", "Sisačko-moslavačka županija", "", nil},
+	{"HR-04", "Karlovačka županija", "", nil},
+	{"HR-05", "Varaždinska županija", "", nil},
+	{"HR-06", "Koprivničko-križevačka županija", "", nil},
+	{"HR-07", "Bjelovarsko-bilogorska županija", "", nil},
+	{"HR-08", "Primorsko-goranska županija", "", nil},
+	{"HR-09", "Ličko-senjska županija", "", nil},
+	{"HR-10", "Virovitičko-podravska županija", "", nil},
+	{"HR-11", "Požeško-slavonska županija", "", nil},
+	{"HR-12", "Brodsko-posavska županija", "", nil},
+	{"HR-13", "Zadarska županija", "", nil},
+	{"HR-14", "Osječko-baranjska županija", "", nil},
+	{"HR-15", "Šibensko-kninska županija", "", nil},
+	{"HR-16", "Vukovarsko-srijemska županija", "", nil},
+	{"HR-17", "Splitsko-dalmatinska županija", "", nil},
+	{"HR-18", "Istarska županija", "", nil},
+	{"HR-19", "Dubrovačko-neretvanska županija", "", nil},
+	{"HR-20", "Međimurska županija", "", nil},
+	{"HR-21", "Grad Zagreb", "", nil},
+
+	// Haiti
+	{"HT-AR", "Artibonite", "", nil},
+	{"HT-CE", "Centre", "", nil},
+	{"HT-GA", "Grandans", "", nil},
+	{"HT-ND", "Nord", "", nil},
+	{"HT-NE", "Nord-Est", "", nil},
+	{"HT-NI", "Nip", "", nil},
+	{"HT-NO", "Nord-Ouest", "", nil},
+	{"HT-OU", "Lwès", "", nil},
+	{"HT-SD", "Sid", "", nil},
+	{"HT-SE", "Sidès", "", nil},
+
+	// Hungary
+	{"HU-BA", "Baranya", "", nil},
+	{"HU-BC", "Békéscsaba", "", nil},
+	{"HU-BE", "Békés", "", nil},
+	{"HU-BK", "Bács-Kiskun", "", nil},
+	{"HU-BU", "Budapest", "", nil},
+	{"HU-BZ", "Borsod-Abaúj-Zemplén", "", nil},
+	{"HU-CS", "Csongrád", "", nil},
+	{"HU-DE", "Debrecen", "", nil},
+	{"HU-DU", "Dunaújváros", "", nil},
+	{"HU-EG", "Eger", "", nil},
+	{"HU-ER", "Érd", "", nil},
+	{"HU-FE", "Fejér", "", nil},
+	{"HU-GS", "Győr-Moson-Sopron", "", nil},
+	{"HU-GY", "Győr", "", nil},
+	{"HU-HB", "Hajdú-Bihar", "", nil},
+	{"HU-HE", "Heves", "", nil},
+	{"HU-HV", "Hódmezővásárhely", "", nil},
+	{"HU-JN", "Jász-Nagykun-Szolnok", "", nil},
+	{"HU-KE", "Komárom-Esztergom", "", nil},
+	{"HU-KM", "Kecskemét", "", nil},
+	{"HU-KV", "Kaposvár", "", nil},
+	{"HU-MI", "Miskolc", "", nil},
+	{"HU-NK", "Nagykanizsa", "", nil},
+	{"HU-NO", "Nógrád", "", nil},
+	{"HU-NY", "Nyíregyháza", "", nil},
+	{"HU-PE", "Pest", "", nil},
+	{"HU-PS", "Pécs", "", nil},
+	{"HU-SD", "Szeged", "", nil},
+	{"HU-SF", "Székesfehérvár", "", nil},
+	{"HU-SH", "Szombathely", "", nil},
+	{"HU-SK", "Szolnok", "", nil},
+	{"HU-SN", "Sopron", "", nil},
+	{"HU-SO", "Somogy", "", nil},
+	{"HU-SS", "Szekszárd", "", nil},
+	{"HU-ST", "Salgótarján", "", nil},
+	{"HU-SZ", "Szabolcs-Szatmár-Bereg", "", nil},
+	{"HU-TB", "Tatabánya", "", nil},
+	{"HU-TO", "Tolna", "", nil},
+	{"HU-VA", "Vas", "", nil},
+	{"HU-VE", "Veszprém", "", nil},
+	{"HU-VM", "Veszprém", "", nil},
+	{"HU-ZA", "Zala", "", nil},
+	{"HU-ZE", "Zalaegerszeg", "", nil},
+
+	// Indonesia
+	{"ID-AC", "Aceh", "ID-SM", nil},
+	{"ID-BA", "Bali", "ID-NU", nil},
+	{"ID-BB", "Kepulauan Bangka Belitung", "ID-SM", nil},
+	{"ID-BE", "Bengkulu", "ID-SM", nil},
+	{"ID-BT", "Banten", "ID-JW", nil},
+	{"ID-GO", "Gorontalo", "ID-SL", nil},
+	{"ID-JA", "Jambi", "ID-SM", nil},
+	{"ID-JB", "Jawa Barat", "ID-JW", nil},
+	{"ID-JI", "Jawa Timur", "ID-JW", nil},
+	{"ID-JK", "Jakarta Raya", "ID-JW", nil},
+	{"ID-JT", "Jawa Tengah", "ID-JW", nil},
+	{"ID-JW", "Jawa", "", nil},
+	{"ID-KA", "Kalimantan", "", nil},
+	{"ID-KB", "Kalimantan Barat", "ID-KA", nil},
+	{"ID-KI", "Kalimantan Timur", "ID-KA", nil},
+	{"ID-KR", "Kepulauan Riau", "ID-SM", nil},
+	{"ID-KS", "Kalimantan Selatan", "ID-KA", nil},
+	{"ID-KT", "Kalimantan Tengah", "ID-KA", nil},
+	{"ID-KU", "Kalimantan Utara", "ID-KA", nil},
+	{"ID-LA", "Lampung", "ID-SM", nil},
+	{"ID-MA", "Maluku", "ID-ML", nil},
+	{"ID-ML", "Maluku", "", nil},
+	{"ID-MU", "Maluku Utara", "ID-ML", nil},
+	{"ID-NB", "Nusa Tenggara Barat", "ID-NU", nil},
+	{"ID-NT", "Nusa Tenggara Timur", "ID-NU", nil},
+	{"ID-NU", "Nusa Tenggara", "", nil},
+	{"ID-PA", "Papua", "ID-PP", nil},
+	{"ID-PB", "Papua Barat", "ID-PP", nil},
+	{"ID-PP", "Papua", "", nil},
+	{"ID-RI", "Riau", "ID-SM", nil},
+	{"ID-SA", "Sulawesi Utara", "ID-SL", nil},
+	{"ID-SB", "Sumatera Barat", "ID-SM", nil},
+	{"ID-SG", "Sulawesi Tenggara", "ID-SL", nil},
+	{"ID-SL", "Sulawesi", "", nil},
+	{"ID-SM", "Sumatera", "", nil},
+	{"ID-SN", "Sulawesi Selatan", "ID-SL", nil},
+	{"ID-SR", "Sulawesi Barat", "ID-SL", nil},
+	{"ID-SS", "Sumatera Selatan", "ID-SM", nil},
+	{"ID-ST", "Sulawesi Tengah", "ID-SL", nil},
+	{"ID-SU", "Sumatera Utara", "ID-SM", nil},
+	{"ID-YO", "Yogyakarta", "ID-JW", nil},
+
+	// Ireland
+	{"IE-C", "Connaught", "", nil},
+	{"IE-CE", "Clare", "IE-M", nil},
+	{"IE-CN", "Cavan", "IE-U", nil},
+	{"IE-CO", "Cork", "IE-M", nil},
+	{"IE-CW", "Carlow", "IE-L", nil},
+	{"IE-D", "Dublin", "IE-L", nil},
+	{"IE-DL", "Donegal", "IE-U", nil},
+	{"IE-G", "Galway", "IE-C", nil},
+	{"IE-KE", "Kildare", "IE-L", nil},
+	{"IE-KK", "Kilkenny", "IE-L", nil},
+	{"IE-KY", "Kerry", "IE-M", nil},
+	{"IE-L", "Leinster", "", nil},
+	{"IE-LD", "Longford", "IE-L", nil},
+	{"IE-LH", "Louth", "IE-L", nil},
+	{"IE-LK", "Limerick", "IE-M", nil},
+	{"IE-LM", "Leitrim", "IE-C", nil},
+	{"IE-LS", "Laois", "IE-L", nil},
+	{"IE-M", "Munster", "", nil},
+	{"IE-MH", "Meath", "IE-L", nil},
+	{"IE-MN", "Monaghan", "IE-U", nil},
+	{"IE-MO", "Mayo", "IE-C", nil},
+	{"IE-OY", "Offaly", "IE-L", nil},
+	{"IE-RN", "Roscommon", "IE-C", nil},
+	{"IE-SO", "Sligo", "IE-C", nil},
+	{"IE-TA", "Tipperary", "IE-M", nil},
+	{"IE-U", "Ulster", "", nil},
+	{"IE-WD", "Waterford", "IE-M", nil},
+	{"IE-WH", "Westmeath", "IE-L", nil},
+	{"IE-WW", "Wicklow", "IE-L", nil},
+	{"IE-WX", "Wexford", "IE-L", nil},
+
+	// Israel
+	{"IL-D", "Southern District", "", []string{"HaDarom", "מחוז הדרום", "הדרום", "Al Janūbī"}},
+	{"IL-HA", "Haifa District", "", []string{"Haifa", "מחוז חיפה", "חיפה", "H̱efa"}},
+	{"IL-JM", "Jerusalem District", "", []string{"Jerusalem", "Yerushalayim", "מחוז ירושלים", "ירושלים", "Al Quds"}},
+	{"IL-M", "Central District", "", []string{"HaMerkaz", "מחוז המרכז", "המרכז", "Al Awsaţ"}},
+	{"IL-TA", "Tel Aviv District", "", []string{"Tel Aviv", "Tel Aviv-Yafo", "מחוז תל אביב", "תל אביב", "Tall Abīb"}},
+	{"IL-Z", "Northern District", "", []string{"HaZafon", "מחוז הצפון", "הצפון", "Ash Shamālī"}},
+
+	// India
+	{"IN-AN", "Andaman and Nicobar Islands", "", nil},
+	{"IN-AP", "Andhra Pradesh", "", nil},
+	{"IN-AR", "Arunachal Pradesh", "", []string{"Arunāchal Pradesh"}},
+	{"IN-AS", "Assam", "", nil},
+	{"IN-BR", "Bihar", "", []string{"Bihār"}},
+	{"IN-CG", "Chhattisgarh", "", []string{"IN-CT", "Chhattīsgarh"}},
+	{"IN-CH", "Chandigarh", "", []string{"Chandīgarh"}},
+	{"IN-DH", "Dadra and Nagar Haveli and Daman and Diu", "", []string{"Dādra and Nagar Haveli and Damān and Diu"}},
+	{"IN-DL", "Delhi", "", []string{"New Delhi"}},
+	{"IN-GA", "Goa", "", nil},
+	{"IN-GJ", "Gujarat", "", []string{"Gujarāt"}},
+	{"IN-HP", "Himachal Pradesh", "", []string{"Himāchal Pradesh"}},
+	{"IN-HR", "Haryana", "", []string{"Haryāna"}},
+	{"IN-JH", "Jharkhand", "", []string{"Jhārkhand"}},
+	{"IN-JK", "Jammu and Kashmir", "", []string{"Jammu and Kashmīr"}},
+	{"IN-KA", "Karnataka", "", []string{"Karnātaka"}},
+	{"IN-KL", "Kerala", "", nil},
+	{"IN-LA", "Ladakh", "", []string{"Ladākh"}},
+	{"IN-LD", "Lakshadweep", "", nil},
+	{"IN-MH", "Maharashtra", "", []string{"Mahārāshtra"}},
+	{"IN-ML", "Meghalaya", "", []string{"Meghālaya"}},
+	{"IN-MN", "Manipur", "", nil},
+	{"IN-MP", "Madhya Pradesh", "", nil},
+	{"IN-MZ", "Mizoram", "", nil},
+	{"IN-NL", "Nagaland", "", []string{"Nāgāland"}},
+	{"IN-OD", "Odisha", "", []string{"Orissa", "IN-OR"}},
+	{"IN-PB", "Punjab", "", nil},
+	{"IN-PY", "Puducherry", "", []string{"Pondicherry"}},
+	{"IN-RJ", "Rajasthan", "", []string{"Rājasthān"}},
+	{"IN-SK", "Sikkim", "", nil},
+	{"IN-TN", "Tamil Nadu", "", []string{"Tamil Nādu"}},
+	{"IN-TR", "Tripura", "", nil},
+	{"IN-TS", "Telangana", "", []string{"IN-TG", "Telangāna"}},
+	{"IN-UK", "Uttarakhand", "", []string{"Uttaranchal", "IN-UT", "Uttarākhand"}},
+	{"IN-UP", "Uttar Pradesh", "", nil},
+	{"IN-WB", "West Bengal", "", nil},
+
+	// Iraq
+	{"IQ-AN", "Al Anbār", "", nil},
+	{"IQ-AR", "Arbīl", "", nil},
+	{"IQ-BA", "Al Başrah", "", nil},
+	{"IQ-BB", "Bābil", "", nil},
+	{"IQ-BG", "Baghdād", "", nil},
+	{"IQ-DA", "Dahūk", "", nil},
+	{"IQ-DI", "Diyālá", "", nil},
+	{"IQ-DQ", "Dhī Qār", "", nil},
+	{"IQ-KA", "Karbalā’", "", nil},
+	{"IQ-KI", "Kirkūk", "", nil},
+	{"IQ-MA", "Maysān", "", nil},
+	{"IQ-MU", "Al Muthanná", "", nil},
+	{"IQ-NA", "An Najaf", "", nil},
+	{"IQ-NI", "Nīnawá", "", nil},
+	{"IQ-QA", "Al Qādisīyah", "", nil},
+	{"IQ-SD", "Şalāḩ ad Dīn", "", nil},
+	{"IQ-SU", "As Sulaymānīyah", "", nil},
+	{"IQ-WA", "Wāsiţ", "", nil},
+
+	// Iran
+	{"IR-00", "Markazī", "", nil},
+	{"IR-01", "Gīlān", "", nil},
+	{"IR-02", "Māzandarān", "", nil},
+	{"IR-03", "Āz̄ārbāyjān-e Shārqī", "", nil},
+	{"IR-04", "Āz̄ārbāyjān-e Ghārbī", "", nil},
+	{"IR-05", "Kermānshāh", "", nil},
+	{"IR-06", "Khūzestān", "", nil},
+	{"IR-07", "Fārs", "", nil},
+	{"IR-08", "Kermān", "", nil},
+	{"IR-09", "Khorāsān-e Raẕavī", "", nil},
+	{"IR-10", "Eşfahān", "", nil},
+	{"IR-11", "Sīstān va Balūchestān", "", nil},
+	{"IR-12", "Kordestān", "", nil},
+	{"IR-13", "Hamadān", "", nil},
+	{"IR-14", "Chahār Maḩāl va Bakhtīārī", "", nil},
+	{"IR-15", "Lorestān", "", nil},
+	{"IR-16", "Īlām", "", nil},
+	{"IR-17", "Kohgīlūyeh va Bowyer Aḩmad", "", nil},
+	{"IR-18", "Būshehr", "", nil},
+	{"IR-19", "Zanjān", "", nil},
+	{"IR-20", "Semnān", "", nil},
+	{"IR-21", "Yazd", "", nil},
+	{"IR-22", "Hormozgān", "", nil},
+	{"IR-23", "Tehrān", "", nil},
+	{"IR-24", "Ardabīl", "", nil},
+	{"IR-25", "Qom", "", nil},
+	{"IR-26", "Qazvīn", "", nil},
+	{"IR-27", "Golestān", "", nil},
+	{"IR-28", "Khorāsān-e Shomālī", "", nil},
+	{"IR-29", "Khorāsān-e Jonūbī", "", nil},
+	{"IR-30", "Alborz", "", nil},
+
+	// Iceland
+	{"IS-1", "Höfuðborgarsvæði", "", nil},
+	{"IS-2", "Suðurnes", "", nil},
+	{"IS-3", "Vesturland", "", nil},
+	{"IS-4", "Vestfirðir", "", nil},
+	{"IS-5", "Norðurland vestra", "", nil},
+	{"IS-6", "Norðurland eystra", "", nil},
+	{"IS-7", "Austurland", "", nil},
+	{"IS-8", "Suðurland", "", nil},
+	{"IS-AKH", "Akrahreppur", "IS-5", nil},
+	{"IS-AKN", "Akraneskaupstaður", "IS-3", nil},
+	{"IS-AKU", "Akureyrarbær", "IS-6", nil},
+	{"IS-ARN", "Árneshreppur", "IS-4", nil},
+	{"IS-ASA", "Ásahreppur", "IS-8", nil},
+	{"IS-BFJ", "Borgarfjarðarhreppur", "IS-7", nil},
+	{"IS-BLA", "Bláskógabyggð", "IS-8", nil},
+	{"IS-BLO", "Blönduósbær", "IS-5", nil},
+	{"IS-BOG", "Borgarbyggð", "IS-3", nil},
+	{"IS-BOL", "Bolungarvíkurkaupstaður", "IS-4", nil},
+	{"IS-DAB", "Dalabyggð", "IS-3", nil},
+	{"IS-DAV", "Dalvíkurbyggð", "IS-6", nil},
+	{"IS-DJU", "Djúpavogshreppur", "IS-7", nil},
+	{"IS-EOM", "Eyja- og Miklaholtshreppur", "IS-3", nil},
+	{"IS-EYF", "Eyjafjarðarsveit", "IS-6", nil},
+	{"IS-FJD", "Fjarðabyggð", "IS-7", nil},
+	{"IS-FJL", "Fjallabyggð", "IS-6", nil},
+	{"IS-FLA", "Flóahreppur", "IS-8", nil},
+	{"IS-FLD", "Fljótsdalshérað", "IS-7", nil},
+	{"IS-FLR", "Fljótsdalshreppur", "IS-7", nil},
+	{"IS-GAR", "Garðabær", "IS-1", nil},
+	{"IS-GOG", "Grímsnes- og Grafningshreppur", "IS-8", nil},
+	{"IS-GRN", "Grindavíkurbær", "IS-2", nil},
+	{"IS-GRU", "Grundarfjarðarbær", "IS-3", nil},
+	{"IS-GRY", "Grýtubakkahreppur", "IS-6", nil},
+	{"IS-HAF", "Hafnarfjarðarkaupstaður", "IS-1", nil},
+	{"IS-HEL", "Helgafellssveit", "IS-3", nil},
+	{"IS-HRG", "Hörgársveit", "IS-6", nil},
+	{"IS-HRU", "Hrunamannahreppur", "IS-8", nil},
+	{"IS-HUT", "Húnavatnshreppur", "IS-5", nil},
+	{"IS-HUV", "Húnaþing vestra", "IS-5", nil},
+	{"IS-HVA", "Hvalfjarðarsveit", "IS-3", nil},
+	{"IS-HVE", "Hveragerðisbær", "IS-8", nil},
+	{"IS-ISA", "Ísafjarðarbær", "IS-4", nil},
+	{"IS-KAL", "Kaldrananeshreppur", "IS-4", nil},
+	{"IS-KJO", "Kjósarhreppur", "IS-1", nil},
+	{"IS-KOP", "Kópavogsbær", "IS-1", nil},
+	{"IS-LAN", "Langanesbyggð", "IS-6", nil},
+	{"IS-MOS", "Mosfellsbær", "IS-1", nil},
+	{"IS-MYR", "Mýrdalshreppur", "IS-8", nil},
+	{"IS-NOR", "Norðurþing", "IS-6", nil},
+	{"IS-RGE", "Rangárþing eystra", "IS-8", nil},
+	{"IS-RGY", "Rangárþing ytra", "IS-8", nil},
+	{"IS-RHH", "Reykhólahreppur", "IS-4", nil},
+	{"IS-RKN", "Reykjanesbær", "IS-2", nil},
+	{"IS-RKV", "Reykjavíkurborg", "IS-1", nil},
+	{"IS-SBH", "Svalbarðshreppur", "IS-6", nil},
+	{"IS-SBT", "Svalbarðsstrandarhreppur", "IS-6", nil},
+	{"IS-SDN", "Suðurnesjabær", "IS-2", nil},
+	{"IS-SDV", "Súðavíkurhreppur", "IS-4", nil},
+	{"IS-SEL", "Seltjarnarnesbær", "IS-1", nil},
+	{"IS-SEY", "Seyðisfjarðarkaupstaður", "IS-7", nil},
+	{"IS-SFA", "Sveitarfélagið Árborg", "IS-8", nil},
+	{"IS-SHF", "Sveitarfélagið Hornafjörður", "IS-7", nil},
+	{"IS-SKF", "Skaftárhreppur", "IS-8", nil},
+	{"IS-SKG", "Skagabyggð", "IS-5", nil},
+	{"IS-SKO", "Skorradalshreppur", "IS-3", nil},
+	{"IS-SKU", "Skútustaðahreppur", "IS-6", nil},
+	{"IS-SNF", "Snæfellsbær", "IS-3", nil},
+	{"IS-SOG", "Skeiða- og Gnúpverjahreppur", "IS-8", nil},
+	{"IS-SOL", "Sveitarfélagið Ölfus", "IS-8", nil},
+	{"IS-SSF", "Sveitarfélagið Skagafjörður", "IS-5", nil},
+	{"IS-SSS", "Sveitarfélagið Skagaströnd", "IS-5", nil},
+	{"IS-STR", "Strandabyggð", "IS-4", nil},
+	{"IS-STY", "Stykkishólmsbær", "IS-3", nil},
+	{"IS-SVG", "Sveitarfélagið Vogar", "IS-2", nil},
+	{"IS-TAL", "Tálknafjarðarhreppur", "IS-4", nil},
+	{"IS-THG", "Þingeyjarsveit", "IS-6", nil},
+	{"IS-TJO", "Tjörneshreppur", "IS-6", nil},
+	{"IS-VEM", "Vestmannaeyjabær", "IS-8", nil},
+	{"IS-VER", "Vesturbyggð", "IS-4", nil},
+	{"IS-VOP", "Vopnafjarðarhreppur", "IS-7", nil},
+
+	// Italy
+	{"IT-21", "Piemonte", "", nil},
+	{"IT-23", "Val d'Aoste", "", nil},
+	{"IT-25", "Lombardia", "", nil},
+	{"IT-32", "Trentino-Alto Adige", "", nil},
+	{"IT-34", "Veneto", "", nil},
+	{"IT-36", "Friuli Venezia Giulia", "", nil},
+	{"IT-42", "Liguria", "", nil},
+	{"IT-45", "Emilia-Romagna", "", nil},
+	{"IT-52", "Toscana", "", nil},
+	{"IT-55", "Umbria", "", nil},
+	{"IT-57", "Marche", "", nil},
+	{"IT-62", "Lazio", "", nil},
+	{"IT-65", "Abruzzo", "", nil},
+	{"IT-67", "Molise", "", nil},
+	{"IT-72", "Campania", "", nil},
+	{"IT-75", "Puglia", "", nil},
+	{"IT-77", "Basilicata", "", nil},
+	{"IT-78", "Calabria", "", nil},
+	{"IT-82", "Sicilia", "", nil},
+	{"IT-88", "Sardegna", "", nil},
+	{"IT-AG", "Agrigento", "IT-82", nil},
+	{"IT-AL", "Alessandria", "IT-21", nil},
+	{"IT-AN", "Ancona", "IT-57", nil},
+	{"IT-AP", "Ascoli Piceno", "IT-57", nil},
+	{"IT-AQ", "L'Aquila", "IT-65", nil},
+	{"IT-AR", "Arezzo", "IT-52", nil},
+	{"IT-AT", "Asti", "IT-21", nil},
+	{"IT-AV", "Avellino", "IT-72", nil},
+	{"IT-BA", "Bari", "IT-75", nil},
+	{"IT-BG", "Bergamo", "IT-25", nil},
+	{"IT-BI", "Biella", "IT-21", nil},
+	{"IT-BL", "Belluno", "IT-34", nil},
+	{"IT-BN", "Benevento", "IT-72", nil},
+	{"IT-BO", "Bologna", "IT-45", nil},
+	{"IT-BR", "Brindisi", "IT-75", nil},
+	{"IT-BS", "Brescia", "IT-25", nil},
+	{"IT-BT", "Barletta-Andria-Trani", "IT-75", nil},
+	{"IT-BZ", "Bolzano", "IT-32", nil},
+	{"IT-CA", "Cagliari", "IT-88", nil},
+	{"IT-CB", "Campobasso", "IT-67", nil},
+	{"IT-CE", "Caserta", "IT-72", nil},
+	{"IT-CH", "Chieti", "IT-65", nil},
+	{"IT-CL", "Caltanissetta", "IT-82", nil},
+	{"IT-CN", "Cuneo", "IT-21", nil},
+	{"IT-CO", "Como", "IT-25", nil},
+	{"IT-CR", "Cremona", "IT-25", nil},
+	{"IT-CS", "Cosenza", "IT-78", nil},
+	{"IT-CT", "Catania", "IT-82", nil},
+	{"IT-CZ", "Catanzaro", "IT-78", nil},
+	{"IT-EN", "Enna", "IT-82", nil},
+	{"IT-FC", "Forlì-Cesena", "IT-45", nil},
+	{"IT-FE", "Ferrara", "IT-45", nil},
+	{"IT-FG", "Foggia", "IT-75", nil},
+	{"IT-FI", "Firenze", "IT-52", nil},
+	{"IT-FM", "Fermo", "IT-57", nil},
+	{"IT-FR", "Frosinone", "IT-62", nil},
+	{"IT-GE", "Genova", "IT-42", nil},
+	{"IT-GO", "Gorizia", "IT-36", nil},
+	{"IT-GR", "Grosseto", "IT-52", nil},
+	{"IT-IM", "Imperia", "IT-42", nil},
+	{"IT-IS", "Isernia", "IT-67", nil},
+	{"IT-KR", "Crotone", "IT-78", nil},
+	{"IT-LC", "Lecco", "IT-25", nil},
+	{"IT-LE", "Lecce", "IT-75", nil},
+	{"IT-LI", "Livorno", "IT-52", nil},
+	{"IT-LO", "Lodi", "IT-25", nil},
+	{"IT-LT", "Latina", "IT-62", nil},
+	{"IT-LU", "Lucca", "IT-52", nil},
+	{"IT-MB", "Monza e Brianza", "IT-25", nil},
+	{"IT-MC", "Macerata", "IT-57", nil},
+	{"IT-ME", "Messina", "IT-82", nil},
+	{"IT-MI", "Milano", "IT-25", nil},
+	{"IT-MN", "Mantova", "IT-25", nil},
+	{"IT-MO", "Modena", "IT-45", nil},
+	{"IT-MS", "Massa-Carrara", "IT-52", nil},
+	{"IT-MT", "Matera", "IT-77", nil},
+	{"IT-NA", "Napoli", "IT-72", nil},
+	{"IT-NO", "Novara", "IT-21", nil},
+	{"IT-NU", "Nuoro", "IT-88", nil},
+	{"IT-OR", "Oristano", "IT-88", nil},
+	{"IT-PA", "Palermo", "IT-82", nil},
+	{"IT-PC", "Piacenza", "IT-45", nil},
+	{"IT-PD", "Padova", "IT-34", nil},
+	{"IT-PE", "Pescara", "IT-65", nil},
+	{"IT-PG", "Perugia", "IT-55", nil},
+	{"IT-PI", "Pisa", "IT-52", nil},
+	{"IT-PN", "Pordenone", "IT-36", nil},
+	{"IT-PO", "Prato", "IT-52", nil},
+	{"IT-PR", "Parma", "IT-45", nil},
+	{"IT-PT", "Pistoia", "IT-52", nil},
+	{"IT-PU", "Pesaro e Urbino", "IT-57", nil},
+	{"IT-PV", "Pavia", "IT-25", nil},
+	{"IT-PZ", "Potenza", "IT-77", nil},
+	{"IT-RA", "Ravenna", "IT-45", nil},
+	{"IT-RC", "Reggio Calabria", "IT-78", nil},
+	{"IT-RE", "Reggio Emilia", "IT-45", nil},
+	{"IT-RG", "Ragusa", "IT-82", nil},
+	{"IT-RI", "Rieti", "IT-62", nil},
+	{"IT-RM", "Roma", "IT-62", nil},
+	{"IT-RN", "Rimini", "IT-45", nil},
+	{"IT-RO", "Rovigo", "IT-34", nil},
+	{"IT-SA", "Salerno", "IT-72", nil},
+	{"IT-SI", "Siena", "IT-52", nil},
+	{"IT-SO", "Sondrio", "IT-25", nil},
+	{"IT-SP", "La Spezia", "IT-42", nil},
+	{"IT-SR", "Siracusa", "IT-82", nil},
+	{"IT-SS", "Sassari", "IT-88", nil},
+	{"IT-SU", "Sud Sardegna", "IT-88", nil},
+	{"IT-SV", "Savona", "IT-42", nil},
+	{"IT-TA", "Taranto", "IT-75", nil},
+	{"IT-TE", "Teramo", "IT-65", nil},
+	{"IT-TN", "Trento", "IT-32", nil},
+	{"IT-TO", "Torino", "IT-21", nil},
+	{"IT-TP", "Trapani", "IT-82", nil},
+	{"IT-TR", "Terni", "IT-55", nil},
+	{"IT-TS", "Trieste", "IT-36", nil},
+	{"IT-TV", "Treviso", "IT-34", nil},
+	{"IT-UD", "Udine", "IT-36", nil},
+	{"IT-VA", "Varese", "IT-25", nil},
+	{"IT-VB", "Verbano-Cusio-Ossola", "IT-21", nil},
+	{"IT-VC", "Vercelli", "IT-21", nil},
+	{"IT-VE", "Venezia", "IT-34", nil},
+	{"IT-VI", "Vicenza", "IT-34", nil},
+	{"IT-VR", "Verona", "IT-34", nil},
+	{"IT-VT", "Viterbo", "IT-62", nil},
+	{"IT-VV", "Vibo Valentia", "IT-78", nil},
+
+	// Jamaica
+	{"JM-01", "Kingston", "", nil},
+	{"JM-02", "Saint Andrew", "", nil},
+	{"JM-03", "Saint Thomas", "", nil},
+	{"JM-04", "Portland", "", nil},
+	{"JM-05", "Saint Mary", "", nil},
+	{"JM-06", "Saint Ann", "", nil},
+	{"JM-07", "Trelawny", "", nil},
+	{"JM-08", "Saint James", "", nil},
+	{"JM-09", "Hanover", "", nil},
+	{"JM-10", "Westmoreland", "", nil},
+	{"JM-11", "Saint Elizabeth", "", nil},
+	{"JM-12", "Manchester", "", nil},
+	{"JM-13", "Clarendon", "", nil},
+	{"JM-14", "Saint Catherine", "", nil},
+
+	// Jordan
+	{"JO-AJ", "‘Ajlūn", "", nil},
+	{"JO-AM", "Al ‘A̅şimah", "", nil},
+	{"JO-AQ", "Al ‘Aqabah", "", nil},
+	{"JO-AT", "Aţ Ţafīlah", "", nil},
+	{"JO-AZ", "Az Zarqā’", "", nil},
+	{"JO-BA", "Al Balqā’", "", nil},
+	{"JO-IR", "Irbid", "", nil},
+	{"JO-JA", "Jarash", "", nil},
+	{"JO-KA", "Al Karak", "", nil},
+	{"JO-MA", "Al Mafraq", "", nil},
+	{"JO-MD", "Mādabā", "", nil},
+	{"JO-MN", "Ma‘ān", "", nil},
+
+	// Japan
+	{"JP-01", "Hokkaido", "", nil},
+	{"JP-02", "Aomori", "", nil},
+	{"JP-03", "Iwate", "", nil},
+	{"JP-04", "Miyagi", "", nil},
+	{"JP-05", "Akita", "", nil},
+	{"JP-06", "Yamagata", "", nil},
+	{"JP-07", "Fukushima", "", nil},
+	{"JP-08", "Ibaraki", "", nil},
+	{"JP-09", "Tochigi", "", nil},
+	{"JP-10", "Gunma", "", nil},
+	{"JP-11", "Saitama", "", nil},
+	{"JP-12", "Chiba", "", nil},
+	{"JP-13", "Tokyo", "", nil},
+	{"JP-14", "Kanagawa", "", nil},
+	{"JP-15", "Niigata", "", nil},
+	{"JP-16", "Toyama", "", nil},
+	{"JP-17", "Ishikawa", "", nil},
+	{"JP-18", "Fukui", "", nil},
+	{"JP-19", "Yamanashi", "", nil},
+	{"JP-20", "Nagano", "", nil},
+	{"JP-21", "Gifu", "", nil},
+	{"JP-22", "Shizuoka", "", nil},
+	{"JP-23", "Aichi", "", nil},
+	{"JP-24", "Mie", "", nil},
+	{"JP-25", "Shiga", "", nil},
+	{"JP-26", "Kyoto", "", nil},
+	{"JP-27", "Osaka", "", nil},
+	{"JP-28", "Hyogo", "", nil},
+	{"JP-29", "Nara", "", nil},
+	{"JP-30", "Wakayama", "", nil},
+	{"JP-31", "Tottori", "", nil},
+	{"JP-32", "Shimane", "", nil},
+	{"JP-33", "Okayama", "", nil},
+	{"JP-34", "Hiroshima", "", nil},
+	{"JP-35", "Yamaguchi", "", nil},
+	{"JP-36", "Tokushima", "", nil},
+	{"JP-37", "Kagawa", "", nil},
+	{"JP-38", "Ehime", "", nil},
+	{"JP-39", "Kochi", "", nil},
+	{"JP-40", "Fukuoka", "", nil},
+	{"JP-41", "Saga", "", nil},
+	{"JP-42", "Nagasaki", "", nil},
+	{"JP-43", "Kumamoto", "", nil},
+	{"JP-44", "Oita", "", nil},
+	{"JP-45", "Miyazaki", "", nil},
+	{"JP-46", "Kagoshima", "", nil},
+	{"JP-47", "Okinawa", "", nil},
+
+	// Kenya
+	{"KE-01", "Baringo", "", nil},
+	{"KE-02", "Bomet", "", nil},
+	{"KE-03", "Bungoma", "", nil},
+	{"KE-04", "Busia", "", nil},
+	{"KE-05", "Elgeyo/Marakwet", "", nil},
+	{"KE-06", "Embu", "", nil},
+	{"KE-07", "Garissa", "", nil},
+	{"KE-08", "Homa Bay", "", nil},
+	{"KE-09", "Isiolo", "", nil},
+	{"KE-10", "Kajiado", "", nil},
+	{"KE-11", "Kakamega", "", nil},
+	{"KE-12", "Kericho", "", nil},
+	{"KE-13", "Kiambu", "", nil},
+	{"KE-14", "Kilifi", "", nil},
+	{"KE-15", "Kirinyaga", "", nil},
+	{"KE-16", "Kisii", "", nil},
+	{"KE-17", "Kisumu", "", nil},
+	{"KE-18", "Kitui", "", nil},
+	{"KE-19", "Kwale", "", nil},
+	{"KE-20", "Laikipia", "", nil},
+	{"KE-21", "Lamu", "", nil},
+	{"KE-22", "Machakos", "", nil},
+	{"KE-23", "Makueni", "", nil},
+	{"KE-24", "Mandera", "", nil},
+	{"KE-25", "Marsabit", "", nil},
+	{"KE-26", "Meru", "", nil},
+	{"KE-27", "Migori", "", nil},
+	{"KE-28", "Mombasa", "", nil},
+	{"KE-29", "Murang'a", "", nil},
+	{"KE-30", "Nairobi City", "", nil},
+	{"KE-31", "Nakuru", "", nil},
+	{"KE-32", "Nandi", "", nil},
+	{"KE-33", "Narok", "", nil},
+	{"KE-34", "Nyamira", "", nil},
+	{"KE-35", "Nyandarua", "", nil},
+	{"KE-36", "Nyeri", "", nil},
+	{"KE-37", "Samburu", "", nil},
+	{"KE-38", "Siaya", "", nil},
+	{"KE-39", "Taita/Taveta", "", nil},
+	{"KE-40", "Tana River", "", nil},
+	{"KE-41", "Tharaka-Nithi", "", nil},
+	{"KE-42", "Trans Nzoia", "", nil},
+	{"KE-43", "Turkana", "", nil},
+	{"KE-44", "Uasin Gishu", "", nil},
+	{"KE-45", "Vihiga", "", nil},
+	{"KE-46", "Wajir", "", nil},
+	{"KE-47", "West Pokot", "", nil},
+
+	// Kyrgyzstan
+	{"KG-B", "Batken", "", nil},
+	{"KG-C", "Chuyskaya oblast'", "", nil},
+	{"KG-GB", "Bishkek Shaary", "", nil},
+	{"KG-GO", "Gorod Osh", "", nil},
+	{"KG-J", "Dzhalal-Abadskaya oblast'", "", nil},
+	{"KG-N", "Naryn", "", nil},
+	{"KG-O", "Osh", "", nil},
+	{"KG-T", "Talas", "", nil},
+	{"KG-Y", "Issyk-Kul'skaja oblast'", "", nil},
+
+	// Cambodia
+	{"KH-1", "Banteay Mean Choăy", "", nil},
+	{"KH-10", "Kracheh", "", nil},
+	{"KH-11", "Mondol Kiri", "", nil},
+	{"KH-12", "Phnom Penh", "", nil},
+	{"KH-13", "Preah Vihear", "", nil},
+	{"KH-14", "Prey Veaeng", "", nil},
+	{"KH-15", "Pousaat", "", nil},
+	{"KH-16", "Rotanak Kiri", "", nil},
+	{"KH-17", "Siem Reab", "", nil},
+	{"KH-18", "Preah Sihanouk", "", nil},
+	{"KH-19", "Stoĕng Trêng", "", nil},
+	{"KH-2", "Baat Dambang", "", nil},
+	{"KH-20", "Svaay Rieng", "", nil},
+	{"KH-21", "Taakaev", "", nil},
+	{"KH-22", "Otdar Mean Chey", "", nil},
+	{"KH-23", "Kaeb", "", nil},
+	{"KH-24", "Pailin", "", nil},
+	{"KH-25", "Tbong Khmum", "", nil},
+	{"KH-3", "Kampong Chaam", "", nil},
+	{"KH-4", "Kampong Chhnang", "", nil},
+	{"KH-5", "Kampong Spueu", "", nil},
+	{"KH-6", "Kampong Thum", "", nil},
+	{"KH-7", "Kampot", "", nil},
+	{"KH-8", "Kandaal", "", nil},
+	{"KH-9", "Kaoh Kong", "", nil},
+
+	// Kiribati
+	{"KI-G", "Gilbert Islands", "", nil},
+	{"KI-L", "Line Islands", "", nil},
+	{"KI-P", "Phoenix Islands", "", nil},
+
+	// Comoros
+	{"KM-A", "Andjouân", "", nil},
+	{"KM-G", "Andjazîdja", "", nil},
+	{"KM-M", "Mohéli", "", nil},
+
+	// Saint Kitts and Nevis
+	{"KN-01", "Christ Church Nichola Town", "KN-K", nil},
+	{"KN-02", "Saint Anne Sandy Point", "KN-K", nil},
+	{"KN-03", "Saint George Basseterre", "KN-K", nil},
+	{"KN-04", "Saint George Gingerland", "KN-N", nil},
+	{"KN-05", "Saint James Windward", "KN-N", nil},
+	{"KN-06", "Saint John Capisterre", "KN-K", nil},
+	{"KN-07", "Saint John Figtree", "KN-N", nil},
+	{"KN-08", "Saint Mary Cayon", "KN-K", nil},
+	{"KN-09", "Saint Paul Capisterre", "KN-K", nil},
+	{"KN-10", "Saint Paul Charlestown", "KN-N", nil},
+	{"KN-11", "Saint Peter Basseterre", "KN-K", nil},
+	{"KN-12", "Saint Thomas Lowland", "KN-N", nil},
+	{"KN-13", "Saint Thomas Middle Island", "KN-K", nil},
+	{"KN-15", "Trinity Palmetto Point", "KN-K", nil},
+	{"KN-K", "Saint Kitts", "", nil},
+	{"KN-N", "Nevis", "", nil},
+
+	// North Korea
+	{"KP-01", "P'yǒngyang", "", nil},
+	{"KP-02", "P'yǒngan-namdo", "", nil},
+	{"KP-03", "P'yǒngan-bukto", "", nil},
+	{"KP-04", "Chagang-do", "", nil},
+	{"KP-05", "Hwanghae-namdo", "", nil},
+	{"KP-06", "Hwanghae-bukto", "", nil},
+	{"KP-07", "Kangweonto", "", nil},
+	{"KP-08", "Hamgyǒng-namdo", "", nil},
+	{"KP-09", "Hamgyǒng-bukto", "", nil},
+	{"KP-10", "Ryanggang-do", "", nil},
+	{"KP-13", "Raseon", "", nil},
+	{"KP-14", "Nampho", "", nil},
+
+	// South Korea
+	{"KR-11", "Seoul-teukbyeolsi", "", nil},
+	{"KR-26", "Busan-gwangyeoksi", "", nil},
+	{"KR-27", "Daegu-gwangyeoksi", "", nil},
+	{"KR-28", "Incheon-gwangyeoksi", "", nil},
+	{"KR-29", "Gwangju-gwangyeoksi", "", nil},
+	{"KR-30", "Daejeon-gwangyeoksi", "", nil},
+	{"KR-31", "Ulsan-gwangyeoksi", "", nil},
+	{"KR-41", "Gyeonggi-do", "", nil},
+	{"KR-42", "Gangwon-do", "", nil},
+	{"KR-43", "Chungcheongbuk-do", "", nil},
+	{"KR-44", "Chungcheongnam-do", "", nil},
+	{"KR-45", "Jeollabuk-do", "", nil},
+	{"KR-46", "Jeollanam-do", "", nil},
+	{"KR-47", "Gyeongsangbuk-do", "", nil},
+	{"KR-48", "Gyeongsangnam-do", "", nil},
+	{"KR-49", "Jeju-teukbyeoljachido", "", nil},
+	{"KR-50", "Sejong", "", nil},
+
+	// Kuwait
+	{"KW-AH", "Al Aḩmadī", "", nil},
+	{"KW-FA", "Al Farwānīyah", "", nil},
+	{"KW-HA", "Ḩawallī", "", nil},
+	{"KW-JA", "Al Jahrā’", "", nil},
+	{"KW-KU", "Al ‘Āşimah", "", nil},
+	{"KW-MU", "Mubārak al Kabīr", "", nil},
+
+	// Kazakhstan
+	{"KZ-AKM", "Akmolinskaja oblast'", "", nil},
+	{"KZ-AKT", "Aktjubinskaja oblast'", "", nil},
+	{"KZ-ALA", "Almaty", "", nil},
+	{"KZ-ALM", "Almatinskaja oblast'", "", nil},
+	{"KZ-AST", "Nur-Sultan", "", nil},
+	{"KZ-ATY", "Atyrauskaja oblast'", "", nil},
+	{"KZ-KAR", "Karagandinskaja oblast'", "", nil},
+	{"KZ-KUS", "Kostanajskaja oblast'", "", nil},
+	{"KZ-KZY", "Kyzylordinskaja oblast'", "", nil},
+	{"KZ-MAN", "Mangghystaū oblysy", "", nil},
+	{"KZ-PAV", "Pavlodar oblysy", "", nil},
+	{"KZ-SEV", "Severo-Kazahstanskaja oblast'", "", nil},
+	{"KZ-SHY", "Shymkent", "", nil},
+	{"KZ-VOS", "Shyghys Qazaqstan oblysy", "", nil},
+	{"KZ-YUZ", "Turkestankaya oblast'", "", nil},
+	{"KZ-ZAP", "Batys Qazaqstan oblysy", "", nil},
+	{"KZ-ZHA", "Zhambyl oblysy", "", nil},
+
+	// Laos
+	{"LA-AT", "Attapu", "", nil},
+	{"LA-BK", "Bokèo", "", nil},
+	{"LA-BL", "Bolikhamxai", "", nil},
+	{"LA-CH", "Champasak", "", nil},
+	{"LA-HO", "Houaphan", "", nil},
+	{"LA-KH", "Khammouan", "", nil},
+	{"LA-LM", "Louang Namtha", "", nil},
+	{"LA-LP", "Louangphabang", "", nil},
+	{"LA-OU", "Oudômxai", "", nil},
+	{"LA-PH", "Phôngsali", "", nil},
+	{"LA-SL", "Salavan", "", nil},
+	{"LA-SV", "Savannakhét", "", nil},
+	{"LA-VI", "Viangchan", "", nil},
+	{"LA-VT", "Viangchan", "", nil},
+	{"LA-XA", "Xaignabouli", "", nil},
+	{"LA-XE", "Xékong", "", nil},
+	{"LA-XI", "Xiangkhouang", "", nil},
+	{"LA-XS", "Xaisômboun", "", nil},
+
+	// Lebanon
+	{"LB-AK", "Aakkâr", "", nil},
+	{"LB-AS", "Ash Shimāl", "", nil},
+	{"LB-BA", "Bayrūt", "", nil},
+	{"LB-BH", "Baalbek-Hermel", "", nil},
+	{"LB-BI", "Al Biqā‘", "", nil},
+	{"LB-JA", "Al Janūb", "", nil},
+	{"LB-JL", "Jabal Lubnān", "", nil},
+	{"LB-NA", "An Nabaţīyah", "", nil},
+
+	// Saint Lucia
+	{"LC-01", "Anse la Raye", "", nil},
+	{"LC-02", "Castries", "", nil},
+	{"LC-03", "Choiseul", "", nil},
+	{"LC-05", "Dennery", "", nil},
+	{"LC-06", "Gros Islet", "", nil},
+	{"LC-07", "Laborie", "", nil},
+	{"LC-08", "Micoud", "", nil},
+	{"LC-10", "Soufrière", "", nil},
+	{"LC-11", "Vieux Fort", "", nil},
+	{"LC-12", "Canaries", "", nil},
+
+	// Liechtenstein
+	{"LI-01", "Balzers", "", nil},
+	{"LI-02", "Eschen", "", nil},
+	{"LI-03", "Gamprin", "", nil},
+	{"LI-04", "Mauren", "", nil},
+	{"LI-05", "Planken", "", nil},
+	{"LI-06", "Ruggell", "", nil},
+	{"LI-07", "Schaan", "", nil},
+	{"LI-08", "Schellenberg", "", nil},
+	{"LI-09", "Triesen", "", nil},
+	{"LI-10", "Triesenberg", "", nil},
+	{"LI-11", "Vaduz", "", nil},
+
+	// Sri Lanka
+	{"LK-1", "Western Province", "", nil},
+	{"LK-11", "Colombo", "LK-1", nil},
+	{"LK-12", "Gampaha", "LK-1", nil},
+	{"LK-13", "Kalutara", "LK-1", nil},
+	{"LK-2", "Central Province", "", nil},
+	{"LK-21", "Kandy", "LK-2", nil},
+	{"LK-22", "Matale", "LK-2", nil},
+	{"LK-23", "Nuwara Eliya", "LK-2", nil},
+	{"LK-3", "Southern Province", "", nil},
+	{"LK-31", "Galle", "LK-3", nil},
+	{"LK-32", "Matara", "LK-3", nil},
+	{"LK-33", "Hambantota", "LK-3", nil},
+	{"LK-4", "Northern Province", "", nil},
+	{"LK-41", "Jaffna", "LK-4", nil},
+	{"LK-42", "Kilinochchi", "LK-4", nil},
+	{"LK-43", "Mannar", "LK-4", nil},
+	{"LK-44", "Vavuniya", "LK-4", nil},
+	{"LK-45", "Mullaittivu", "LK-4", nil},
+	{"LK-5", "Eastern Province", "", nil},
+	{"LK-51", "Batticaloa", "LK-5", nil},
+	{"LK-52", "Ampara", "LK-5", nil},
+	{"LK-53", "Trincomalee", "LK-5", nil},
+	{"LK-6", "North Western Province", "", nil},
+	{"LK-61", "Kurunegala", "LK-6", nil},
+	{"LK-62", "Puttalam", "LK-6", nil},
+	{"LK-7", "North Central Province", "", nil},
+	{"LK-71", "Anuradhapura", "LK-7", nil},
+	{"LK-72", "Polonnaruwa", "LK-7", nil},
+	{"LK-8", "Uva Province", "", nil},
+	{"LK-81", "Badulla", "LK-8", nil},
+	{"LK-82", "Monaragala", "LK-8", nil},
+	{"LK-9", "Sabaragamuwa Province", "", nil},
+	{"LK-91", "Ratnapura", "LK-9", nil},
+	{"LK-92", "Kegalla", "LK-9", nil},
+
+	// Liberia
+	{"LR-BG", "Bong", "", nil},
+	{"LR-BM", "Bomi", "", nil},
+	{"LR-CM", "Grand Cape Mount", "", nil},
+	{"LR-GB", "Grand Bassa", "", nil},
+	{"LR-GG", "Grand Gedeh", "", nil},
+	{"LR-GK", "Grand Kru", "", nil},
+	{"LR-GP", "Gbarpolu", "", nil},
+	{"LR-LO", "Lofa", "", nil},
+	{"LR-MG", "Margibi", "", nil},
+	{"LR-MO", "Montserrado", "", nil},
+	{"LR-MY", "Maryland", "", nil},
+	{"LR-NI", "Nimba", "", nil},
+	{"LR-RG", "River Gee", "", nil},
+	{"LR-RI", "River Cess", "", nil},
+	{"LR-SI", "Sinoe", "", nil},
+
+	// Lesotho
+	{"LS-A", "Maseru", "", nil},
+	{"LS-B", "Botha-Bothe", "", nil},
+	{"LS-C", "Leribe", "", nil},
+	{"LS-D", "Berea", "", nil},
+	{"LS-E", "Mafeteng", "", nil},
+	{"LS-F", "Mohale's Hoek", "", nil},
+	{"LS-G", "Quthing", "", nil},
+	{"LS-H", "Qacha's Nek", "", nil},
+	{"LS-J", "Mokhotlong", "", nil},
+	{"LS-K", "Thaba-Tseka", "", nil},
+
+	// Lithuania
+	{"LT-01", "Akmenė", "", nil},
+	{"LT-02", "Alytaus miestas", "", nil},
+	{"LT-03", "Alytus", "", nil},
+	{"LT-04", "Anykščiai", "", nil},
+	{"LT-05", "Birštono", "", nil},
+	{"LT-06", "Biržai", "", nil},
+	{"LT-07", "Druskininkai", "", nil},
+	{"LT-08", "Elektrėnai", "", nil},
+	{"LT-09", "Ignalina", "", nil},
+	{"LT-10", "Jonava", "", nil},
+	{"LT-11", "Joniškis", "", nil},
+	{"LT-12", "Jurbarkas", "", nil},
+	{"LT-13", "Kaišiadorys", "", nil},
+	{"LT-14", "Kalvarijos", "", nil},
+	{"LT-15", "Kauno miestas", "", nil},
+	{"LT-16", "Kaunas", "", nil},
+	{"LT-17", "Kazlų Rūdos", "", nil},
+	{"LT-18", "Kėdainiai", "", nil},
+	{"LT-19", "Kelmė", "", nil},
+	{"LT-20", "Klaipėdos miestas", "", nil},
+	{"LT-21", "Klaipėda", "", nil},
+	{"LT-22", "Kretinga", "", nil},
+	{"LT-23", "Kupiškis", "", nil},
+	{"LT-24", "Lazdijai", "", nil},
+	{"LT-25", "Marijampolė", "", nil},
+	{"LT-26", "Mažeikiai", "", nil},
+	{"LT-27", "Molėtai", "", nil},
+	{"LT-28", "Neringa", "", nil},
+	{"LT-29", "Pagėgiai", "", nil},
+	{"LT-30", "Pakruojis", "", nil},
+	{"LT-31", "Palangos miestas", "", nil},
+	{"LT-32", "Panevėžio miestas", "", nil},
+	{"LT-33", "Panevėžys", "", nil},
+	{"LT-34", "Pasvalys", "", nil},
+	{"LT-35", "Plungė", "", nil},
+	{"LT-36", "Prienai", "", nil},
+	{"LT-37", "Radviliškis", "", nil},
+	{"LT-38", "Raseiniai", "", nil},
+	{"LT-39", "Rietavo", "", nil},
+	{"LT-40", "Rokiškis", "", nil},
+	{"LT-41", "Šakiai", "", nil},
+	{"LT-42", "Šalčininkai", "", nil},
+	{"LT-43", "Šiaulių miestas", "", nil},
+	{"LT-44", "Šiauliai", "", nil},
+	{"LT-45", "Šilalė", "", nil},
+	{"LT-46", "Šilutė", "", nil},
+	{"LT-47", "Širvintos", "", nil},
+	{"LT-48", "Skuodas", "", nil},
+	{"LT-49", "Švenčionys", "", nil},
+	{"LT-50", "Tauragė", "", nil},
+	{"LT-51", "Telšiai", "", nil},
+	{"LT-52", "Trakai", "", nil},
+	{"LT-53", "Ukmergė", "", nil},
+	{"LT-54", "Utena", "", nil},
+	{"LT-55", "Varėna", "", nil},
+	{"LT-56", "Vilkaviškis", "", nil},
+	{"LT-57", "Vilniaus miestas", "", nil},
+	{"LT-58", "Vilnius", "", nil},
+	{"LT-59", "Visaginas", "", nil},
+	{"LT-60", "Zarasai", "", nil},
+	{"LT-AL", "Alytaus apskritis", "", nil},
+	{"LT-KL", "Klaipėdos apskritis", "", nil},
+	{"LT-KU", "Kauno apskritis", "", nil},
+	{"LT-MR", "Marijampolės apskritis", "", nil},
+	{"LT-PN", "Panevėžio apskritis", "", nil},
+	{"LT-SA", "Šiaulių apskritis", "", nil},
+	{"LT-TA", "Tauragės apskritis", "", nil},
+	{"LT-TE", "Telšių apskritis", "", nil},
+	{"LT-UT", "Utenos apskritis", "", nil},
+	{"LT-VL", "Vilniaus apskritis", "", nil},
+
+	// Luxembourg
+	{"LU-CA", "Capellen", "", nil},
+	{"LU-CL", "Clerf", "", nil},
+	{"LU-DI", "Diekirch", "", nil},
+	{"LU-EC", "Echternach", "", nil},
+	{"LU-ES", "Esch an der Alzette", "", nil},
+	{"LU-GR", "Grevenmacher", "", nil},
+	{"LU-LU", "Luxembourg", "", nil},
+	{"LU-ME", "Mersch", "", nil},
+	{"LU-RD", "Redange", "", nil},
+	{"LU-RM", "Remich", "", nil},
+	{"LU-VD", "Veianen", "", nil},
+	{"LU-WI", "Wiltz", "", nil},
+
+	// Latvia
+	{"LV-001", "Aglonas novads", "", nil},
+	{"LV-002", "Aizkraukles novads", "", nil},
+	{"LV-003", "Aizputes novads", "", nil},
+	{"LV-004", "Aknīstes novads", "", nil},
+	{"LV-005", "Alojas novads", "", nil},
+	{"LV-006", "Alsungas novads", "", nil},
+	{"LV-007", "Alūksnes novads", "", nil},
+	{"LV-008", "Amatas novads", "", nil},
+	{"LV-009", "Apes novads", "", nil},
+	{"LV-010", "Auces novads", "", nil},
+	{"LV-011", "Ādažu novads", "", nil},
+	{"LV-012", "Babītes novads", "", nil},
+	{"LV-013", "Baldones novads", "", nil},
+	{"LV-014", "Baltinavas novads", "", nil},
+	{"LV-015", "Balvu novads", "", nil},
+	{"LV-016", "Bauskas novads", "", nil},
+	{"LV-017", "Beverīnas novads", "", nil},
+	{"LV-018", "Brocēnu novads", "", nil},
+	{"LV-019", "Burtnieku novads", "", nil},
+	{"LV-020", "Carnikavas novads", "", nil},
+	{"LV-021", "Cesvaines novads", "", nil},
+	{"LV-022", "Cēsu novads", "", nil},
+	{"LV-023", "Ciblas novads", "", nil},
+	{"LV-024", "Dagdas novads", "", nil},
+	{"LV-025", "Daugavpils novads", "", nil},
+	{"LV-026", "Dobeles novads", "", nil},
+	{"LV-027", "Dundagas novads", "", nil},
+	{"LV-028", "Durbes novads", "", nil},
+	{"LV-029", "Engures novads", "", nil},
+	{"LV-030", "Ērgļu novads", "", nil},
+	{"LV-031", "Garkalnes novads", "", nil},
+	{"LV-032", "Grobiņas novads", "", nil},
+	{"LV-033", "Gulbenes novads", "", nil},
+	{"LV-034", "Iecavas novads", "", nil},
+	{"LV-035", "Ikšķiles novads", "", nil},
+	{"LV-036", "Ilūkstes novads", "", nil},
+	{"LV-037", "Inčukalna novads", "", nil},
+	{"LV-038", "Jaunjelgavas novads", "", nil},
+	{"LV-039", "Jaunpiebalgas novads", "", nil},
+	{"LV-040", "Jaunpils novads", "", nil},
+	{"LV-041", "Jelgavas novads", "", nil},
+	{"LV-042", "Jēkabpils novads", "", nil},
+	{"LV-043", "Kandavas novads", "", nil},
+	{"LV-044", "Kārsavas novads", "", nil},
+	{"LV-045", "Kocēnu novads", "", nil},
+	{"LV-046", "Kokneses novads", "", nil},
+	{"LV-047", "Krāslavas novads", "", nil},
+	{"LV-048", "Krimuldas novads", "", nil},
+	{"LV-049", "Krustpils novads", "", nil},
+	{"LV-050", "Kuldīgas novads", "", nil},
+	{"LV-051", "Ķeguma novads", "", nil},
+	{"LV-052", "Ķekavas novads", "", nil},
+	{"LV-053", "Lielvārdes novads", "", nil},
+	{"LV-054", "Limbažu novads", "", nil},
+	{"LV-055", "Līgatnes novads", "", nil},
+	{"LV-056", "Līvānu novads", "", nil},
+	{"LV-057", "Lubānas novads", "", nil},
+	{"LV-058", "Ludzas novads", "", nil},
+	{"LV-059", "Madonas novads", "", nil},
+	{"LV-060", "Mazsalacas novads", "", nil},
+	{"LV-061", "Mālpils novads", "", nil},
+	{"LV-062", "Mārupes novads", "", nil},
+	{"LV-063", "Mērsraga novads", "", nil},
+	{"LV-064", "Naukšēnu novads", "", nil},
+	{"LV-065", "Neretas novads", "", nil},
+	{"LV-066", "Nīcas novads", "", nil},
+	{"LV-067", "Ogres novads", "", nil},
+	{"LV-068", "Olaines novads", "", nil},
+	{"LV-069", "Ozolnieku novads", "", nil},
+	{"LV-070", "Pārgaujas novads", "", nil},
+	{"LV-071", "Pāvilostas novads", "", nil},
+	{"LV-072", "Pļaviņu novads", "", nil},
+	{"LV-073", "Preiļu novads", "", nil},
+	{"LV-074", "Priekules novads", "", nil},
+	{"LV-075", "Priekuļu novads", "", nil},
+	{"LV-076", "Raunas novads", "", nil},
+	{"LV-077", "Rēzeknes novads", "", nil},
+	{"LV-078", "Riebiņu novads", "", nil},
+	{"LV-079", "Rojas novads", "", nil},
+	{"LV-080", "Ropažu novads", "", nil},
+	{"LV-081", "Rucavas novads", "", nil},
+	{"LV-082", "Rugāju novads", "", nil},
+	{"LV-083", "Rundāles novads", "", nil},
+	{"LV-084", "Rūjienas novads", "", nil},
+	{"LV-085", "Salas novads", "", nil},
+	{"LV-086", "Salacgrīvas novads", "", nil},
+	{"LV-087", "Salaspils novads", "", nil},
+	{"LV-088", "Saldus novads", "", nil},
+	{"LV-089", "Saulkrastu novads", "", nil},
+	{"LV-090", "Sējas novads", "", nil},
+	{"LV-091", "Siguldas novads", "", nil},
+	{"LV-092", "Skrīveru novads", "", nil},
+	{"LV-093", "Skrundas novads", "", nil},
+	{"LV-094", "Smiltenes novads", "", nil},
+	{"LV-095", "Stopiņu novads", "", nil},
+	{"LV-096", "Strenču novads", "", nil},
+	{"LV-097", "Talsu novads", "", nil},
+	{"LV-098", "Tērvetes novads", "", nil},
+	{"LV-099", "Tukuma novads", "", nil},
+	{"LV-100", "Vaiņodes novads", "", nil},
+	{"LV-101", "Valkas novads", "", nil},
+	{"LV-102", "Varakļānu novads", "", nil},
+	{"LV-103", "Vārkavas novads", "", nil},
+	{"LV-104", "Vecpiebalgas novads", "", nil},
+	{"LV-105", "Vecumnieku novads", "", nil},
+	{"LV-106", "Ventspils novads", "", nil},
+	{"LV-107", "Viesītes novads", "", nil},
+	{"LV-108", "Viļakas novads", "", nil},
+	{"LV-109", "Viļānu novads", "", nil},
+	{"LV-110", "Zilupes novads", "", nil},
+	{"LV-DGV", "Daugavpils", "", nil},
+	{"LV-JEL", "Jelgava", "", nil},
+	{"LV-JKB", "Jēkabpils", "", nil},
+	{"LV-JUR", "Jūrmala", "", nil},
+	{"LV-LPX", "Liepāja", "", nil},
+	{"LV-REZ", "Rēzekne", "", nil},
+	{"LV-RIX", "Rīga", "", nil},
+	{"LV-VEN", "Ventspils", "", nil},
+	{"LV-VMR", "Valmiera", "", nil},
+
+	// Libya
+	{"LY-BA", "Banghāzī", "", nil},
+	{"LY-BU", "Al Buţnān", "", nil},
+	{"LY-DR", "Darnah", "", nil},
+	{"LY-GT", "Ghāt", "", nil},
+	{"LY-JA", "Al Jabal al Akhḑar", "", nil},
+	{"LY-JG", "Al Jabal al Gharbī", "", nil},
+	{"LY-JI", "Al Jafārah", "", nil},
+	{"LY-JU", "Al Jufrah", "", nil},
+	{"LY-KF", "Al Kufrah", "", nil},
+	{"LY-MB", "Al Marqab", "", nil},
+	{"LY-MI", "Mişrātah", "", nil},
+	{"LY-MJ", "Al Marj", "", nil},
+	{"LY-MQ", "Murzuq", "", nil},
+	{"LY-NL", "Nālūt", "", nil},
+	{"LY-NQ", "An Nuqāţ al Khams", "", nil},
+	{"LY-SB", "Sabhā", "", nil},
+	{"LY-SR", "Surt", "", nil},
+	{"LY-TB", "Ţarābulus", "", nil},
+	{"LY-WA", "Al Wāḩāt", "", nil},
+	{"LY-WD", "Wādī al Ḩayāt", "", nil},
+	{"LY-WS", "Wādī ash Shāţi’", "", nil},
+	{"LY-ZA", "Az Zāwiyah", "", nil},
+
+	// Morocco
+	{"MA-01", "Tanger-Tétouan-Al Hoceïma", "", nil},
+	{"MA-02", "L'Oriental", "", nil},
+	{"MA-03", "Fès-Meknès", "", nil},
+	{"MA-04", "Rabat-Salé-Kénitra", "", nil},
+	{"MA-05", "Béni Mellal-Khénifra", "", nil},
+	{"MA-06", "Casablanca-Settat", "", nil},
+	{"MA-07", "Marrakech-Safi", "", nil},
+	{"MA-08", "Drâa-Tafilalet", "", nil},
+	{"MA-09", "Souss-Massa", "", nil},
+	{"MA-10", "Guelmim-Oued Noun (EH-partial)", "", []string{"Guelmim-Oued Noun"}},
+	{"MA-11", "Laâyoune-Sakia El Hamra (EH-partial)", "", []string{"Laâyoune-Sakia El Hamra"}},
+	{"MA-12", "Dakhla-Oued Ed-Dahab (EH)", "", []string{"Dakhla-Oued Ed-Dahab"}},
+	{"MA-AGD", "Agadir-Ida-Ou-Tanane", "MA-09", nil},
+	{"MA-AOU", "Aousserd (EH)", "MA-12", []string{"Aousserd"}},
+	{"MA-ASZ", "Assa-Zag (EH-partial)", "MA-10", []string{"Assa-Zag"}},
+	{"MA-AZI", "Azilal", "MA-05", nil},
+	{"MA-BEM", "Béni Mellal", "MA-05", nil},
+	{"MA-BER", "Berkane", "MA-02", nil},
+	{"MA-BES", "Benslimane", "MA-06", nil},
+	{"MA-BOD", "Boujdour (EH)", "MA-11", []string{"Boujdour"}},
+	{"MA-BOM", "Boulemane", "MA-03", nil},
+	{"MA-BRR", "Berrechid", "MA-06", nil},
+	{"MA-CAS", "Casablanca", "MA-06", nil},
+	{"MA-CHE", "Chefchaouen", "MA-01", nil},
+	{"MA-CHI", "Chichaoua", "MA-07", nil},
+	{"MA-CHT", "Chtouka-Ait Baha", "MA-06", nil},
+	{"MA-DRI", "Driouch", "MA-02", nil},
+	{"MA-ERR", "Errachidia", "MA-08", nil},
+	{"MA-ESI", "Essaouira", "MA-07", nil},
+	{"MA-ESM", "Es-Semara (EH-partial)", "MA-11", []string{"Es-Semara"}},
+	{"MA-FAH", "Fahs-Anjra", "MA-01", nil},
+	{"MA-FES", "Fès", "MA-03", nil},
+	{"MA-FIG", "Figuig", "MA-02", nil},
+	{"MA-FQH", "Fquih Ben Salah", "MA-05", nil},
+	{"MA-GUE", "Guelmim", "MA-10", nil},
+	{"MA-GUF", "Guercif", "MA-02", nil},
+	{"MA-HAJ", "El Hajeb", "MA-03", nil},
+	{"MA-HAO", "Al Haouz", "MA-07", nil},
+	{"MA-HOC", "Al Hoceïma", "MA-01", nil},
+	{"MA-IFR", "Ifrane", "MA-03", nil},
+	{"MA-INE", "Inezgane-Ait Melloul", "MA-09", nil},
+	{"MA-JDI", "El Jadida", "MA-06", nil},
+	{"MA-JRA", "Jerada", "MA-02", nil},
+	{"MA-KEN", "Kénitra", "MA-04", nil},
+	{"MA-KES", "El Kelâa des Sraghna", "MA-07", nil},
+	{"MA-KHE", "Khémisset", "MA-04", nil},
+	{"MA-KHN", "Khénifra", "MA-05", nil},
+	{"MA-KHO", "Khouribga", "MA-05", nil},
+	{"MA-LAA", "Laâyoune (EH)", "MA-11", []string{"Laâyoune"}},
+	{"MA-LAR", "Larache", "MA-01", nil},
+	{"MA-MAR", "Marrakech", "MA-07", nil},
+	{"MA-MDF", "M’diq-Fnideq", "MA-01", nil},
+	{"MA-MED", "Médiouna", "MA-06", nil},
+	{"MA-MEK", "Meknès", "MA-03", nil},
+	{"MA-MID", "Midelt", "MA-08", nil},
+	{"MA-MOH", "Mohammadia", "MA-06", nil},
+	{"MA-MOU", "Moulay Yacoub", "MA-03", nil},
+	{"MA-NAD", "Nador", "MA-02", nil},
+	{"MA-NOU", "Nouaceur", "MA-04", nil},
+	{"MA-OUA", "Ouarzazate", "MA-08", nil},
+	{"MA-OUD", "Oued Ed-Dahab (EH)", "MA-12", []string{"Oued Ed-Dahab"}},
+	{"MA-OUJ", "Oujda-Angad", "MA-02", nil},
+	{"MA-OUZ", "Ouezzane", "MA-01", nil},
+	{"MA-RAB", "Rabat", "MA-04", nil},
+	{"MA-REH", "Rehamna", "MA-07", nil},
+	{"MA-SAF", "Safi", "MA-07", nil},
+	{"MA-SAL", "Salé", "MA-04", nil},
+	{"MA-SEF", "Sefrou", "MA-03", nil},
+	{"MA-SET", "Settat", "MA-06", nil},
+	{"MA-SIB", "Sidi Bennour", "MA-06", nil},
+	{"MA-SIF", "Sidi Ifni", "MA-10", nil},
+	{"MA-SIK", "Sidi Kacem", "MA-04", nil},
+	{"MA-SIL", "Sidi Slimane", "MA-04", nil},
+	{"MA-SKH", "Skhirate-Témara", "MA-04", nil},
+	{"MA-TAF", "Tarfaya (EH-partial)", "MA-11", []string{"Tarfaya"}},
+	{"MA-TAI", "Taourirt", "MA-02", nil},
+	{"MA-TAO", "Taounate", "MA-03", nil},
+	{"MA-TAR", "Taroudannt", "MA-09", nil},
+	{"MA-TAT", "Tata", "MA-09", nil},
+	{"MA-TAZ", "Taza", "MA-03", nil},
+	{"MA-TET", "Tétouan", "MA-01", nil},
+	{"MA-TIN", "Tinghir", "MA-08", nil},
+	{"MA-TIZ", "Tiznit", "MA-09", nil},
+	{"MA-TNG", "Tanger-Assilah", "MA-01", nil},
+	{"MA-TNT", "Tan-Tan (EH-partial)", "MA-10", []string{"Tan-Tan"}},
+	{"MA-YUS", "Youssoufia", "MA-07", nil},
+	{"MA-ZAG", "Zagora", "MA-08", nil},
+
+	// Monaco
+	{"MC-CL", "La Colle", "", nil},
+	{"MC-CO", "La Condamine", "", nil},
+	{"MC-FO", "Fontvieille", "", nil},
+	{"MC-GA", "La Gare", "", nil},
+	{"MC-JE", "Jardin Exotique", "", nil},
+	{"MC-LA", "Larvotto", "", nil},
+	{"MC-MA", "Malbousquet", "", nil},
+	{"MC-MC", "Monte-Carlo", "", nil},
+	{"MC-MG", "Moneghetti", "", nil},
+	{"MC-MO", "Monaco-Ville", "", nil},
+	{"MC-MU", "Moulins", "", nil},
+	{"MC-PH", "Port-Hercule", "", nil},
+	{"MC-SD", "Sainte-Dévote", "", nil},
+	{"MC-SO", "La Source", "", nil},
+	{"MC-SP", "Spélugues", "", nil},
+	{"MC-SR", "Saint-Roman", "", nil},
+	{"MC-VR", "Vallon de la Rousse", "", nil},
+
+	// Moldova
+	{"MD-AN", "Anenii Noi", "", nil},
+	{"MD-BA", "Bălți", "", nil},
+	{"MD-BD", "Bender", "", []string{"Tighina"}},
+	{"MD-BR", "Briceni", "", nil},
+	{"MD-BS", "Basarabeasca", "", nil},
+	{"MD-CA", "Cahul", "", nil},
+	{"MD-CL", "Călărași", "", nil},
+	{"MD-CM", "Cimișlia", "", nil},
+	{"MD-CR", "Criuleni", "", nil},
+	{"MD-CS", "Căușeni", "", nil},
+	{"MD-CT", "Cantemir", "", nil},
+	{"MD-CU", "Chișinău", "", nil},
+	{"MD-DO", "Dondușeni", "", nil},
+	{"MD-DR", "Drochia", "", nil},
+	{"MD-DU", "Dubăsari", "", nil},
+	{"MD-ED", "Edineț", "", nil},
+	{"MD-FA", "Fălești", "", nil},
+	{"MD-FL", "Florești", "", nil},
+	{"MD-GA", "Găgăuzia, Unitatea teritorială autonomă (UTAG)", "", []string{"Găgăuzia, Unitatea teritorială autonomă"}},
+	{"MD-GL", "Glodeni", "", nil},
+	{"MD-HI", "Hîncești", "", nil},
+	{"MD-IA", "Ialoveni", "", nil},
+	{"MD-LE", "Leova", "", nil},
+	{"MD-NI", "Nisporeni", "", nil},
+	{"MD-OC", "Ocnița", "", nil},
+	{"MD-OR", "Orhei", "", nil},
+	{"MD-RE", "Rezina", "", nil},
+	{"MD-RI", "Rîșcani", "", nil},
+	{"MD-SD", "Șoldănești", "", nil},
+	{"MD-SI", "Sîngerei", "", nil},
+	{"MD-SN", "Stînga Nistrului, unitatea teritorială din", "", nil},
+	{"MD-SO", "Soroca", "", nil},
+	{"MD-ST", "Strășeni", "", nil},
+	{"MD-SV", "Ștefan Vodă", "", nil},
+	{"MD-TA", "Taraclia", "", nil},
+	{"MD-TE", "Telenești", "", nil},
+	{"MD-UN", "Ungheni", "", nil},
+
+	// Montenegro
+	{"ME-01", "Andrijevica", "", nil},
+	{"ME-02", "Bar", "", nil},
+	{"ME-03", "Berane", "", nil},
+	{"ME-04", "Bijelo Polje", "", nil},
+	{"ME-05", "Budva", "", nil},
+	{"ME-06", "Cetinje", "", nil},
+	{"ME-07", "Danilovgrad", "", nil},
+	{"ME-08", "Herceg-Novi", "", nil},
+	{"ME-09", "Kolašin", "", nil},
+	{"ME-10", "Kotor", "", nil},
+	{"ME-11", "Mojkovac", "", nil},
+	{"ME-12", "Nikšić", "", nil},
+	{"ME-13", "Plav", "", nil},
+	{"ME-14", "Pljevlja", "", nil},
+	{"ME-15", "Plužine", "", nil},
+	{"ME-16", "Podgorica", "", nil},
+	{"ME-17", "Rožaje", "", nil},
+	{"ME-18", "Šavnik", "", nil},
+	{"ME-19", "Tivat", "", nil},
+	{"ME-20", "Ulcinj", "", nil},
+	{"ME-21", "Žabljak", "", nil},
+	{"ME-22", "Gusinje", "", nil},
+	{"ME-23", "Petnjica", "", nil},
+	{"ME-24", "Tuzi", "", nil},
+
+	// Madagascar
+	{"MG-A", "Toamasina", "", nil},
+	{"MG-D", "Antsiranana", "", nil},
+	{"MG-F", "Fianarantsoa", "", nil},
+	{"MG-M", "Mahajanga", "", nil},
+	{"MG-T", "Antananarivo", "", nil},
+	{"MG-U", "Toliara", "", nil},
+
+	// Marshall Islands
+	{"MH-ALK", "Ailuk", "MH-T", nil},
+	{"MH-ALL", "Ailinglaplap", "MH-L", nil},
+	{"MH-ARN", "Arno", "MH-T", nil},
+	{"MH-AUR", "Aur", "MH-T", nil},
+	{"MH-EBO", "Ebon", "MH-L", nil},
+	{"MH-ENI", "Enewetak & Ujelang", "MH-L", nil},
+	{"MH-JAB", "Jabat", "MH-L", nil},
+	{"MH-JAL", "Jaluit", "MH-L", nil},
+	{"MH-KIL", "Bikini & Kili", "MH-L", nil},
+	{"MH-KWA", "Kwajalein", "MH-L", nil},
+	{"MH-L", "Ralik chain", "", nil},
+	{"MH-LAE", "Lae", "MH-L", nil},
+	{"MH-LIB", "Lib", "MH-L", nil},
+	{"MH-LIK", "Likiep", "MH-T", nil},
+	{"MH-MAJ", "Majuro", "MH-T", nil},
+	{"MH-MAL", "Maloelap", "MH-T", nil},
+	{"MH-MEJ", "Mejit", "MH-T", nil},
+	{"MH-MIL", "Mili", "MH-T", nil},
+	{"MH-NMK", "Namdrik", "MH-L", nil},
+	{"MH-NMU", "Namu", "MH-L", nil},
+	{"MH-RON", "Rongelap", "MH-L", nil},
+	{"MH-T", "Ratak chain", "", nil},
+	{"MH-UJA", "Ujae", "MH-L", nil},
+	{"MH-UTI", "Utrik", "MH-T", nil},
+	{"MH-WTH", "Wotho", "MH-L", nil},
+	{"MH-WTJ", "Wotje", "MH-T", nil},
+
+	// North Macedonia
+	{"MK-101", "Veles", "", nil},
+	{"MK-102", "Gradsko", "", nil},
+	{"MK-103", "Demir Kapija", "", nil},
+	{"MK-104", "Kavadarci", "", nil},
+	{"MK-105", "Lozovo", "", nil},
+	{"MK-106", "Negotino", "", nil},
+	{"MK-107", "Rosoman", "", nil},
+	{"MK-108", "Sveti Nikole", "", nil},
+	{"MK-109", "Čaška", "", nil},
+	{"MK-201", "Berovo", "", nil},
+	{"MK-202", "Vinica", "", nil},
+	{"MK-203", "Delčevo", "", nil},
+	{"MK-204", "Zrnovci", "", nil},
+	{"MK-205", "Karbinci", "", nil},
+	{"MK-206", "Kočani", "", nil},
+	{"MK-207", "Makedonska Kamenica", "", nil},
+	{"MK-208", "Pehčevo", "", nil},
+	{"MK-209", "Probištip", "", nil},
+	{"MK-210", "Češinovo-Obleševo", "", nil},
+	{"MK-211", "Štip", "", nil},
+	{"MK-301", "Vevčani", "", nil},
+	{"MK-303", "Debar", "", nil},
+	{"MK-304", "Debrca", "", nil},
+	{"MK-307", "Kičevo", "", nil},
+	{"MK-308", "Makedonski Brod", "", nil},
+	{"MK-310", "Ohrid", "", nil},
+	{"MK-311", "Plasnica", "", nil},
+	{"MK-312", "Struga", "", nil},
+	{"MK-313", "Centar Župa", "", nil},
+	{"MK-401", "Bogdanci", "", nil},
+	{"MK-402", "Bosilovo", "", nil},
+	{"MK-403", "Valandovo", "", nil},
+	{"MK-404", "Vasilevo", "", nil},
+	{"MK-405", "Gevgelija", "", nil},
+	{"MK-406", "Dojran", "", nil},
+	{"MK-407", "Konče", "", nil},
+	{"MK-408", "Novo Selo", "", nil},
+	{"MK-409", "Radoviš", "", nil},
+	{"MK-410", "Strumica", "", nil},
+	{"MK-501", "Bitola", "", nil},
+	{"MK-502", "Demir Hisar", "", nil},
+	{"MK-503", "Dolneni", "", nil},
+	{"MK-504", "Krivogaštani", "", nil},
+	{"MK-505", "Kruševo", "", nil},
+	{"MK-506", "Mogila", "", nil},
+	{"MK-507", "Novaci", "", nil},
+	{"MK-508", "Prilep", "", nil},
+	{"MK-509", "Resen", "", nil},
+	{"MK-601", "Bogovinje", "", nil},
+	{"MK-602", "Brvenica", "", nil},
+	{"MK-603", "Vrapčište", "", nil},
+	{"MK-604", "Gostivar", "", nil},
+	{"MK-605", "Želino", "", nil},
+	{"MK-606", "Jegunovce", "", nil},
+	{"MK-607", "Mavrovo i Rostuše", "", nil},
+	{"MK-608", "Tearce", "", nil},
+	{"MK-609", "Tetovo", "", nil},
+	{"MK-701", "Kratovo", "", nil},
+	{"MK-702", "Kriva Palanka", "", nil},
+	{"MK-703", "Kumanovo", "", nil},
+	{"MK-704", "Lipkovo", "", nil},
+	{"MK-705", "Rankovce", "", nil},
+	{"MK-706", "Staro Nagoričane", "", nil},
+	{"MK-801", "Aerodrom †", "", nil},
+	{"MK-802", "Aračinovo", "", nil},
+	{"MK-803", "Butel †", "", nil},
+	{"MK-804", "Gazi Baba †", "", nil},
+	{"MK-805", "Gjorče Petrov †", "", nil},
+	{"MK-806", "Zelenikovo", "", nil},
+	{"MK-807", "Ilinden", "", nil},
+	{"MK-808", "Karpoš †", "", nil},
+	{"MK-809", "Kisela Voda †", "", nil},
+	{"MK-810", "Petrovec", "", nil},
+	{"MK-811", "Saraj †", "", nil},
+	{"MK-812", "Sopište", "", nil},
+	{"MK-813", "Studeničani", "", nil},
+	{"MK-814", "Centar †", "", nil},
+	{"MK-815", "Čair †", "", nil},
+	{"MK-816", "Čučer-Sandevo", "", nil},
+	{"MK-817", "Šuto Orizari †", "", nil},
+
+	// Mali
+	{"ML-1", "Kayes", "", nil},
+	{"ML-10", "Taoudénit", "", nil},
+	{"ML-2", "Koulikoro", "", nil},
+	{"ML-3", "Sikasso", "", nil},
+	{"ML-4", "Ségou", "", nil},
+	{"ML-5", "Mopti", "", nil},
+	{"ML-6", "Tombouctou", "", nil},
+	{"ML-7", "Gao", "", nil},
+	{"ML-8", "Kidal", "", nil},
+	{"ML-9", "Ménaka", "", nil},
+	{"ML-BKO", "Bamako", "", nil},
+
+	// Myanmar
+	{"MM-01", "Sagaing", "", nil},
+	{"MM-02", "Bago", "", nil},
+	{"MM-03", "Magway", "", nil},
+	{"MM-04", "Mandalay", "", nil},
+	{"MM-05", "Tanintharyi", "", nil},
+	{"MM-06", "Yangon", "", nil},
+	{"MM-07", "Ayeyarwady", "", nil},
+	{"MM-11", "Kachin", "", nil},
+	{"MM-12", "Kayah", "", nil},
+	{"MM-13", "Kayin", "", nil},
+	{"MM-14", "Chin", "", nil},
+	{"MM-15", "Mon", "", nil},
+	{"MM-16", "Rakhine", "", nil},
+	{"MM-17", "Shan", "", nil},
+	{"MM-18", "Nay Pyi Taw", "", nil},
+
+	// Mongolia
+	{"MN-035", "Orhon", "", nil},
+	{"MN-037", "Darhan uul", "", nil},
+	{"MN-039", "Hentiy", "", nil},
+	{"MN-041", "Hövsgöl", "", nil},
+	{"MN-043", "Hovd", "", nil},
+	{"MN-046", "Uvs", "", nil},
+	{"MN-047", "Töv", "", nil},
+	{"MN-049", "Selenge", "", nil},
+	{"MN-051", "Sühbaatar", "", nil},
+	{"MN-053", "Ömnögovĭ", "", nil},
+	{"MN-055", "Övörhangay", "", nil},
+	{"MN-057", "Dzavhan", "", nil},
+	{"MN-059", "Dundgovĭ", "", nil},
+	{"MN-061", "Dornod", "", nil},
+	{"MN-063", "Dornogovĭ", "", nil},
+	{"MN-064", "Govĭ-Sümber", "", nil},
+	{"MN-065", "Govĭ-Altay", "", nil},
+	{"MN-067", "Bulgan", "", nil},
+	{"MN-069", "Bayanhongor", "", nil},
+	{"MN-071", "Bayan-Ölgiy", "", nil},
+	{"MN-073", "Arhangay", "", nil},
+	{"MN-1", "Ulaanbaatar", "", nil},
+
+	// Mauritania
+	{"MR-01", "Hodh ech Chargui", "", nil},
+	{"MR-02", "Hodh el Gharbi", "", nil},
+	{"MR-03", "Assaba", "", nil},
+	{"MR-04", "Gorgol", "", nil},
+	{"MR-05", "Brakna", "", nil},
+	{"MR-06", "Trarza", "", nil},
+	{"MR-07", "Adrar", "", nil},
+	{"MR-08", "Dakhlet Nouâdhibou", "", nil},
+	{"MR-09", "Tagant", "", nil},
+	{"MR-10", "Guidimaka", "", nil},
+	{"MR-11", "Tiris Zemmour", "", nil},
+	{"MR-12", "Inchiri", "", nil},
+	{"MR-13", "Nouakchott Ouest", "", nil},
+	{"MR-14", "Nouakchott Nord", "", nil},
+	{"MR-15", "Nouakchott Sud", "", nil},
+
+	// Malta
+	{"MT-01", "Attard", "", nil},
+	{"MT-02", "Balzan", "", nil},
+	{"MT-03", "Birgu", "", nil},
+	{"MT-04", "Birkirkara", "", nil},
+	{"MT-05", "Birżebbuġa", "", nil},
+	{"MT-06", "Bormla", "", nil},
+	{"MT-07", "Dingli", "", nil},
+	{"MT-08", "Fgura", "", nil},
+	{"MT-09", "Floriana", "", nil},
+	{"MT-10", "Fontana", "", nil},
+	{"MT-11", "Gudja", "", nil},
+	{"MT-12", "Gżira", "", nil},
+	{"MT-13", "Għajnsielem", "", nil},
+	{"MT-14", "Għarb", "", nil},
+	{"MT-15", "Għargħur", "", nil},
+	{"MT-16", "Għasri", "", nil},
+	{"MT-17", "Għaxaq", "", nil},
+	{"MT-18", "Ħamrun", "", nil},
+	{"MT-19", "Iklin", "", nil},
+	{"MT-20", "Isla", "", nil},
+	{"MT-21", "Kalkara", "", nil},
+	{"MT-22", "Kerċem", "", nil},
+	{"MT-23", "Kirkop", "", nil},
+	{"MT-24", "Lija", "", nil},
+	{"MT-25", "Luqa", "", nil},
+	{"MT-26", "Marsa", "", nil},
+	{"MT-27", "Marsaskala", "", nil},
+	{"MT-28", "Marsaxlokk", "", nil},
+	{"MT-29", "Mdina", "", nil},
+	{"MT-30", "Mellieħa", "", nil},
+	{"MT-31", "Mġarr", "", nil},
+	{"MT-32", "Mosta", "", nil},
+	{"MT-33", "Mqabba", "", nil},
+	{"MT-34", "Msida", "", nil},
+	{"MT-35", "Mtarfa", "", nil},
+	{"MT-36", "Munxar", "", nil},
+	{"MT-37", "Nadur", "", nil},
+	{"MT-38", "Naxxar", "", nil},
+	{"MT-39", "Paola", "", nil},
+	{"MT-40", "Pembroke", "", nil},
+	{"MT-41", "Pietà", "", nil},
+	{"MT-42", "Qala", "", nil},
+	{"MT-43", "Qormi", "", nil},
+	{"MT-44", "Qrendi", "", nil},
+	{"MT-45", "Rabat Gozo", "", nil},
+	{"MT-46", "Rabat Malta", "", nil},
+	{"MT-47", "Safi", "", nil},
+	{"MT-48", "Saint Julian's", "", nil},
+	{"MT-49", "Saint John", "", nil},
+	{"MT-50", "Saint Lawrence", "", nil},
+	{"MT-51", "Saint Paul's Bay", "", nil},
+	{"MT-52", "Sannat", "", nil},
+	{"MT-53", "Saint Lucia's", "", nil},
+	{"MT-54", "Santa Venera", "", nil},
+	{"MT-55", "Siġġiewi", "", nil},
+	{"MT-56", "Sliema", "", nil},
+	{"MT-57", "Swieqi", "", nil},
+	{"MT-58", "Ta' Xbiex", "", nil},
+	{"MT-59", "Tarxien", "", nil},
+	{"MT-60", "Valletta", "", nil},
+	{"MT-61", "Xagħra", "", nil},
+	{"MT-62", "Xewkija", "", nil},
+	{"MT-63", "Xgħajra", "", nil},
+	{"MT-64", "Żabbar", "", nil},
+	{"MT-65", "Żebbuġ Gozo", "", nil},
+	{"MT-66", "Żebbuġ Malta", "", nil},
+	{"MT-67", "Żejtun", "", nil},
+	{"MT-68", "Żurrieq", "", nil},
+
+	// Mauritius
+	{"MU-AG", "Agalega Islands", "", nil},
+	{"MU-BL", "Black River", "", nil},
+	{"MU-CC", "Cargados Carajos Shoals", "", nil},
+	{"MU-FL", "Flacq", "", nil},
+	{"MU-GP", "Grand Port", "", nil},
+	{"MU-MO", "Moka", "", nil},
+	{"MU-PA", "Pamplemousses", "", nil},
+	{"MU-PL", "Port Louis", "", nil},
+	{"MU-PW", "Plaines Wilhems", "", nil},
+	{"MU-RO", "Rodrigues Island", "", nil},
+	{"MU-RR", "Rivière du Rempart", "", nil},
+	{"MU-SA", "Savanne", "", nil},
+
+	// Maldives
+	{"MV-00", "South Ari Atoll", "", nil},
+	{"MV-01", "Addu City", "", nil},
+	{"MV-02", "North Ari Atoll", "", nil},
+	{"MV-03", "Faadhippolhu", "", nil},
+	{"MV-04", "Felidhu Atoll", "", nil},
+	{"MV-05", "Hahdhunmathi", "", nil},
+	{"MV-07", "North Thiladhunmathi", "", nil},
+	{"MV-08", "Kolhumadulu", "", nil},
+	{"MV-12", "Mulaku Atoll", "", nil},
+	{"MV-13", "North Maalhosmadulu", "", nil},
+	{"MV-14", "North Nilandhe Atoll", "", nil},
+	{"MV-17", "South Nilandhe Atoll", "", nil},
+	{"MV-20", "South Maalhosmadulu", "", nil},
+	{"MV-23", "South Thiladhunmathi", "", nil},
+	{"MV-24", "North Miladhunmadulu", "", nil},
+	{"MV-25", "South Miladhunmadulu", "", nil},
+	{"MV-26", "Male Atoll", "", nil},
+	{"MV-27", "North Huvadhu Atoll", "", nil},
+	{"MV-28", "South Huvadhu Atoll", "", nil},
+	{"MV-29", "Fuvammulah", "", nil},
+	{"MV-MLE", "Male", "", nil},
+
+	// Malawi
+	{"MW-BA", "Balaka", "MW-S", nil},
+	{"MW-BL", "Blantyre", "MW-S", nil},
+	{"MW-C", "Central Region", "", nil},
+	{"MW-CK", "Chikwawa", "MW-S", nil},
+	{"MW-CR", "Chiradzulu", "MW-S", nil},
+	{"MW-CT", "Chitipa", "MW-N", nil},
+	{"MW-DE", "Dedza", "MW-C", nil},
+	{"MW-DO", "Dowa", "MW-C", nil},
+	{"MW-KR", "Karonga", "MW-N", nil},
+	{"MW-KS", "Kasungu", "MW-C", nil},
+	{"MW-LI", "Lilongwe", "MW-C", nil},
+	{"MW-LK", "Likoma", "MW-N", nil},
+	{"MW-MC", "Mchinji", "MW-C", nil},
+	{"MW-MG", "Mangochi", "MW-S", nil},
+	{"MW-MH", "Machinga", "MW-S", nil},
+	{"MW-MU", "Mulanje", "MW-S", nil},
+	{"MW-MW", "Mwanza", "MW-S", nil},
+	{"MW-MZ", "Mzimba", "MW-N", nil},
+	{"MW-N", "Northern Region", "", nil},
+	{"MW-NB", "Nkhata Bay", "MW-N", nil},
+	{"MW-NE", "Neno", "MW-S", nil},
+	{"MW-NI", "Ntchisi", "MW-C", nil},
+	{"MW-NK", "Nkhotakota", "MW-C", nil},
+	{"MW-NS", "Nsanje", "MW-S", nil},
+	{"MW-NU", "Ntcheu", "MW-C", nil},
+	{"MW-PH", "Phalombe", "MW-S", nil},
+	{"MW-RU", "Rumphi", "MW-N", nil},
+	{"MW-S", "Southern Region", "", nil},
+	{"MW-SA", "Salima", "MW-C", nil},
+	{"MW-TH", "Thyolo", "MW-S", nil},
+	{"MW-ZO", "Zomba", "MW-S", nil},
+
+	// Mexico
+	{"MX-AGU", "Aguascalientes", "", nil},
+	{"MX-BCN", "Baja California", "", nil},
+	{"MX-BCS", "Baja California Sur", "", nil},
+	{"MX-CAM", "Campeche", "", nil},
+	{"MX-CHH", "Chihuahua", "", nil},
+	{"MX-CHP", "Chiapas", "", nil},
+	{"MX-CMX", "Mexico City", "", []string{"Ciudad de México", "CDMX"}},
+	{"MX-COA", "Coahuila", "", []string{"Coahuila de Zaragoza"}},
+	{"MX-COL", "Colima", "", nil},
+	{"MX-DUR", "Durango", "", nil},
+	{"MX-GRO", "Guerrero", "", nil},
+	{"MX-GUA", "Guanajuato", "", nil},
+	{"MX-HID", "Hidalgo", "", nil},
+	{"MX-JAL", "Jalisco", "", nil},
+	{"MX-MEX", "State of Mexico", "", []string{"México", "Estado de México"}},
+	{"MX-MIC", "Michoacán", "", []string{"Michoacán de Ocampo"}},
+	{"MX-MOR", "Morelos", "", nil},
+	{"MX-NAY", "Nayarit", "", nil},
+	{"MX-NLE", "Nuevo León", "", nil},
+	{"MX-OAX", "Oaxaca", "", nil},
+	{"MX-PUE", "Puebla", "", nil},
+	{"MX-QUE", "Querétaro", "", nil},
+	{"MX-ROO", "Quintana Roo", "", nil},
+	{"MX-SIN", "Sinaloa", "", nil},
+	{"MX-SLP", "San Luis Potosí", "", nil},
+	{"MX-SON", "Sonora", "", nil},
+	{"MX-TAB", "Tabasco", "", nil},
+	{"MX-TAM", "Tamaulipas", "", nil},
+	{"MX-TLA", "Tlaxcala", "", nil},
+	{"MX-VER", "Veracruz", "", []string{"Veracruz de Ignacio de la Llave"}},
+	{"MX-YUC", "Yucatán", "", nil},
+	{"MX-ZAC", "Zacatecas", "", nil},
+
+	// Malaysia
+	{"MY-01", "Johor", "", nil},
+	{"MY-02", "Kedah", "", nil},
+	{"MY-03", "Kelantan", "", nil},
+	{"MY-04", "Melaka", "", nil},
+	{"MY-05", "Negeri Sembilan", "", nil},
+	{"MY-06", "Pahang", "", nil},
+	{"MY-07", "Pulau Pinang", "", nil},
+	{"MY-08", "Perak", "", nil},
+	{"MY-09", "Perlis", "", nil},
+	{"MY-10", "Selangor", "", nil},
+	{"MY-11", "Terengganu", "", nil},
+	{"MY-12", "Sabah", "", nil},
+	{"MY-13", "Sarawak", "", nil},
+	{"MY-14", "Wilayah Persekutuan Kuala Lumpur", "", nil},
+	{"MY-15", "Wilayah Persekutuan Labuan", "", nil},
+	{"MY-16", "Wilayah Persekutuan Putrajaya", "", nil},
+
+	// Mozambique
+	{"MZ-A", "Niassa", "", nil},
+	{"MZ-B", "Manica", "", nil},
+	{"MZ-G", "Gaza", "", nil},
+	{"MZ-I", "Inhambane", "", nil},
+	{"MZ-L", "Maputo", "", nil},
+	{"MZ-MPM", "Maputo", "", nil},
+	{"MZ-N", "Nampula", "", nil},
+	{"MZ-P", "Cabo Delgado", "", nil},
+	{"MZ-Q", "Zambézia", "", nil},
+	{"MZ-S", "Sofala", "", nil},
+	{"MZ-T", "Tete", "", nil},
+
+	// Namibia
+	{"NA-CA", "Zambezi", "", nil},
+	{"NA-ER", "Erongo", "", nil},
+	{"NA-HA", "Hardap", "", nil},
+	{"NA-KA", "//Karas", "", nil},
+	{"NA-KE", "Kavango East", "", nil},
+	{"NA-KH", "Khomas", "", nil},
+	{"NA-KU", "Kunene", "", nil},
+	{"NA-KW", "Kavango West", "", nil},
+	{"NA-OD", "Otjozondjupa", "", nil},
+	{"NA-OH", "Omaheke", "", nil},
+	{"NA-ON", "Oshana", "", nil},
+	{"NA-OS", "Omusati", "", nil},
+	{"NA-OT", "Oshikoto", "", nil},
+	{"NA-OW", "Ohangwena", "", nil},
+
+	// Niger
+	{"NE-1", "Agadez", "", nil},
+	{"NE-2", "Diffa", "", nil},
+	{"NE-3", "Dosso", "", nil},
+	{"NE-4", "Maradi", "", nil},
+	{"NE-5", "Tahoua", "", nil},
+	{"NE-6", "Tillabéri", "", nil},
+	{"NE-7", "Zinder", "", nil},
+	{"NE-8", "Niamey", "", nil},
+
+	// Nigeria
+	{"NG-AB", "Abia", "", nil},
+	{"NG-AD", "Adamawa", "", nil},
+	{"NG-AK", "Akwa Ibom", "", nil},
+	{"NG-AN", "Anambra", "", nil},
+	{"NG-BA", "Bauchi", "", nil},
+	{"NG-BE", "Benue", "", nil},
+	{"NG-BO", "Borno", "", nil},
+	{"NG-BY", "Bayelsa", "", nil},
+	{"NG-CR", "Cross River", "", nil},
+	{"NG-DE", "Delta", "", nil},
+	{"NG-EB", "Ebonyi", "", nil},
+	{"NG-ED", "Edo", "", nil},
+	{"NG-EK", "Ekiti", "", nil},
+	{"NG-EN", "Enugu", "", nil},
+	{"NG-FC", "Abuja Federal Capital Territory", "", nil},
+	{"NG-GO", "Gombe", "", nil},
+	{"NG-IM", "Imo", "", nil},
+	{"NG-JI", "Jigawa", "", nil},
+	{"NG-KD", "Kaduna", "", nil},
+	{"NG-KE", "Kebbi", "", nil},
+	{"NG-KN", "Kano", "", nil},
+	{"NG-KO", "Kogi", "", nil},
+	{"NG-KT", "Katsina", "", nil},
+	{"NG-KW", "Kwara", "", nil},
+	{"NG-LA", "Lagos", "", nil},
+	{"NG-NA", "Nasarawa", "", nil},
+	{"NG-NI", "Niger", "", nil},
+	{"NG-OG", "Ogun", "", nil},
+	{"NG-ON", "Ondo", "", nil},
+	{"NG-OS", "Osun", "", nil},
+	{"NG-OY", "Oyo", "", nil},
+	{"NG-PL", "Plateau", "", nil},
+	{"NG-RI", "Rivers", "", nil},
+	{"NG-SO", "Sokoto", "", nil},
+	{"NG-TA", "Taraba", "", nil},
+	{"NG-YO", "Yobe", "", nil},
+	{"NG-ZA", "Zamfara", "", nil},
+
+	// Nicaragua
+	{"NI-AN", "Costa Caribe Norte", "", nil},
+	{"NI-AS", "Costa Caribe Sur", "", nil},
+	{"NI-BO", "Boaco", "", nil},
+	{"NI-CA", "Carazo", "", nil},
+	{"NI-CI", "Chinandega", "", nil},
+	{"NI-CO", "Chontales", "", nil},
+	{"NI-ES", "Estelí", "", nil},
+	{"NI-GR", "Granada", "", nil},
+	{"NI-JI", "Jinotega", "", nil},
+	{"NI-LE", "León", "", nil},
+	{"NI-MD", "Madriz", "", nil},
+	{"NI-MN", "Managua", "", nil},
+	{"NI-MS", "Masaya", "", nil},
+	{"NI-MT", "Matagalpa", "", nil},
+	{"NI-NS", "Nueva Segovia", "", nil},
+	{"NI-RI", "Rivas", "", nil},
+	{"NI-SJ", "Río San Juan", "", nil},
+
+	// Netherlands
+	{"NL-AW", "Aruba", "", nil},
+	{"NL-BQ1", "Bonaire", "", nil},
+	{"NL-BQ2", "Saba", "", nil},
+	{"NL-BQ3", "Sint Eustatius", "", nil},
+	{"NL-CW", "Curaçao", "", nil},
+	{"NL-DR", "Drenthe", "", nil},
+	{"NL-FL", "Flevoland", "", nil},
+	{"NL-FR", "Fryslân", "", nil},
+	{"NL-GE", "Gelderland", "", nil},
+	{"NL-GR", "Groningen", "", nil},
+	{"NL-LI", "Limburg", "", nil},
+	{"NL-NB", "Noord-Brabant", "", nil},
+	{"NL-NH", "Noord-Holland", "", nil},
+	{"NL-OV", "Overijssel", "", nil},
+	{"NL-SX", "Sint Maarten", "", nil},
+	{"NL-UT", "Utrecht", "", nil},
+	{"NL-ZE", "Zeeland", "", nil},
+	{"NL-ZH", "Zuid-Holland", "", nil},
+
+	// Norway
+	{"NO-03", "Oslo", "", nil},
+	{"NO-11", "Rogaland", "", nil},
+	{"NO-15", "Møre og Romsdal", "", nil},
+	{"NO-18", "Nordland", "", nil},
+	{"NO-21", "Svalbard (Arctic Region)", "", []string{"Svalbard"}},
+	{"NO-22", "Jan Mayen (Arctic Region)", "", []string{"Jan Mayen"}},
+	{"NO-30", "Viken", "", nil},
+	{"NO-34", "Innlandet", "", nil},
+	{"NO-38", "Vestfold og Telemark", "", nil},
+	{"NO-42", "Agder", "", nil},
+	{"NO-46", "Vestland", "", nil},
+	{"NO-50", "Trööndelage", "", nil},
+	{"NO-54", "Romssa ja Finnmárkku", "", nil},
+
+	// Nepal
+	{"NP-1", "Central", "", nil},
+	{"NP-2", "Mid Western", "", nil},
+	{"NP-3", "Western", "", nil},
+	{"NP-4", "Eastern", "", nil},
+	{"NP-5", "Far Western", "", nil},
+	{"NP-BA", "Bagmati", "NP-1", nil},
+	{"NP-BH", "Bheri", "NP-2", nil},
+	{"NP-DH", "Dhawalagiri", "NP-3", nil},
+	{"NP-GA", "Gandaki", "NP-3", nil},
+	{"NP-JA", "Janakpur", "NP-1", nil},
+	{"NP-KA", "Karnali", "NP-2", nil},
+	{"NP-KO", "Kosi", "NP-4", nil},
+	{"NP-LU", "Lumbini", "NP-3", nil},
+	{"NP-MA", "Mahakali", "NP-5", nil},
+	{"NP-ME", "Mechi", "NP-4", nil},
+	{"NP-NA", "Narayani", "NP-1", nil},
+	{"NP-P1", "Province 1", "", nil},
+	{"NP-P2", "Province 2", "", nil},
+	{"NP-P3", "Bāgmatī", "", nil},
+	{"NP-P4", "Gandaki", "", nil},
+	{"NP-P5", "Province 5", "", nil},
+	{"NP-P6", "Karnali", "", nil},
+	{"NP-P7", "Sudūr Pashchim", "", nil},
+	{"NP-RA", "Rapti", "NP-2", nil},
+	{"NP-SA", "Sagarmatha", "NP-4", nil},
+	{"NP-SE", "Seti", "NP-5", nil},
+
+	// Nauru
+	{"NR-01", "Aiwo", "", nil},
+	{"NR-02", "Anabar", "", nil},
+	{"NR-03", "Anetan", "", nil},
+	{"NR-04", "Anibare", "", nil},
+	{"NR-05", "Baitsi", "", nil},
+	{"NR-06", "Boe", "", nil},
+	{"NR-07", "Buada", "", nil},
+	{"NR-08", "Denigomodu", "", nil},
+	{"NR-09", "Ewa", "", nil},
+	{"NR-10", "Ijuw", "", nil},
+	{"NR-11", "Meneng", "", nil},
+	{"NR-12", "Nibok", "", nil},
+	{"NR-13", "Uaboe", "", nil},
+	{"NR-14", "Yaren", "", nil},
+
+	// New Zealand
+	{"NZ-AUK", "Auckland", "", nil},
+	{"NZ-BOP", "Bay of Plenty", "", nil},
+	{"NZ-CAN", "Canterbury", "", nil},
+	{"NZ-CIT", "Chatham Islands Territory", "", nil},
+	{"NZ-GIS", "Gisborne", "", nil},
+	{"NZ-HKB", "Hawke's Bay", "", nil},
+	{"NZ-MBH", "Marlborough", "", nil},
+	{"NZ-MWT", "Manawatu-Wanganui", "", nil},
+	{"NZ-NSN", "Nelson", "", nil},
+	{"NZ-NTL", "Northland", "", nil},
+	{"NZ-OTA", "Otago", "", nil},
+	{"NZ-STL", "Southland", "", nil},
+	{"NZ-TAS", "Tasman", "", nil},
+	{"NZ-TKI", "Taranaki", "", nil},
+	{"NZ-WGN", "Wellington", "", nil},
+	{"NZ-WKO", "Waikato", "", nil},
+	{"NZ-WTC", "West Coast", "", nil},
+
+	// Oman
+	{"OM-BJ", "Janūb al Bāţinah", "", nil},
+	{"OM-BS", "Shamāl al Bāţinah", "", nil},
+	{"OM-BU", "Al Buraymī", "", nil},
+	{"OM-DA", "Ad Dākhilīyah", "", nil},
+	{"OM-MA", "Masqaţ", "", nil},
+	{"OM-MU", "Musandam", "", nil},
+	{"OM-SJ", "Janūb ash Sharqīyah", "", nil},
+	{"OM-SS", "Shamāl ash Sharqīyah", "", nil},
+	{"OM-WU", "Al Wusţá", "", nil},
+	{"OM-ZA", "Az̧ Z̧āhirah", "", nil},
+	{"OM-ZU", "Z̧ufār", "", nil},
+
+	// Panama
+	{"PA-1", "Bocas del Toro", "", nil},
+	{"PA-10", "Panamá Oeste", "", nil},
+	{"PA-2", "Coclé", "", nil},
+	{"PA-3", "Colón", "", nil},
+	{"PA-4", "Chiriquí", "", nil},
+	{"PA-5", "Darién", "", nil},
+	{"PA-6", "Herrera", "", nil},
+	{"PA-7", "Los Santos", "", nil},
+	{"PA-8", "Panamá", "", nil},
+	{"PA-9", "Veraguas", "", nil},
+	{"PA-EM", "Emberá", "", nil},
+	{"PA-KY", "Guna Yala", "", nil},
+	{"PA-NB", "Ngöbe-Buglé", "", nil},
+
+	// Peru
+	{"PE-AMA", "Amarumayu", "", nil},
+	{"PE-ANC", "Ancash", "", nil},
+	{"PE-APU", "Apurimaq", "", nil},
+	{"PE-ARE", "Arequipa", "", nil},
+	{"PE-AYA", "Ayacucho", "", nil},
+	{"PE-CAJ", "Cajamarca", "", nil},
+	{"PE-CAL", "El Callao", "", nil},
+	{"PE-CUS", "Cusco", "", nil},
+	{"PE-HUC", "Huánuco", "", nil},
+	{"PE-HUV", "Huancavelica", "", nil},
+	{"PE-ICA", "Ica", "", nil},
+	{"PE-JUN", "Hunin", "", nil},
+	{"PE-LAL", "La Libertad", "", nil},
+	{"PE-LAM", "Lambayeque", "", nil},
+	{"PE-LIM", "Lima", "", nil},
+	{"PE-LMA", "Lima hatun llaqta", "", nil},
+	{"PE-LOR", "Loreto", "", nil},
+	{"PE-MDD", "Madre de Dios", "", nil},
+	{"PE-MOQ", "Moquegua", "", nil},
+	{"PE-PAS", "Pasco", "", nil},
+	{"PE-PIU", "Piura", "", nil},
+	{"PE-PUN", "Puno", "", nil},
+	{"PE-SAM", "San Martin", "", nil},
+	{"PE-TAC", "Tacna", "", nil},
+	{"PE-TUM", "Tumbes", "", nil},
+	{"PE-UCA", "Ucayali", "", nil},
+
+	// Papua New Guinea
+	{"PG-CPK", "Chimbu", "", nil},
+	{"PG-CPM", "Central", "", nil},
+	{"PG-EBR", "East New Britain", "", nil},
+	{"PG-EHG", "Eastern Highlands", "", nil},
+	{"PG-EPW", "Enga", "", nil},
+	{"PG-ESW", "East Sepik", "", nil},
+	{"PG-GPK", "Gulf", "", nil},
+	{"PG-HLA", "Hela", "", nil},
+	{"PG-JWK", "Jiwaka", "", nil},
+	{"PG-MBA", "Milne Bay", "", nil},
+	{"PG-MPL", "Morobe", "", nil},
+	{"PG-MPM", "Madang", "", nil},
+	{"PG-MRL", "Manus", "", nil},
+	{"PG-NCD", "National Capital District (Port Moresby)", "", []string{"National Capital District"}},
+	{"PG-NIK", "New Ireland", "", nil},
+	{"PG-NPP", "Northern", "", nil},
+	{"PG-NSB", "Bougainville", "", nil},
+	{"PG-SAN", "West Sepik", "", nil},
+	{"PG-SHM", "Southern Highlands", "", nil},
+	{"PG-WBK", "West New Britain", "", nil},
+	{"PG-WHM", "Western Highlands", "", nil},
+	{"PG-WPD", "Western", "", nil},
+
+	// Philippines
+	{"PH-00", "National Capital Region", "", nil},
+	{"PH-01", "Ilocos (Region I)", "", []string{"Ilocos"}},
+	{"PH-02", "Cagayan Valley (Region II)", "", []string{"Cagayan Valley"}},
+	{"PH-03", "Central Luzon (Region III)", "", []string{"Central Luzon"}},
+	{"PH-05", "Bicol (Region V)", "", []string{"Bicol"}},
+	{"PH-06", "Western Visayas (Region VI)", "", []string{"Western Visayas"}},
+	{"PH-07", "Central Visayas (Region VII)", "", []string{"Central Visayas"}},
+	{"PH-08", "Eastern Visayas (Region VIII)", "", []string{"Eastern Visayas"}},
+	{"PH-09", "Zamboanga Peninsula (Region IX)", "", []string{"Zamboanga Peninsula"}},
+	{"PH-10", "Northern Mindanao (Region X)", "", []string{"Northern Mindanao"}},
+	{"PH-11", "Davao (Region XI)", "", []string{"Davao"}},
+	{"PH-12", "Soccsksargen (Region XII)", "", []string{"Soccsksargen"}},
+	{"PH-13", "Caraga (Region XIII)", "", []string{"Caraga"}},
+	{"PH-14", "Autonomous Region in Muslim Mindanao (ARMM)", "", []string{"Autonomous Region in Muslim Mindanao"}},
+	{"PH-15", "Cordillera Administrative Region (CAR)", "", []string{"Cordillera Administrative Region"}},
+	{"PH-40", "Calabarzon (Region IV-A)", "", []string{"Calabarzon"}},
+	{"PH-41", "Mimaropa (Region IV-B)", "", []string{"Mimaropa"}},
+	{"PH-ABR", "Abra", "PH-15", nil},
+	{"PH-AGN", "Agusan del Norte", "PH-13", nil},
+	{"PH-AGS", "Agusan del Sur", "PH-13", nil},
+	{"PH-AKL", "Aklan", "PH-06", nil},
+	{"PH-ALB", "Albay", "PH-05", nil},
+	{"PH-ANT", "Antique", "PH-06", nil},
+	{"PH-APA", "Apayao", "PH-15", nil},
+	{"PH-AUR", "Aurora", "PH-03", nil},
+	{"PH-BAN", "Bataan", "PH-03", nil},
+	{"PH-BAS", "Basilan", "PH-09", nil},
+	{"PH-BEN", "Benguet", "PH-15", nil},
+	{"PH-BIL", "Biliran", "PH-08", nil},
+	{"PH-BOH", "Bohol", "PH-07", nil},
+	{"PH-BTG", "Batangas", "PH-40", nil},
+	{"PH-BTN", "Batanes", "PH-02", nil},
+	{"PH-BUK", "Bukidnon", "PH-10", nil},
+	{"PH-BUL", "Bulacan", "PH-03", nil},
+	{"PH-CAG", "Cagayan", "PH-02", nil},
+	{"PH-CAM", "Camiguin", "PH-10", nil},
+	{"PH-CAN", "Camarines Norte", "PH-05", nil},
+	{"PH-CAP", "Capiz", "PH-06", nil},
+	{"PH-CAS", "Camarines Sur", "PH-05", nil},
+	{"PH-CAT", "Catanduanes", "PH-05", nil},
+	{"PH-CAV", "Cavite", "PH-40", nil},
+	{"PH-CEB", "Cebu", "PH-07", nil},
+	{"PH-COM", "Davao de Oro", "PH-11", nil},
+	{"PH-DAO", "Davao Oriental", "PH-11", nil},
+	{"PH-DAS", "Davao del Sur", "PH-11", nil},
+	{"PH-DAV", "Davao del Norte", "PH-11", nil},
+	{"PH-DIN", "Dinagat Islands", "PH-13", nil},
+	{"PH-DVO", "Davao Occidental", "PH-11", nil},
+	{"PH-EAS", "Eastern Samar", "PH-08", nil},
+	{"PH-GUI", "Guimaras", "PH-06", nil},
+	{"PH-IFU", "Ifugao", "PH-15", nil},
+	{"PH-ILI", "Iloilo", "PH-06", nil},
+	{"PH-ILN", "Ilocos Norte", "PH-01", nil},
+	{"PH-ILS", "Ilocos Sur", "PH-01", nil},
+	{"PH-ISA", "Isabela", "PH-02", nil},
+	{"PH-KAL", "Kalinga", "PH-15", nil},
+	{"PH-LAG", "Laguna", "PH-40", nil},
+	{"PH-LAN", "Lanao del Norte", "PH-12", nil},
+	{"PH-LAS", "Lanao del Sur", "PH-14", nil},
+	{"PH-LEY", "Leyte", "PH-08", nil},
+	{"PH-LUN", "La Union", "PH-01", nil},
+	{"PH-MAD", "Marinduque", "PH-41", nil},
+	{"PH-MAG", "Maguindanao", "PH-14", nil},
+	{"PH-MAS", "Masbate", "PH-05", nil},
+	{"PH-MDC", "Mindoro Occidental", "PH-41", nil},
+	{"PH-MDR", "Mindoro Oriental", "PH-41", nil},
+	{"PH-MOU", "Mountain Province", "PH-15", nil},
+	{"PH-MSC", "Misamis Occidental", "PH-10", nil},
+	{"PH-MSR", "Misamis Oriental", "PH-10", nil},
+	{"PH-NCO", "Cotabato", "PH-12", nil},
+	{"PH-NEC", "Negros Occidental", "PH-06", nil},
+	{"PH-NER", "Negros Oriental", "PH-07", nil},
+	{"PH-NSA", "Northern Samar", "PH-08", nil},
+	{"PH-NUE", "Nueva Ecija", "PH-03", nil},
+	{"PH-NUV", "Nueva Vizcaya", "PH-02", nil},
+	{"PH-PAM", "Pampanga", "PH-03", nil},
+	{"PH-PAN", "Pangasinan", "PH-01", nil},
+	{"PH-PLW", "Palawan", "PH-41", nil},
+	{"PH-QUE", "Quezon", "PH-40", nil},
+	{"PH-QUI", "Quirino", "PH-02", nil},
+	{"PH-RIZ", "Rizal", "PH-40", nil},
+	{"PH-ROM", "Romblon", "PH-41", nil},
+	{"PH-SAR", "Sarangani", "PH-11", nil},
+	{"PH-SCO", "South Cotabato", "PH-11", nil},
+	{"PH-SIG", "Siquijor", "PH-07", nil},
+	{"PH-SLE", "Southern Leyte", "PH-08", nil},
+	{"PH-SLU", "Sulu", "PH-14", nil},
+	{"PH-SOR", "Sorsogon", "PH-05", nil},
+	{"PH-SUK", "Sultan Kudarat", "PH-12", nil},
+	{"PH-SUN", "Surigao del Norte", "PH-13", nil},
+	{"PH-SUR", "Surigao del Sur", "PH-13", nil},
+	{"PH-TAR", "Tarlac", "PH-03", nil},
+	{"PH-TAW", "Tawi-Tawi", "PH-14", nil},
+	{"PH-WSA", "Samar", "PH-08", nil},
+	{"PH-ZAN", "Zamboanga del Norte", "PH-09", nil},
+	{"PH-ZAS", "Zamboanga del Sur", "PH-09", nil},
+	{"PH-ZMB", "Zambales", "PH-03", nil},
+	{"PH-ZSI", "Zamboanga Sibugay", "PH-09", nil},
+
+	// Pakistan
+	{"PK-BA", "Balochistan", "", nil},
+	{"PK-GB", "Gilgit-Baltistan", "", nil},
+	{"PK-IS", "Islamabad", "", nil},
+	{"PK-JK", "Azad Jammu and Kashmir", "", nil},
+	{"PK-KP", "Khyber Pakhtunkhwa", "", nil},
+	{"PK-PB", "Punjab", "", nil},
+	{"PK-SD", "Sindh", "", nil},
+
+	// Poland
+	{"PL-02", "Dolnośląskie", "", nil},
+	{"PL-04", "Kujawsko-pomorskie", "", nil},
+	{"PL-06", "Lubelskie", "", nil},
+	{"PL-08", "Lubuskie", "", nil},
+	{"PL-10", "Łódzkie", "", nil},
+	{"PL-12", "Małopolskie", "", nil},
+	{"PL-14", "Mazowieckie", "", nil},
+	{"PL-16", "Opolskie", "", nil},
+	{"PL-18", "Podkarpackie", "", nil},
+	{"PL-20", "Podlaskie", "", nil},
+	{"PL-22", "Pomorskie", "", nil},
+	{"PL-24", "Śląskie", "", nil},
+	{"PL-26", "Świętokrzyskie", "", nil},
+	{"PL-28", "Warmińsko-mazurskie", "", nil},
+	{"PL-30", "Wielkopolskie", "", nil},
+	{"PL-32", "Zachodniopomorskie", "", nil},
+
+	// Palestine
+	{"PS-BTH", "Bethlehem", "", nil},
+	{"PS-DEB", "Deir El Balah", "", nil},
+	{"PS-GZA", "Gaza", "", nil},
+	{"PS-HBN", "Hebron", "", nil},
+	{"PS-JEM", "Jerusalem", "", nil},
+	{"PS-JEN", "Jenin", "", nil},
+	{"PS-JRH", "Jericho and Al Aghwar", "", nil},
+	{"PS-KYS", "Khan Yunis", "", nil},
+	{"PS-NBS", "Nablus", "", nil},
+	{"PS-NGZ", "North Gaza", "", nil},
+	{"PS-QQA", "Qalqilya", "", nil},
+	{"PS-RBH", "Ramallah", "", nil},
+	{"PS-RFH", "Rafah", "", nil},
+	{"PS-SLT", "Salfit", "", nil},
+	{"PS-TBS", "Tubas", "", nil},
+	{"PS-TKM", "Tulkarm", "", nil},
+
+	// Portugal
+	{"PT-01", "Aveiro", "", nil},
+	{"PT-02", "Beja", "", nil},
+	{"PT-03", "Braga", "", nil},
+	{"PT-04", "Bragança", "", nil},
+	{"PT-05", "Castelo Branco", "", nil},
+	{"PT-06", "Coimbra", "", nil},
+	{"PT-07", "Évora", "", nil},
+	{"PT-08", "Faro", "", nil},
+	{"PT-09", "Guarda", "", nil},
+	{"PT-10", "Leiria", "", nil},
+	{"PT-11", "Lisboa", "", nil},
+	{"PT-12", "Portalegre", "", nil},
+	{"PT-13", "Porto", "", nil},
+	{"PT-14", "Santarém", "", nil},
+	{"PT-15", "Setúbal", "", nil},
+	{"PT-16", "Viana do Castelo", "", nil},
+	{"PT-17", "Vila Real", "", nil},
+	{"PT-18", "Viseu", "", nil},
+	{"PT-20", "Região Autónoma dos Açores", "", nil},
+	{"PT-30", "Região Autónoma da Madeira", "", nil},
+
+	// Palau
+	{"PW-002", "Aimeliik", "", nil},
+	{"PW-004", "Airai", "", nil},
+	{"PW-010", "Angaur", "", nil},
+	{"PW-050", "Hatohobei", "", nil},
+	{"PW-100", "Kayangel", "", nil},
+	{"PW-150", "Koror", "", nil},
+	{"PW-212", "Melekeok", "", nil},
+	{"PW-214", "Ngaraard", "", nil},
+	{"PW-218", "Ngarchelong", "", nil},
+	{"PW-222", "Ngardmau", "", nil},
+	{"PW-224", "Ngatpang", "", nil},
+	{"PW-226", "Ngchesar", "", nil},
+	{"PW-227", "Ngeremlengui", "", nil},
+	{"PW-228", "Ngiwal", "", nil},
+	{"PW-350", "Peleliu", "", nil},
+	{"PW-370", "Sonsorol", "", nil},
+
+	// Paraguay
+	{"PY-1", "Concepción", "", nil},
+	{"PY-10", "Alto Paraná", "", nil},
+	{"PY-11", "Central", "", nil},
+	{"PY-12", "Ñeembucú", "", nil},
+	{"PY-13", "Amambay", "", nil},
+	{"PY-14", "Canindeyú", "", nil},
+	{"PY-15", "Presidente Hayes", "", nil},
+	{"PY-16", "Alto Paraguay", "", nil},
+	{"PY-19", "Boquerón", "", nil},
+	{"PY-2", "San Pedro", "", nil},
+	{"PY-3", "Cordillera", "", nil},
+	{"PY-4", "Guairá", "", nil},
+	{"PY-5", "Caaguazú", "", nil},
+	{"PY-6", "Caazapá", "", nil},
+	{"PY-7", "Itapúa", "", nil},
+	{"PY-8", "Misiones", "", nil},
+	{"PY-9", "Paraguarí", "", nil},
+	{"PY-ASU", "Asunción", "", nil},
+
+	// Qatar
+	{"QA-DA", "Ad Dawḩah", "", nil},
+	{"QA-KH", "Al Khawr wa adh Dhakhīrah", "", nil},
+	{"QA-MS", "Ash Shamāl", "", nil},
+	{"QA-RA", "Ar Rayyān", "", nil},
+	{"QA-SH", "Ash Shīḩānīyah", "", nil},
+	{"QA-US", "Umm Şalāl", "", nil},
+	{"QA-WA", "Al Wakrah", "", nil},
+	{"QA-ZA", "Az̧ Z̧a‘āyin", "", nil},
+
+	// Romania
+	{"RO-AB", "Alba", "", nil},
+	{"RO-AG", "Argeș", "", nil},
+	{"RO-AR", "Arad", "", nil},
+	{"RO-B", "București", "", nil},
+	{"RO-BC", "Bacău", "", nil},
+	{"RO-BH", "Bihor", "", nil},
+	{"RO-BN", "Bistrița-Năsăud", "", nil},
+	{"RO-BR", "Brăila", "", nil},
+	{"RO-BT", "Botoșani", "", nil},
+	{"RO-BV", "Brașov", "", nil},
+	{"RO-BZ", "Buzău", "", nil},
+	{"RO-CJ", "Cluj", "", nil},
+	{"RO-CL", "Călărași", "", nil},
+	{"RO-CS", "Caraș-Severin", "", nil},
+	{"RO-CT", "Constanța", "", nil},
+	{"RO-CV", "Covasna", "", nil},
+	{"RO-DB", "Dâmbovița", "", nil},
+	{"RO-DJ", "Dolj", "", nil},
+	{"RO-GJ", "Gorj", "", nil},
+	{"RO-GL", "Galați", "", nil},
+	{"RO-GR", "Giurgiu", "", nil},
+	{"RO-HD", "Hunedoara", "", nil},
+	{"RO-HR", "Harghita", "", nil},
+	{"RO-IF", "Ilfov", "", nil},
+	{"RO-IL", "Ialomița", "", nil},
+	{"RO-IS", "Iași", "", nil},
+	{"RO-MH", "Mehedinți", "", nil},
+	{"RO-MM", "Maramureș", "", nil},
+	{"RO-MS", "Mureș", "", nil},
+	{"RO-NT", "Neamț", "", nil},
+	{"RO-OT", "Olt", "", nil},
+	{"RO-PH", "Prahova", "", nil},
+	{"RO-SB", "Sibiu", "", nil},
+	{"RO-SJ", "Sălaj", "", nil},
+	{"RO-SM", "Satu Mare", "", nil},
+	{"RO-SV", "Suceava", "", nil},
+	{"RO-TL", "Tulcea", "", nil},
+	{"RO-TM", "Timiș", "", nil},
+	{"RO-TR", "Teleorman", "", nil},
+	{"RO-VL", "Vâlcea", "", nil},
+	{"RO-VN", "Vrancea", "", nil},
+	{"RO-VS", "Vaslui", "", nil},
+
+	// Serbia
+	{"RS-00", "Beograd", "", nil},
+	{"RS-01", "Severnobački okrug", "RS-VO", nil},
+	{"RS-02", "Srednjebanatski okrug", "RS-VO", nil},
+	{"RS-03", "Severnobanatski okrug", "RS-VO", nil},
+	{"RS-04", "Južnobanatski okrug", "RS-VO", nil},
+	{"RS-05", "Zapadnobački okrug", "RS-VO", nil},
+	{"RS-06", "Južnobački okrug", "RS-VO", nil},
+	{"RS-07", "Sremski okrug", "RS-VO", nil},
+	{"RS-08", "Mačvanski okrug", "", nil},
+	{"RS-09", "Kolubarski okrug", "", nil},
+	{"RS-10", "Podunavski okrug", "", nil},
+	{"RS-11", "Braničevski okrug", "", nil},
+	{"RS-12", "Šumadijski okrug", "", nil},
+	{"RS-13", "Pomoravski okrug", "", nil},
+	{"RS-14", "Borski okrug", "", nil},
+	{"RS-15", "Zaječarski okrug", "", nil},
+	{"RS-16", "Zlatiborski okrug", "", nil},
+	{"RS-17", "Moravički okrug", "", nil},
+	{"RS-18", "Raški okrug", "", nil},
+	{"RS-19", "Rasinski okrug", "", nil},
+	{"RS-20", "Nišavski okrug", "", nil},
+	{"RS-21", "Toplički okrug", "", nil},
+	{"RS-22", "Pirotski okrug", "", nil},
+	{"RS-23", "Jablanički okrug", "", nil},
+	{"RS-24", "Pčinjski okrug", "", nil},
+	{"RS-25", "Kosovski okrug", "RS-KM", nil},
+	{"RS-26", "Pećki okrug", "RS-KM", nil},
+	{"RS-27", "Prizrenski okrug", "RS-KM", nil},
+	{"RS-28", "Kosovsko-Mitrovački okrug", "RS-KM", nil},
+	{"RS-29", "Kosovsko-Pomoravski okrug", "RS-KM", nil},
+	{"RS-KM", "Kosovo-Metohija", "", nil},
+	{"RS-VO", "Vojvodina", "", nil},
+
+	// Russia
+	{"RU-AD", "Adygeja, Respublika", "", nil},
+	{"RU-AL", "Altaj, Respublika", "", nil},
+	{"RU-ALT", "Altajskij kraj", "", nil},
+	{"RU-AMU", "Amurskaja oblast'", "", nil},
+	{"RU-ARK", "Arhangel'skaja oblast'", "", nil},
+	{"RU-AST", "Astrahanskaja oblast'", "", nil},
+	{"RU-BA", "Bashkortostan, Respublika", "", nil},
+	{"RU-BEL", "Belgorodskaja oblast'", "", nil},
+	{"RU-BRY", "Brjanskaja oblast'", "", nil},
+	{"RU-BU", "Burjatija, Respublika", "", nil},
+	{"RU-CE", "Chechenskaya Respublika", "", nil},
+	{"RU-CHE", "Chelyabinskaya oblast'", "", nil},
+	{"RU-CHU", "Chukotskiy avtonomnyy okrug", "", nil},
+	{"RU-CU", "Chuvashskaya Respublika", "", nil},
+	{"RU-DA", "Dagestan, Respublika", "", nil},
+	{"RU-IN", "Ingushetiya, Respublika", "", nil},
+	{"RU-IRK", "Irkutskaja oblast'", "", nil},
+	{"RU-IVA", "Ivanovskaja oblast'", "", nil},
+	{"RU-KAM", "Kamchatskiy kray", "", nil},
+	{"RU-KB", "Kabardino-Balkarskaja Respublika", "", nil},
+	{"RU-KC", "Karachayevo-Cherkesskaya Respublika", "", nil},
+	{"RU-KDA", "Krasnodarskij kraj", "", nil},
+	{"RU-KEM", "Kemerovskaja oblast'", "", nil},
+	{"RU-KGD", "Kaliningradskaja oblast'", "", nil},
+	{"RU-KGN", "Kurganskaja oblast'", "", nil},
+	{"RU-KHA", "Habarovskij kraj", "", nil},
+	{"RU-KHM", "Hanty-Mansijskij avtonomnyj okrug", "", nil},
+	{"RU-KIR", "Kirovskaja oblast'", "", nil},
+	{"RU-KK", "Hakasija, Respublika", "", nil},
+	{"RU-KL", "Kalmykija, Respublika", "", nil},
+	{"RU-KLU", "Kaluzhskaya oblast'", "", nil},
+	{"RU-KO", "Komi, Respublika", "", nil},
+	{"RU-KOS", "Kostromskaja oblast'", "", nil},
+	{"RU-KR", "Karelija, Respublika", "", nil},
+	{"RU-KRS", "Kurskaja oblast'", "", nil},
+	{"RU-KYA", "Krasnojarskij kraj", "", nil},
+	{"RU-LEN", "Leningradskaja oblast'", "", nil},
+	{"RU-LIP", "Lipeckaja oblast'", "", nil},
+	{"RU-MAG", "Magadanskaja oblast'", "", nil},
+	{"RU-ME", "Marij Èl, Respublika", "", nil},
+	{"RU-MO", "Mordovija, Respublika", "", nil},
+	{"RU-MOS", "Moskovskaja oblast'", "", nil},
+	{"RU-MOW", "Moskva", "", nil},
+	{"RU-MUR", "Murmanskaja oblast'", "", nil},
+	{"RU-NEN", "Neneckij avtonomnyj okrug", "", nil},
+	{"RU-NGR", "Novgorodskaja oblast'", "", nil},
+	{"RU-NIZ", "Nizhegorodskaya oblast'", "", nil},
+	{"RU-NVS", "Novosibirskaja oblast'", "", nil},
+	{"RU-OMS", "Omskaja oblast'", "", nil},
+	{"RU-ORE", "Orenburgskaja oblast'", "", nil},
+	{"RU-ORL", "Orlovskaja oblast'", "", nil},
+	{"RU-PER", "Permskij kraj", "", nil},
+	{"RU-PNZ", "Penzenskaja oblast'", "", nil},
+	{"RU-PRI", "Primorskij kraj", "", nil},
+	{"RU-PSK", "Pskovskaja oblast'", "", nil},
+	{"RU-ROS", "Rostovskaja oblast'", "", nil},
+	{"RU-RYA", "Rjazanskaja oblast'", "", nil},
+	{"RU-SA", "Saha, Respublika", "", nil},
+	{"RU-SAK", "Sahalinskaja oblast'", "", nil},
+	{"RU-SAM", "Samarskaja oblast'", "", nil},
+	{"RU-SAR", "Saratovskaja oblast'", "", nil},
+	{"RU-SE", "Severnaja Osetija, Respublika", "", nil},
+	{"RU-SMO", "Smolenskaja oblast'", "", nil},
+	{"RU-SPE", "Sankt-Peterburg", "", nil},
+	{"RU-STA", "Stavropol'skij kraj", "", nil},
+	{"RU-SVE", "Sverdlovskaja oblast'", "", nil},
+	{"RU-TA", "Tatarstan, Respublika", "", nil},
+	{"RU-TAM", "Tambovskaja oblast'", "", nil},
+	{"RU-TOM", "Tomskaja oblast'", "", nil},
+	{"RU-TUL", "Tul'skaja oblast'", "", nil},
+	{"RU-TVE", "Tverskaja oblast'", "", nil},
+	{"RU-TY", "Tyva, Respublika", "", nil},
+	{"RU-TYU", "Tjumenskaja oblast'", "", nil},
+	{"RU-UD", "Udmurtskaja Respublika", "", nil},
+	{"RU-ULY", "Ul'janovskaja oblast'", "", nil},
+	{"RU-VGG", "Volgogradskaja oblast'", "", nil},
+	{"RU-VLA", "Vladimirskaja oblast'", "", nil},
+	{"RU-VLG", "Vologodskaja oblast'", "", nil},
+	{"RU-VOR", "Voronezhskaya oblast'", "", nil},
+	{"RU-YAN", "Jamalo-Neneckij avtonomnyj okrug", "", nil},
+	{"RU-YAR", "Jaroslavskaja oblast'", "", nil},
+	{"RU-YEV", "Evrejskaja avtonomnaja oblast'", "", nil},
+	{"RU-ZAB", "Zabajkal'skij kraj", "", nil},
+
+	// Rwanda
+	{"RW-01", "City of Kigali", "", nil},
+	{"RW-02", "Eastern", "", nil},
+	{"RW-03", "Northern", "", nil},
+	{"RW-04", "Western", "", nil},
+	{"RW-05", "Southern", "", nil},
+
+	// Saudi Arabia
+	{"SA-01", "Ar Riyāḑ", "", nil},
+	{"SA-02", "Makkah al Mukarramah", "", nil},
+	{"SA-03", "Al Madīnah al Munawwarah", "", nil},
+	{"SA-04", "Ash Sharqīyah", "", nil},
+	{"SA-05", "Al Qaşīm", "", nil},
+	{"SA-06", "Ḩā'il", "", nil},
+	{"SA-07", "Tabūk", "", nil},
+	{"SA-08", "Al Ḩudūd ash Shamālīyah", "", nil},
+	{"SA-09", "Jāzān", "", nil},
+	{"SA-10", "Najrān", "", nil},
+	{"SA-11", "Al Bāḩah", "", nil},
+	{"SA-12", "Al Jawf", "", nil},
+	{"SA-14", "'Asīr", "", nil},
+
+	// Solomon Islands
+	{"SB-CE", "Central", "", nil},
+	{"SB-CH", "Choiseul", "", nil},
+	{"SB-CT", "Capital Territory (Honiara)", "", []string{"Capital Territory"}},
+	{"SB-GU", "Guadalcanal", "", nil},
+	{"SB-IS", "Isabel", "", nil},
+	{"SB-MK", "Makira-Ulawa", "", nil},
+	{"SB-ML", "Malaita", "", nil},
+	{"SB-RB", "Rennell and Bellona", "", nil},
+	{"SB-TE", "Temotu", "", nil},
+	{"SB-WE", "Western", "", nil},
+
+	// Seychelles
+	{"SC-01", "Anse aux Pins", "", nil},
+	{"SC-02", "Anse Boileau", "", nil},
+	{"SC-03", "Anse Etoile", "", nil},
+	{"SC-04", "Au Cap", "", nil},
+	{"SC-05", "Anse Royale", "", nil},
+	{"SC-06", "Baie Lazare", "", nil},
+	{"SC-07", "Baie Sainte Anne", "", nil},
+	{"SC-08", "Beau Vallon", "", nil},
+	{"SC-09", "Bel Air", "", nil},
+	{"SC-10", "Bel Ombre", "", nil},
+	{"SC-11", "Cascade", "", nil},
+	{"SC-12", "Glacis", "", nil},
+	{"SC-13", "Grand Anse Mahe", "", nil},
+	{"SC-14", "Grand Anse Praslin", "", nil},
+	{"SC-15", "La Digue", "", nil},
+	{"SC-16", "English River", "", nil},
+	{"SC-17", "Mont Buxton", "", nil},
+	{"SC-18", "Mont Fleuri", "", nil},
+	{"SC-19", "Plaisance", "", nil},
+	{"SC-20", "Pointe Larue", "", nil},
+	{"SC-21", "Port Glaud", "", nil},
+	{"SC-22", "Saint Louis", "", nil},
+	{"SC-23", "Takamaka", "", nil},
+	{"SC-24", "Les Mamelles", "", nil},
+	{"SC-25", "Roche Caiman", "", nil},
+	{"SC-26", "Ile Perseverance I", "", nil},
+	{"SC-27", "Ile Perseverance II", "", nil},
+
+	// Sudan
+	{"SD-DC", "Central Darfur", "", nil},
+	{"SD-DE", "East Darfur", "", nil},
+	{"SD-DN", "North Darfur", "", nil},
+	{"SD-DS", "South Darfur", "", nil},
+	{"SD-DW", "West Darfur", "", nil},
+	{"SD-GD", "Gedaref", "", nil},
+	{"SD-GK", "West Kordofan", "", nil},
+	{"SD-GZ", "Gezira", "", nil},
+	{"SD-KA", "Kassala", "", nil},
+	{"SD-KH", "Khartoum", "", nil},
+	{"SD-KN", "North Kordofan", "", nil},
+	{"SD-KS", "South Kordofan", "", nil},
+	{"SD-NB", "Blue Nile", "", nil},
+	{"SD-NO", "Northern", "", nil},
+	{"SD-NR", "River Nile", "", nil},
+	{"SD-NW", "White Nile", "", nil},
+	{"SD-RS", "Red Sea", "", nil},
+	{"SD-SI", "Sennar", "", nil},
+
+	// Sweden
+	{"SE-AB", "Stockholms län", "", []string{"SE-01"}},
+	{"SE-AC", "Västerbottens län", "", []string{"SE-24"}},
+	{"SE-BD", "Norrbottens län", "", []string{"SE-25"}},
+	{"SE-C", "Uppsala län", "", []string{"SE-03"}},
+	{"SE-D", "Södermanlands län", "", []string{"SE-04"}},
+	{"SE-E", "Östergötlands län", "", []string{"SE-05"}},
+	{"SE-F", "Jönköpings län", "", []string{"SE-06"}},
+	{"SE-G", "Kronobergs län", "", []string{"SE-07"}},
+	{"SE-H", "Kalmar län", "", []string{"SE-08"}},
+	{"SE-I", "Gotlands län", "", []string{"SE-09"}},
+	{"SE-K", "Blekinge län", "", []string{"SE-10"}},
+	{"SE-M", "Skåne län", "", []string{"SE-12"}},
+	{"SE-N", "Hallands län", "", []string{"SE-13"}},
+	{"SE-O", "Västra Götalands län", "", []string{"SE-14"}},
+	{"SE-S", "Värmlands län", "", []string{"SE-17"}},
+	{"SE-T", "Örebro län", "", []string{"SE-18"}},
+	{"SE-U", "Västmanlands län", "", []string{"SE-19"}},
+	{"SE-W", "Dalarnas län", "", []string{"SE-20"}},
+	{"SE-X", "Gävleborgs län", "", []string{"SE-21"}},
+	{"SE-Y", "Västernorrlands län", "", []string{"SE-22"}},
+	{"SE-Z", "Jämtlands län", "", []string{"SE-23"}},
+
+	// Singapore
+	{"SG-01", "Central Singapore", "", nil},
+	{"SG-02", "North East", "", nil},
+	{"SG-03", "North West", "", nil},
+	{"SG-04", "South East", "", nil},
+	{"SG-05", "South West", "", nil},
+
+	// Saint Helena, Ascension and Tristan da Cunha
+	{"SH-AC", "Ascension", "", nil},
+	{"SH-HL", "Saint Helena", "", nil},
+	{"SH-TA", "Tristan da Cunha", "", nil},
+
+	// Slovenia
+	{"SI-001", "Ajdovščina", "", nil},
+	{"SI-002", "Beltinci", "", nil},
+	{"SI-003", "Bled", "", nil},
+	{"SI-004", "Bohinj", "", nil},
+	{"SI-005", "Borovnica", "", nil},
+	{"SI-006", "Bovec", "", nil},
+	{"SI-007", "Brda", "", nil},
+	{"SI-008", "Brezovica", "", nil},
+	{"SI-009", "Brežice", "", nil},
+	{"SI-010", "Tišina", "", nil},
+	{"SI-011", "Celje", "", nil},
+	{"SI-012", "Cerklje na Gorenjskem", "", nil},
+	{"SI-013", "Cerknica", "", nil},
+	{"SI-014", "Cerkno", "", nil},
+	{"SI-015", "Črenšovci", "", nil},
+	{"SI-016", "Črna na Koroškem", "", nil},
+	{"SI-017", "Črnomelj", "", nil},
+	{"SI-018", "Destrnik", "", nil},
+	{"SI-019", "Divača", "", nil},
+	{"SI-020", "Dobrepolje", "", nil},
+	{"SI-021", "Dobrova-Polhov Gradec", "", nil},
+	{"SI-022", "Dol pri Ljubljani", "", nil},
+	{"SI-023", "Domžale", "", nil},
+	{"SI-024", "Dornava", "", nil},
+	{"SI-025", "Dravograd", "", nil},
+	{"SI-026", "Duplek", "", nil},
+	{"SI-027", "Gorenja vas-Poljane", "", nil},
+	{"SI-028", "Gorišnica", "", nil},
+	{"SI-029", "Gornja Radgona", "", nil},
+	{"SI-030", "Gornji Grad", "", nil},
+	{"SI-031", "Gornji Petrovci", "", nil},
+	{"SI-032", "Grosuplje", "", nil},
+	{"SI-033", "Šalovci", "", nil},
+	{"SI-034", "Hrastnik", "", nil},
+	{"SI-035", "Hrpelje-Kozina", "", nil},
+	{"SI-036", "Idrija", "", nil},
+	{"SI-037", "Ig", "", nil},
+	{"SI-038", "Ilirska Bistrica", "", nil},
+	{"SI-039", "Ivančna Gorica", "", nil},
+	{"SI-040", "Izola", "", nil},
+	{"SI-041", "Jesenice", "", nil},
+	{"SI-042", "Juršinci", "", nil},
+	{"SI-043", "Kamnik", "", nil},
+	{"SI-044", "Kanal", "", nil},
+	{"SI-045", "Kidričevo", "", nil},
+	{"SI-046", "Kobarid", "", nil},
+	{"SI-047", "Kobilje", "", nil},
+	{"SI-048", "Kočevje", "", nil},
+	{"SI-049", "Komen", "", nil},
+	{"SI-050", "Koper", "", nil},
+	{"SI-051", "Kozje", "", nil},
+	{"SI-052", "Kranj", "", nil},
+	{"SI-053", "Kranjska Gora", "", nil},
+	{"SI-054", "Krško", "", nil},
+	{"SI-055", "Kungota", "", nil},
+	{"SI-056", "Kuzma", "", nil},
+	{"SI-057", "Laško", "", nil},
+	{"SI-058", "Lenart", "", nil},
+	{"SI-059", "Lendava", "", nil},
+	{"SI-060", "Litija", "", nil},
+	{"SI-061", "Ljubljana", "", nil},
+	{"SI-062", "Ljubno", "", nil},
+	{"SI-063", "Ljutomer", "", nil},
+	{"SI-064", "Logatec", "", nil},
+	{"SI-065", "Loška dolina", "", nil},
+	{"SI-066", "Loški Potok", "", nil},
+	{"SI-067", "Luče", "", nil},
+	{"SI-068", "Lukovica", "", nil},
+	{"SI-069", "Majšperk", "", nil},
+	{"SI-070", "Maribor", "", nil},
+	{"SI-071", "Medvode", "", nil},
+	{"SI-072", "Mengeš", "", nil},
+	{"SI-073", "Metlika", "", nil},
+	{"SI-074", "Mežica", "", nil},
+	{"SI-075", "Miren-Kostanjevica", "", nil},
+	{"SI-076", "Mislinja", "", nil},
+	{"SI-077", "Moravče", "", nil},
+	{"SI-078", "Moravske Toplice", "", nil},
+	{"SI-079", "Mozirje", "", nil},
+	{"SI-080", "Murska Sobota", "", nil},
+	{"SI-081", "Muta", "", nil},
+	{"SI-082", "Naklo", "", nil},
+	{"SI-083", "Nazarje", "", nil},
+	{"SI-084", "Nova Gorica", "", nil},
+	{"SI-085", "Novo Mesto", "", nil},
+	{"SI-086", "Odranci", "", nil},
+	{"SI-087", "Ormož", "", nil},
+	{"SI-088", "Osilnica", "", nil},
+	{"SI-089", "Pesnica", "", nil},
+	{"SI-090", "Piran", "", nil},
+	{"SI-091", "Pivka", "", nil},
+	{"SI-092", "Podčetrtek", "", nil},
+	{"SI-093", "Podvelka", "", nil},
+	{"SI-094", "Postojna", "", nil},
+	{"SI-095", "Preddvor", "", nil},
+	{"SI-096", "Ptuj", "", nil},
+	{"SI-097", "Puconci", "", nil},
+	{"SI-098", "Rače-Fram", "", nil},
+	{"SI-099", "Radeče", "", nil},
+	{"SI-100", "Radenci", "", nil},
+	{"SI-101", "Radlje ob Dravi", "", nil},
+	{"SI-102", "Radovljica", "", nil},
+	{"SI-103", "Ravne na Koroškem", "", nil},
+	{"SI-104", "Ribnica", "", nil},
+	{"SI-105", "Rogašovci", "", nil},
+	{"SI-106", "Rogaška Slatina", "", nil},
+	{"SI-107", "Rogatec", "", nil},
+	{"SI-108", "Ruše", "", nil},
+	{"SI-109", "Semič", "", nil},
+	{"SI-110", "Sevnica", "", nil},
+	{"SI-111", "Sežana", "", nil},
+	{"SI-112", "Slovenj Gradec", "", nil},
+	{"SI-113", "Slovenska Bistrica", "", nil},
+	{"SI-114", "Slovenske Konjice", "", nil},
+	{"SI-115", "Starše", "", nil},
+	{"SI-116", "Sveti Jurij ob Ščavnici", "", nil},
+	{"SI-117", "Šenčur", "", nil},
+	{"SI-118", "Šentilj", "", nil},
+	{"SI-119", "Šentjernej", "", nil},
+	{"SI-120", "Šentjur", "", nil},
+	{"SI-121", "Škocjan", "", nil},
+	{"SI-122", "Škofja Loka", "", nil},
+	{"SI-123", "Škofljica", "", nil},
+	{"SI-124", "Šmarje pri Jelšah", "", nil},
+	{"SI-125", "Šmartno ob Paki", "", nil},
+	{"SI-126", "Šoštanj", "", nil},
+	{"SI-127", "Štore", "", nil},
+	{"SI-128", "Tolmin", "", nil},
+	{"SI-129", "Trbovlje", "", nil},
+	{"SI-130", "Trebnje", "", nil},
+	{"SI-131", "Tržič", "", nil},
+	{"SI-132", "Turnišče", "", nil},
+	{"SI-133", "Velenje", "", nil},
+	{"SI-134", "Velike Lašče", "", nil},
+	{"SI-135", "Videm", "", nil},
+	{"SI-136", "Vipava", "", nil},
+	{"SI-137", "Vitanje", "", nil},
+	{"SI-138", "Vodice", "", nil},
+	{"SI-139", "Vojnik", "", nil},
+	{"SI-140", "Vrhnika", "", nil},
+	{"SI-141", "Vuzenica", "", nil},
+	{"SI-142", "Zagorje ob Savi", "", nil},
+	{"SI-143", "Zavrč", "", nil},
+	{"SI-144", "Zreče", "", nil},
+	{"SI-146", "Železniki", "", nil},
+	{"SI-147", "Žiri", "", nil},
+	{"SI-148", "Benedikt", "", nil},
+	{"SI-149", "Bistrica ob Sotli", "", nil},
+	{"SI-150", "Bloke", "", nil},
+	{"SI-151", "Braslovče", "", nil},
+	{"SI-152", "Cankova", "", nil},
+	{"SI-153", "Cerkvenjak", "", nil},
+	{"SI-154", "Dobje", "", nil},
+	{"SI-155", "Dobrna", "", nil},
+	{"SI-156", "Dobrovnik", "", nil},
+	{"SI-157", "Dolenjske Toplice", "", nil},
+	{"SI-158", "Grad", "", nil},
+	{"SI-159", "Hajdina", "", nil},
+	{"SI-160", "Hoče-Slivnica", "", nil},
+	{"SI-161", "Hodoš", "", nil},
+	{"SI-162", "Horjul", "", nil},
+	{"SI-163", "Jezersko", "", nil},
+	{"SI-164", "Komenda", "", nil},
+	{"SI-165", "Kostel", "", nil},
+	{"SI-166", "Križevci", "", nil},
+	{"SI-167", "Lovrenc na Pohorju", "", nil},
+	{"SI-168", "Markovci", "", nil},
+	{"SI-169", "Miklavž na Dravskem polju", "", nil},
+	{"SI-170", "Mirna Peč", "", nil},
+	{"SI-171", "Oplotnica", "", nil},
+	{"SI-172", "Podlehnik", "", nil},
+	{"SI-173", "Polzela", "", nil},
+	{"SI-174", "Prebold", "", nil},
+	{"SI-175", "Prevalje", "", nil},
+	{"SI-176", "Razkrižje", "", nil},
+	{"SI-177", "Ribnica na Pohorju", "", nil},
+	{"SI-178", "Selnica ob Dravi", "", nil},
+	{"SI-179", "Sodražica", "", nil},
+	{"SI-180", "Solčava", "", nil},
+	{"SI-181", "Sveta Ana", "", nil},
+	{"SI-182", "Sveti Andraž v Slovenskih goricah", "", nil},
+	{"SI-183", "Šempeter-Vrtojba", "", nil},
+	{"SI-184", "Tabor", "", nil},
+	{"SI-185", "Trnovska Vas", "", nil},
+	{"SI-186", "Trzin", "", nil},
+	{"SI-187", "Velika Polana", "", nil},
+	{"SI-188", "Veržej", "", nil},
+	{"SI-189", "Vransko", "", nil},
+	{"SI-190", "Žalec", "", nil},
+	{"SI-191", "Žetale", "", nil},
+	{"SI-192", "Žirovnica", "", nil},
+	{"SI-193", "Žužemberk", "", nil},
+	{"SI-194", "Šmartno pri Litiji", "", nil},
+	{"SI-195", "Apače", "", nil},
+	{"SI-196", "Cirkulane", "", nil},
+	{"SI-197", "Kosanjevica na Krki", "", nil},
+	{"SI-198", "Makole", "", nil},
+	{"SI-199", "Mokronog-Trebelno", "", nil},
+	{"SI-200", "Poljčane", "", nil},
+	{"SI-201", "Renče-Vogrsko", "", nil},
+	{"SI-202", "Središče ob Dravi", "", nil},
+	{"SI-203", "Straža", "", nil},
+	{"SI-204", "Sveta Trojica v Slovenskih goricah", "", nil},
+	{"SI-205", "Sveti Tomaž", "", nil},
+	{"SI-206", "Šmarješke Toplice", "", nil},
+	{"SI-207", "Gorje", "", nil},
+	{"SI-208", "Log-Dragomer", "", nil},
+	{"SI-209", "Rečica ob Savinji", "", nil},
+	{"SI-210", "Sveti Jurij v Slovenskih goricah", "", nil},
+	{"SI-211", "Šentrupert", "", nil},
+	{"SI-212", "Mirna", "", nil},
+	{"SI-213", "Ankaran", "", nil},
+
+	// Slovakia
+	{"SK-BC", "Banskobystrický kraj", "", nil},
+	{"SK-BL", "Bratislavský kraj", "", nil},
+	{"SK-KI", "Košický kraj", "", nil},
+	{"SK-NI", "Nitriansky kraj", "", nil},
+	{"SK-PV", "Prešovský kraj", "", nil},
+	{"SK-TA", "Trnavský kraj", "", nil},
+	{"SK-TC", "Trenčiansky kraj", "", nil},
+	{"SK-ZI", "Žilinský kraj", "", nil},
+
+	// Sierra Leone
+	{"SL-E", "Eastern", "", nil},
+	{"SL-N", "Northern", "", nil},
+	{"SL-NW", "North Western", "", nil},
+	{"SL-S", "Southern", "", nil},
+	{"SL-W", "Western Area (Freetown)", "", []string{"Western Area"}},
+
+	// San Marino
+	{"SM-01", "Acquaviva", "", nil},
+	{"SM-02", "Chiesanuova", "", nil},
+	{"SM-03", "Domagnano", "", nil},
+	{"SM-04", "Faetano", "", nil},
+	{"SM-05", "Fiorentino", "", nil},
+	{"SM-06", "Borgo Maggiore", "", nil},
+	{"SM-07", "Città di San Marino", "", nil},
+	{"SM-08", "Montegiardino", "", nil},
+	{"SM-09", "Serravalle", "", nil},
+
+	// Senegal
+	{"SN-DB", "Diourbel", "", nil},
+	{"SN-DK", "Dakar", "", nil},
+	{"SN-FK", "Fatick", "", nil},
+	{"SN-KA", "Kaffrine", "", nil},
+	{"SN-KD", "Kolda", "", nil},
+	{"SN-KE", "Kédougou", "", nil},
+	{"SN-KL", "Kaolack", "", nil},
+	{"SN-LG", "Louga", "", nil},
+	{"SN-MT", "Matam", "", nil},
+	{"SN-SE", "Sédhiou", "", nil},
+	{"SN-SL", "Saint-Louis", "", nil},
+	{"SN-TC", "Tambacounda", "", nil},
+	{"SN-TH", "Thiès", "", nil},
+	{"SN-ZG", "Ziguinchor", "", nil},
+
+	// Somalia
+	{"SO-AW", "Awdal", "", nil},
+	{"SO-BK", "Bakool", "", nil},
+	{"SO-BN", "Banaadir", "", nil},
+	{"SO-BR", "Bari", "", nil},
+	{"SO-BY", "Bay", "", nil},
+	{"SO-GA", "Galguduud", "", nil},
+	{"SO-GE", "Gedo", "", nil},
+	{"SO-HI", "Hiiraan", "", nil},
+	{"SO-JD", "Jubbada Dhexe", "", nil},
+	{"SO-JH", "Jubbada Hoose", "", nil},
+	{"SO-MU", "Mudug", "", nil},
+	{"SO-NU", "Nugaal", "", nil},
+	{"SO-SA", "Sanaag", "", nil},
+	{"SO-SD", "Shabeellaha Dhexe", "", nil},
+	{"SO-SH", "Shabeellaha Hoose", "", nil},
+	{"SO-SO", "Sool", "", nil},
+	{"SO-TO", "Togdheer", "", nil},
+	{"SO-WO", "Woqooyi Galbeed", "", nil},
+
+	// Suriname
+	{"SR-BR", "Brokopondo", "", nil},
+	{"SR-CM", "Commewijne", "", nil},
+	{"SR-CR", "Coronie", "", nil},
+	{"SR-MA", "Marowijne", "", nil},
+	{"SR-NI", "Nickerie", "", nil},
+	{"SR-PM", "Paramaribo", "", nil},
+	{"SR-PR", "Para", "", nil},
+	{"SR-SA", "Saramacca", "", nil},
+	{"SR-SI", "Sipaliwini", "", nil},
+	{"SR-WA", "Wanica", "", nil},
+
+	// South Sudan
+	{"SS-BN", "Northern Bahr el Ghazal", "", nil},
+	{"SS-BW", "Western Bahr el Ghazal", "", nil},
+	{"SS-EC", "Central Equatoria", "", nil},
+	{"SS-EE", "Eastern Equatoria", "", nil},
+	{"SS-EW", "Western Equatoria", "", nil},
+	{"SS-JG", "Jonglei", "", nil},
+	{"SS-LK", "Lakes", "", nil},
+	{"SS-NU", "Upper Nile", "", nil},
+	{"SS-UY", "Unity", "", nil},
+	{"SS-WR", "Warrap", "", nil},
+
+	// Sao Tome and Principe
+	{"ST-01", "Água Grande", "", nil},
+	{"ST-02", "Cantagalo", "", nil},
+	{"ST-03", "Caué", "", nil},
+	{"ST-04", "Lembá", "", nil},
+	{"ST-05", "Lobata", "", nil},
+	{"ST-06", "Mé-Zóchi", "", nil},
+	{"ST-P", "Príncipe", "", nil},
+
+	// El Salvador
+	{"SV-AH", "Ahuachapán", "", nil},
+	{"SV-CA", "Cabañas", "", nil},
+	{"SV-CH", "Chalatenango", "", nil},
+	{"SV-CU", "Cuscatlán", "", nil},
+	{"SV-LI", "La Libertad", "", nil},
+	{"SV-MO", "Morazán", "", nil},
+	{"SV-PA", "La Paz", "", nil},
+	{"SV-SA", "Santa Ana", "", nil},
+	{"SV-SM", "San Miguel", "", nil},
+	{"SV-SO", "Sonsonate", "", nil},
+	{"SV-SS", "San Salvador", "", nil},
+	{"SV-SV", "San Vicente", "", nil},
+	{"SV-UN", "La Unión", "", nil},
+	{"SV-US", "Usulután", "", nil},
+
+	// Syria
+	{"SY-DI", "Dimashq", "", nil},
+	{"SY-DR", "Dar'ā", "", nil},
+	{"SY-DY", "Dayr az Zawr", "", nil},
+	{"SY-HA", "Al Ḩasakah", "", nil},
+	{"SY-HI", "Ḩimş", "", nil},
+	{"SY-HL", "Ḩalab", "", nil},
+	{"SY-HM", "Ḩamāh", "", nil},
+	{"SY-ID", "Idlib", "", nil},
+	{"SY-LA", "Al Lādhiqīyah", "", nil},
+	{"SY-QU", "Al Qunayţirah", "", nil},
+	{"SY-RA", "Ar Raqqah", "", nil},
+	{"SY-RD", "Rīf Dimashq", "", nil},
+	{"SY-SU", "As Suwaydā'", "", nil},
+	{"SY-TA", "Ţarţūs", "", nil},
+
+	// Eswatini
+	{"SZ-HH", "Hhohho", "", nil},
+	{"SZ-LU", "Lubombo", "", nil},
+	{"SZ-MA", "Manzini", "", nil},
+	{"SZ-SH", "Shiselweni", "", nil},
+
+	// Chad
+	{"TD-BA", "Al Baţḩā’", "", nil},
+	{"TD-BG", "Bahr el Ghazal", "", nil},
+	{"TD-BO", "Borkou", "", nil},
+	{"TD-CB", "Chari-Baguirmi", "", nil},
+	{"TD-EE", "Ennedi-Est", "", nil},
+	{"TD-EO", "Ennedi-Ouest", "", nil},
+	{"TD-GR", "Guéra", "", nil},
+	{"TD-HL", "Hadjer Lamis", "", nil},
+	{"TD-KA", "Kanem", "", nil},
+	{"TD-LC", "Al Buḩayrah", "", nil},
+	{"TD-LO", "Logone-Occidental", "", nil},
+	{"TD-LR", "Logone-Oriental", "", nil},
+	{"TD-MA", "Mandoul", "", nil},
+	{"TD-MC", "Moyen-Chari", "", nil},
+	{"TD-ME", "Mayo-Kebbi-Est", "", nil},
+	{"TD-MO", "Mayo-Kebbi-Ouest", "", nil},
+	{"TD-ND", "Madīnat Injamīnā", "", nil},
+	{"TD-OD", "Ouaddaï", "", nil},
+	{"TD-SA", "Salamat", "", nil},
+	{"TD-SI", "Sila", "", nil},
+	{"TD-TA", "Tandjilé", "", nil},
+	{"TD-TI", "Tibastī", "", nil},
+	{"TD-WF", "Wadi Fira", "", nil},
+
+	// Togo
+	{"TG-C", "Centrale", "", nil},
+	{"TG-K", "Kara", "", nil},
+	{"TG-M", "Maritime (Région)", "", []string{"Maritime"}},
+	{"TG-P", "Plateaux", "", nil},
+	{"TG-S", "Savanes", "", nil},
+
+	// Thailand
+	{"TH-10", "Krung Thep Maha Nakhon", "", nil},
+	{"TH-11", "Samut Prakan", "", nil},
+	{"TH-12", "Nonthaburi", "", nil},
+	{"TH-13", "Pathum Thani", "", nil},
+	{"TH-14", "Phra Nakhon Si Ayutthaya", "", nil},
+	{"TH-15", "Ang Thong", "", nil},
+	{"TH-16", "Lop Buri", "", nil},
+	{"TH-17", "Sing Buri", "", nil},
+	{"TH-18", "Chai Nat", "", nil},
+	{"TH-19", "Saraburi", "", nil},
+	{"TH-20", "Chon Buri", "", nil},
+	{"TH-21", "Rayong", "", nil},
+	{"TH-22", "Chanthaburi", "", nil},
+	{"TH-23", "Trat", "", nil},
+	{"TH-24", "Chachoengsao", "", nil},
+	{"TH-25", "Prachin Buri", "", nil},
+	{"TH-26", "Nakhon Nayok", "", nil},
+	{"TH-27", "Sa Kaeo", "", nil},
+	{"TH-30", "Nakhon Ratchasima", "", nil},
+	{"TH-31", "Buri Ram", "", nil},
+	{"TH-32", "Surin", "", nil},
+	{"TH-33", "Si Sa Ket", "", nil},
+	{"TH-34", "Ubon Ratchathani", "", nil},
+	{"TH-35", "Yasothon", "", nil},
+	{"TH-36", "Chaiyaphum", "", nil},
+	{"TH-37", "Amnat Charoen", "", nil},
+	{"TH-38", "Bueng Kan", "", nil},
+	{"TH-39", "Nong Bua Lam Phu", "", nil},
+	{"TH-40", "Khon Kaen", "", nil},
+	{"TH-41", "Udon Thani", "", nil},
+	{"TH-42", "Loei", "", nil},
+	{"TH-43", "Nong Khai", "", nil},
+	{"TH-44", "Maha Sarakham", "", nil},
+	{"TH-45", "Roi Et", "", nil},
+	{"TH-46", "Kalasin", "", nil},
+	{"TH-47", "Sakon Nakhon", "", nil},
+	{"TH-48", "Nakhon Phanom", "", nil},
+	{"TH-49", "Mukdahan", "", nil},
+	{"TH-50", "Chiang Mai", "", nil},
+	{"TH-51", "Lamphun", "", nil},
+	{"TH-52", "Lampang", "", nil},
+	{"TH-53", "Uttaradit", "", nil},
+	{"TH-54", "Phrae", "", nil},
+	{"TH-55", "Nan", "", nil},
+	{"TH-56", "Phayao", "", nil},
+	{"TH-57", "Chiang Rai", "", nil},
+	{"TH-58", "Mae Hong Son", "", nil},
+	{"TH-60", "Nakhon Sawan", "", nil},
+	{"TH-61", "Uthai Thani", "", nil},
+	{"TH-62", "Kamphaeng Phet", "", nil},
+	{"TH-63", "Tak", "", nil},
+	{"TH-64", "Sukhothai", "", nil},
+	{"TH-65", "Phitsanulok", "", nil},
+	{"TH-66", "Phichit", "", nil},
+	{"TH-67", "Phetchabun", "", nil},
+	{"TH-70", "Ratchaburi", "", nil},
+	{"TH-71", "Kanchanaburi", "", nil},
+	{"TH-72", "Suphan Buri", "", nil},
+	{"TH-73", "Nakhon Pathom", "", nil},
+	{"TH-74", "Samut Sakhon", "", nil},
+	{"TH-75", "Samut Songkhram", "", nil},
+	{"TH-76", "Phetchaburi", "", nil},
+	{"TH-77", "Prachuap Khiri Khan", "", nil},
+	{"TH-80", "Nakhon Si Thammarat", "", nil},
+	{"TH-81", "Krabi", "", nil},
+	{"TH-82", "Phangnga", "", nil},
+	{"TH-83", "Phuket", "", nil},
+	{"TH-84", "Surat Thani", "", nil},
+	{"TH-85", "Ranong", "", nil},
+	{"TH-86", "Chumphon", "", nil},
+	{"TH-90", "Songkhla", "", nil},
+	{"TH-91", "Satun", "", nil},
+	{"TH-92", "Trang", "", nil},
+	{"TH-93", "Phatthalung", "", nil},
+	{"TH-94", "Pattani", "", nil},
+	{"TH-95", "Yala", "", nil},
+	{"TH-96", "Narathiwat", "", nil},
+	{"TH-S", "Phatthaya", "", nil},
+
+	// Tajikistan
+	{"TJ-DU", "Dushanbe", "", nil},
+	{"TJ-GB", "Kŭhistoni Badakhshon", "", nil},
+	{"TJ-KT", "Khatlon", "", nil},
+	{"TJ-RA", "nohiyahoi tobei jumhurí", "", nil},
+	{"TJ-SU", "Sughd", "", nil},
+
+	// Timor-Leste
+	{"TL-AL", "Aileu", "", nil},
+	{"TL-AN", "Ainaro", "", nil},
+	{"TL-BA", "Baucau", "", nil},
+	{"TL-BO", "Bobonaro", "", nil},
+	{"TL-CO", "Cova Lima", "", nil},
+	{"TL-DI", "Díli", "", nil},
+	{"TL-ER", "Ermera", "", nil},
+	{"TL-LA", "Lautein", "", nil},
+	{"TL-LI", "Likisá", "", nil},
+	{"TL-MF", "Manufahi", "", nil},
+	{"TL-MT", "Manatuto", "", nil},
+	{"TL-OE", "Oekusi-Ambenu", "", nil},
+	{"TL-VI", "Vikeke", "", nil},
+
+	// Turkmenistan
+	{"TM-A", "Ahal", "", nil},
+	{"TM-B", "Balkan", "", nil},
+	{"TM-D", "Daşoguz", "", nil},
+	{"TM-L", "Lebap", "", nil},
+	{"TM-M", "Mary", "", nil},
+	{"TM-S", "Aşgabat", "", nil},
+
+	// Tunisia
+	{"TN-11", "Tunis", "", nil},
+	{"TN-12", "L'Ariana", "", nil},
+	{"TN-13", "Ben Arous", "", nil},
+	{"TN-14", "La Manouba", "", nil},
+	{"TN-21", "Nabeul", "", nil},
+	{"TN-22", "Zaghouan", "", nil},
+	{"TN-23", "Bizerte", "", nil},
+	{"TN-31", "Béja", "", nil},
+	{"TN-32", "Jendouba", "", nil},
+	{"TN-33", "Le Kef", "", nil},
+	{"TN-34", "Siliana", "", nil},
+	{"TN-41", "Kairouan", "", nil},
+	{"TN-42", "Kasserine", "", nil},
+	{"TN-43", "Sidi Bouzid", "", nil},
+	{"TN-51", "Sousse", "", nil},
+	{"TN-52", "Monastir", "", nil},
+	{"TN-53", "Mahdia", "", nil},
+	{"TN-61", "Sfax", "", nil},
+	{"TN-71", "Gafsa", "", nil},
+	{"TN-72", "Tozeur", "", nil},
+	{"TN-73", "Kébili", "", nil},
+	{"TN-81", "Gabès", "", nil},
+	{"TN-82", "Médenine", "", nil},
+	{"TN-83", "Tataouine", "", nil},
+
+	// Tonga
+	{"TO-01", "'Eua", "", nil},
+	{"TO-02", "Ha'apai", "", nil},
+	{"TO-03", "Niuas", "", nil},
+	{"TO-04", "Tongatapu", "", nil},
+	{"TO-05", "Vava'u", "", nil},
+
+	// Türkiye
+	{"TR-01", "Adana", "", nil},
+	{"TR-02", "Adıyaman", "", nil},
+	{"TR-03", "Afyonkarahisar", "", nil},
+	{"TR-04", "Ağrı", "", nil},
+	{"TR-05", "Amasya", "", nil},
+	{"TR-06", "Ankara", "", nil},
+	{"TR-07", "Antalya", "", nil},
+	{"TR-08", "Artvin", "", nil},
+	{"TR-09", "Aydın", "", nil},
+	{"TR-10", "Balıkesir", "", nil},
+	{"TR-11", "Bilecik", "", nil},
+	{"TR-12", "Bingöl", "", nil},
+	{"TR-13", "Bitlis", "", nil},
+	{"TR-14", "Bolu", "", nil},
+	{"TR-15", "Burdur", "", nil},
+	{"TR-16", "Bursa", "", nil},
+	{"TR-17", "Çanakkale", "", nil},
+	{"TR-18", "Çankırı", "", nil},
+	{"TR-19", "Çorum", "", nil},
+	{"TR-20", "Denizli", "", nil},
+	{"TR-21", "Diyarbakır", "", nil},
+	{"TR-22", "Edirne", "", nil},
+	{"TR-23", "Elazığ", "", nil},
+	{"TR-24", "Erzincan", "", nil},
+	{"TR-25", "Erzurum", "", nil},
+	{"TR-26", "Eskişehir", "", nil},
+	{"TR-27", "Gaziantep", "", nil},
+	{"TR-28", "Giresun", "", nil},
+	{"TR-29", "Gümüşhane", "", nil},
+	{"TR-30", "Hakkâri", "", nil},
+	{"TR-31", "Hatay", "", nil},
+	{"TR-32", "Isparta", "", nil},
+	{"TR-33", "Mersin", "", nil},
+	{"TR-34", "İstanbul", "", nil},
+	{"TR-35", "İzmir", "", nil},
+	{"TR-36", "Kars", "", nil},
+	{"TR-37", "Kastamonu", "", nil},
+	{"TR-38", "Kayseri", "", nil},
+	{"TR-39", "Kırklareli", "", nil},
+	{"TR-40", "Kırşehir", "", nil},
+	{"TR-41", "Kocaeli", "", nil},
+	{"TR-42", "Konya", "", nil},
+	{"TR-43", "Kütahya", "", nil},
+	{"TR-44", "Malatya", "", nil},
+	{"TR-45", "Manisa", "", nil},
+	{"TR-46", "Kahramanmaraş", "", nil},
+	{"TR-47", "Mardin", "", nil},
+	{"TR-48", "Muğla", "", nil},
+	{"TR-49", "Muş", "", nil},
+	{"TR-50", "Nevşehir", "", nil},
+	{"TR-51", "Niğde", "", nil},
+	{"TR-52", "Ordu", "", nil},
+	{"TR-53", "Rize", "", nil},
+	{"TR-54", "Sakarya", "", nil},
+	{"TR-55", "Samsun", "", nil},
+	{"TR-56", "Siirt", "", nil},
+	{"TR-57", "Sinop", "", nil},
+	{"TR-58", "Sivas", "", nil},
+	{"TR-59", "Tekirdağ", "", nil},
+	{"TR-60", "Tokat", "", nil},
+	{"TR-61", "Trabzon", "", nil},
+	{"TR-62", "Tunceli", "", nil},
+	{"TR-63", "Şanlıurfa", "", nil},
+	{"TR-64", "Uşak", "", nil},
+	{"TR-65", "Van", "", nil},
+	{"TR-66", "Yozgat", "", nil},
+	{"TR-67", "Zonguldak", "", nil},
+	{"TR-68", "Aksaray", "", nil},
+	{"TR-69", "Bayburt", "", nil},
+	{"TR-70", "Karaman", "", nil},
+	{"TR-71", "Kırıkkale", "", nil},
+	{"TR-72", "Batman", "", nil},
+	{"TR-73", "Şırnak", "", nil},
+	{"TR-74", "Bartın", "", nil},
+	{"TR-75", "Ardahan", "", nil},
+	{"TR-76", "Iğdır", "", nil},
+	{"TR-77", "Yalova", "", nil},
+	{"TR-78", "Karabük", "", nil},
+	{"TR-79", "Kilis", "", nil},
+	{"TR-80", "Osmaniye", "", nil},
+	{"TR-81", "Düzce", "", nil},
+
+	// Trinidad and Tobago
+	{"TT-ARI", "Arima", "", nil},
+	{"TT-CHA", "Chaguanas", "", nil},
+	{"TT-CTT", "Couva-Tabaquite-Talparo", "", nil},
+	{"TT-DMN", "Diego Martin", "", nil},
+	{"TT-MRC", "Mayaro-Rio Claro", "", nil},
+	{"TT-PED", "Penal-Debe", "", nil},
+	{"TT-POS", "Port of Spain", "", nil},
+	{"TT-PRT", "Princes Town", "", nil},
+	{"TT-PTF", "Point Fortin", "", nil},
+	{"TT-SFO", "San Fernando", "", nil},
+	{"TT-SGE", "Sangre Grande", "", nil},
+	{"TT-SIP", "Siparia", "", nil},
+	{"TT-SJL", "San Juan-Laventille", "", nil},
+	{"TT-TOB", "Tobago", "", nil},
+	{"TT-TUP", "Tunapuna-Piarco", "", nil},
+
+	// Tuvalu
+	{"TV-FUN", "Funafuti", "", nil},
+	{"TV-NIT", "Niutao", "", nil},
+	{"TV-NKF", "Nukufetau", "", nil},
+	{"TV-NKL", "Nukulaelae", "", nil},
+	{"TV-NMA", "Nanumea", "", nil},
+	{"TV-NMG", "Nanumaga", "", nil},
+	{"TV-NUI", "Nui", "", nil},
+	{"TV-VAI", "Vaitupu", "", nil},
+
+	// Taiwan
+	{"TW-CHA", "Changhua", "", nil},
+	{"TW-CYI", "Chiayi", "", nil},
+	{"TW-CYQ", "Chiayi", "", nil},
+	{"TW-HSQ", "Hsinchu", "", nil},
+	{"TW-HSZ", "Hsinchu", "", nil},
+	{"TW-HUA", "Hualien", "", nil},
+	{"TW-ILA", "Yilan", "", nil},
+	{"TW-KEE", "Keelung", "", nil},
+	{"TW-KHH", "Kaohsiung", "", nil},
+	{"TW-KIN", "Kinmen", "", nil},
+	{"TW-LIE", "Lienchiang", "", nil},
+	{"TW-MIA", "Miaoli", "", nil},
+	{"TW-NAN", "Nantou", "", nil},
+	{"TW-NWT", "New Taipei", "", nil},
+	{"TW-PEN", "Penghu", "", nil},
+	{"TW-PIF", "Pingtung", "", nil},
+	{"TW-TAO", "Taoyuan", "", nil},
+	{"TW-TNN", "Tainan", "", nil},
+	{"TW-TPE", "Taipei", "", nil},
+	{"TW-TTT", "Taitung", "", nil},
+	{"TW-TXG", "Taichung", "", nil},
+	{"TW-YUN", "Yunlin", "", nil},
+
+	// Tanzania
+	{"TZ-01", "Arusha", "", nil},
+	{"TZ-02", "Dar es Salaam", "", nil},
+	{"TZ-03", "Dodoma", "", nil},
+	{"TZ-04", "Iringa", "", nil},
+	{"TZ-05", "Kagera", "", nil},
+	{"TZ-06", "Pemba North", "", nil},
+	{"TZ-07", "Zanzibar North", "", nil},
+	{"TZ-08", "Kigoma", "", nil},
+	{"TZ-09", "Kilimanjaro", "", nil},
+	{"TZ-10", "Pemba South", "", nil},
+	{"TZ-11", "Zanzibar South", "", nil},
+	{"TZ-12", "Lindi", "", nil},
+	{"TZ-13", "Mara", "", nil},
+	{"TZ-14", "Mbeya", "", nil},
+	{"TZ-15", "Zanzibar West", "", nil},
+	{"TZ-16", "Morogoro", "", nil},
+	{"TZ-17", "Mtwara", "", nil},
+	{"TZ-18", "Mwanza", "", nil},
+	{"TZ-19", "Coast", "", nil},
+	{"TZ-20", "Rukwa", "", nil},
+	{"TZ-21", "Ruvuma", "", nil},
+	{"TZ-22", "Shinyanga", "", nil},
+	{"TZ-23", "Singida", "", nil},
+	{"TZ-24", "Tabora", "", nil},
+	{"TZ-25", "Tanga", "", nil},
+	{"TZ-26", "Manyara", "", nil},
+	{"TZ-27", "Geita", "", nil},
+	{"TZ-28", "Katavi", "", nil},
+	{"TZ-29", "Njombe", "", nil},
+	{"TZ-30", "Simiyu", "", nil},
+	{"TZ-31", "Songwe", "", nil},
+
+	// Ukraine
+	{"UA-05", "Vinnytska oblast", "", nil},
+	{"UA-07", "Volynska oblast", "", nil},
+	{"UA-09", "Luhanska oblast", "", nil},
+	{"UA-12", "Dnipropetrovska oblast", "", nil},
+	{"UA-14", "Donetska oblast", "", nil},
+	{"UA-18", "Zhytomyrska oblast", "", nil},
+	{"UA-21", "Zakarpatska oblast", "", nil},
+	{"UA-23", "Zaporizka oblast", "", nil},
+	{"UA-26", "Ivano-Frankivska oblast", "", nil},
+	{"UA-30", "Kyiv", "", nil},
+	{"UA-32", "Kyivska oblast", "", nil},
+	{"UA-35", "Kirovohradska oblast", "", nil},
+	{"UA-40", "Sevastopol", "", nil},
+	{"UA-43", "Avtonomna Respublika Krym", "", nil},
+	{"UA-46", "Lvivska oblast", "", nil},
+	{"UA-48", "Mykolaivska oblast", "", nil},
+	{"UA-51", "Odeska oblast", "", nil},
+	{"UA-53", "Poltavska oblast", "", nil},
+	{"UA-56", "Rivnenska oblast", "", nil},
+	{"UA-59", "Sumska oblast", "", nil},
+	{"UA-61", "Ternopilska oblast", "", nil},
+	{"UA-63", "Kharkivska oblast", "", nil},
+	{"UA-65", "Khersonska oblast", "", nil},
+	{"UA-68", "Khmelnytska oblast", "", nil},
+	{"UA-71", "Cherkaska oblast", "", nil},
+	{"UA-74", "Chernihivska oblast", "", nil},
+	{"UA-77", "Chernivetska oblast", "", nil},
+
+	// Uganda
+	{"UG-101", "Kalangala", "UG-C", nil},
+	{"UG-102", "Kampala", "UG-C", nil},
+	{"UG-103", "Kiboga", "UG-C", nil},
+	{"UG-104", "Luwero", "UG-C", nil},
+	{"UG-105", "Masaka", "UG-C", nil},
+	{"UG-106", "Mpigi", "UG-C", nil},
+	{"UG-107", "Mubende", "UG-C", nil},
+	{"UG-108", "Mukono", "UG-C", nil},
+	{"UG-109", "Nakasongola", "UG-C", nil},
+	{"UG-110", "Rakai", "UG-C", nil},
+	{"UG-111", "Sembabule", "UG-C", nil},
+	{"UG-112", "Kayunga", "UG-C", nil},
+	{"UG-113", "Wakiso", "UG-C", nil},
+	{"UG-114", "Lyantonde", "UG-C", nil},
+	{"UG-115", "Mityana", "UG-C", nil},
+	{"UG-116", "Nakaseke", "UG-C", nil},
+	{"UG-117", "Buikwe", "UG-C", nil},
+	{"UG-118", "Bukomansibi", "UG-C", nil},
+	{"UG-119", "Butambala", "UG-C", nil},
+	{"UG-120", "Buvuma", "UG-C", nil},
+	{"UG-121", "Gomba", "UG-C", nil},
+	{"UG-122", "Kalungu", "UG-C", nil},
+	{"UG-123", "Kyankwanzi", "UG-C", nil},
+	{"UG-124", "Lwengo", "UG-C", nil},
+	{"UG-125", "Kyotera", "UG-C", nil},
+	{"UG-126", "Kasanda", "UG-C", nil},
+	{"UG-201", "Bugiri", "UG-E", nil},
+	{"UG-202", "Busia", "UG-E", nil},
+	{"UG-203", "Iganga", "UG-E", nil},
+	{"UG-204", "Jinja", "UG-E", nil},
+	{"UG-205", "Kamuli", "UG-E", nil},
+	{"UG-206", "Kapchorwa", "UG-E", nil},
+	{"UG-207", "Katakwi", "UG-E", nil},
+	{"UG-208", "Kumi", "UG-E", nil},
+	{"UG-209", "Mbale", "UG-E", nil},
+	{"UG-210", "Pallisa", "UG-E", nil},
+	{"UG-211", "Soroti", "UG-E", nil},
+	{"UG-212", "Tororo", "UG-E", nil},
+	{"UG-213", "Kaberamaido", "UG-E", nil},
+	{"UG-214", "Mayuge", "UG-E", nil},
+	{"UG-215", "Sironko", "UG-E", nil},
+	{"UG-216", "Amuria", "UG-E", nil},
+	{"UG-217", "Budaka", "UG-E", nil},
+	{"UG-218", "Bududa", "UG-E", nil},
+	{"UG-219", "Bukedea", "UG-E", nil},
+	{"UG-220", "Bukwo", "UG-E", nil},
+	{"UG-221", "Butaleja", "UG-E", nil},
+	{"UG-222", "Kaliro", "UG-E", nil},
+	{"UG-223", "Manafwa", "UG-E", nil},
+	{"UG-224", "Namutumba", "UG-E", nil},
+	{"UG-225", "Bulambuli", "UG-E", nil},
+	{"UG-226", "Buyende", "UG-E", nil},
+	{"UG-227", "Kibuku", "UG-E", nil},
+	{"UG-228", "Kween", "UG-E", nil},
+	{"UG-229", "Luuka", "UG-E", nil},
+	{"UG-230", "Namayingo", "UG-E", nil},
+	{"UG-231", "Ngora", "UG-E", nil},
+	{"UG-232", "Serere", "UG-E", nil},
+	{"UG-233", "Butebo", "UG-E", nil},
+	{"UG-234", "Namisindwa", "UG-E", nil},
+	{"UG-235", "Bugweri", "UG-E", nil},
+	{"UG-236", "Kapelebyong", "UG-E", nil},
+	{"UG-237", "Kalaki", "UG-E", nil},
+	{"UG-301", "Adjumani", "UG-N", nil},
+	{"UG-302", "Apac", "UG-N", nil},
+	{"UG-303", "Arua", "UG-N", nil},
+	{"UG-304", "Gulu", "UG-N", nil},
+	{"UG-305", "Kitgum", "UG-N", nil},
+	{"UG-306", "Kotido", "UG-N", nil},
+	{"UG-307", "Lira", "UG-N", nil},
+	{"UG-308", "Moroto", "UG-N", nil},
+	{"UG-309", "Moyo", "UG-N", nil},
+	{"UG-310", "Nebbi", "UG-N", nil},
+	{"UG-311", "Nakapiripirit", "UG-N", nil},
+	{"UG-312", "Pader", "UG-N", nil},
+	{"UG-313", "Yumbe", "UG-N", nil},
+	{"UG-314", "Abim", "UG-N", nil},
+	{"UG-315", "Amolatar", "UG-N", nil},
+	{"UG-316", "Amuru", "UG-N", nil},
+	{"UG-317", "Dokolo", "UG-N", nil},
+	{"UG-318", "Kaabong", "UG-N", nil},
+	{"UG-319", "Koboko", "UG-N", nil},
+	{"UG-320", "Maracha", "UG-N", nil},
+	{"UG-321", "Oyam", "UG-N", nil},
+	{"UG-322", "Agago", "UG-N", nil},
+	{"UG-323", "Alebtong", "UG-N", nil},
+	{"UG-324", "Amudat", "UG-N", nil},
+	{"UG-325", "Kole", "UG-N", nil},
+	{"UG-326", "Lamwo", "UG-N", nil},
+	{"UG-327", "Napak", "UG-N", nil},
+	{"UG-328", "Nwoya", "UG-N", nil},
+	{"UG-329", "Otuke", "UG-N", nil},
+	{"UG-330", "Zombo", "UG-N", nil},
+	{"UG-331", "Omoro", "UG-N", nil},
+	{"UG-332", "Pakwach", "UG-N", nil},
+	{"UG-333", "Kwania", "UG-N", nil},
+	{"UG-334", "Nabilatuk", "UG-N", nil},
+	{"UG-335", "Karenga", "UG-N", nil},
+	{"UG-336", "Madi-Okollo", "UG-N", nil},
+	{"UG-337", "Obongi", "UG-N", nil},
+	{"UG-401", "Bundibugyo", "UG-W", nil},
+	{"UG-402", "Bushenyi", "UG-W", nil},
+	{"UG-403", "Hoima", "UG-W", nil},
+	{"UG-404", "Kabale", "UG-W", nil},
+	{"UG-405", "Kabarole", "UG-W", nil},
+	{"UG-406", "Kasese", "UG-W", nil},
+	{"UG-407", "Kibaale", "UG-W", nil},
+	{"UG-408", "Kisoro", "UG-W", nil},
+	{"UG-409", "Masindi", "UG-W", nil},
+	{"UG-410", "Mbarara", "UG-W", nil},
+	{"UG-411", "Ntungamo", "UG-W", nil},
+	{"UG-412", "Rukungiri", "UG-W", nil},
+	{"UG-413", "Kamwenge", "UG-W", nil},
+	{"UG-414", "Kanungu", "UG-W", nil},
+	{"UG-415", "Kyenjojo", "UG-W", nil},
+	{"UG-416", "Buliisa", "UG-W", nil},
+	{"UG-417", "Ibanda", "UG-W", nil},
+	{"UG-418", "Isingiro", "UG-W", nil},
+	{"UG-419", "Kiruhura", "UG-W", nil},
+	{"UG-420", "Buhweju", "UG-W", nil},
+	{"UG-421", "Kiryandongo", "UG-W", nil},
+	{"UG-422", "Kyegegwa", "UG-W", nil},
+	{"UG-423", "Mitooma", "UG-W", nil},
+	{"UG-424", "Ntoroko", "UG-W", nil},
+	{"UG-425", "Rubirizi", "UG-W", nil},
+	{"UG-426", "Sheema", "UG-W", nil},
+	{"UG-427", "Kagadi", "UG-W", nil},
+	{"UG-428", "Kakumiro", "UG-W", nil},
+	{"UG-429", "Rubanda", "UG-W", nil},
+	{"UG-430", "Bunyangabu", "UG-W", nil},
+	{"UG-431", "Rukiga", "UG-W", nil},
+	{"UG-432", "Kikuube", "UG-W", nil},
+	{"UG-433", "Kazo", "UG-W", nil},
+	{"UG-434", "Kitagwenda", "UG-W", nil},
+	{"UG-435", "Rwampara", "UG-W", nil},
+	{"UG-C", "Central", "", nil},
+	{"UG-E", "Eastern", "", nil},
+	{"UG-N", "Northern", "", nil},
+	{"UG-W", "Western", "", nil},
+
+	// United States Minor Outlying Islands
+	{"UM-67", "Johnston Atoll", "", nil},
+	{"UM-71", "Midway Islands", "", nil},
+	{"UM-76", "Navassa Island", "", nil},
+	{"UM-79", "Wake Island", "", nil},
+	{"UM-81", "Baker Island", "", nil},
+	{"UM-84", "Howland Island", "", nil},
+	{"UM-86", "Jarvis Island", "", nil},
+	{"UM-89", "Kingman Reef", "", nil},
+	{"UM-95", "Palmyra Atoll", "", nil},
+
+	// United States
+	{"US-AK", "Alaska", "", nil},
+	{"US-AL", "Alabama", "", nil},
+	{"US-AR", "Arkansas", "", nil},
+	{"US-AS", "American Samoa", "", nil},
+	{"US-AZ", "Arizona", "", nil},
+	{"US-CA", "California", "", nil},
+	{"US-CO", "Colorado", "", nil},
+	{"US-CT", "Connecticut", "", nil},
+	{"US-DC", "District of Columbia", "", []string{"Washington, D.C.", "Washington DC"}},
+	{"US-DE", "Delaware", "", nil},
+	{"US-FL", "Florida", "", nil},
+	{"US-GA", "Georgia", "", nil},
+	{"US-GU", "Guam", "", nil},
+	{"US-HI", "Hawaii", "", nil},
+	{"US-IA", "Iowa", "", nil},
+	{"US-ID", "Idaho", "", nil},
+	{"US-IL", "Illinois", "", nil},
+	{"US-IN", "Indiana", "", nil},
+	{"US-KS", "Kansas", "", nil},
+	{"US-KY", "Kentucky", "", nil},
+	{"US-LA", "Louisiana", "", nil},
+	{"US-MA", "Massachusetts", "", nil},
+	{"US-MD", "Maryland", "", nil},
+	{"US-ME", "Maine", "", nil},
+	{"US-MI", "Michigan", "", nil},
+	{"US-MN", "Minnesota", "", nil},
+	{"US-MO", "Missouri", "", nil},
+	{"US-MP", "Northern Mariana Islands", "", nil},
+	{"US-MS", "Mississippi", "", nil},
+	{"US-MT", "Montana", "", nil},
+	{"US-NC", "North Carolina", "", nil},
+	{"US-ND", "North Dakota", "", nil},
+	{"US-NE", "Nebraska", "", nil},
+	{"US-NH", "New Hampshire", "", nil},
+	{"US-NJ", "New Jersey", "", nil},
+	{"US-NM", "New Mexico", "", nil},
+	{"US-NV", "Nevada", "", nil},
+	{"US-NY", "New York", "", nil},
+	{"US-OH", "Ohio", "", nil},
+	{"US-OK", "Oklahoma", "", nil},
+	{"US-OR", "Oregon", "", nil},
+	{"US-PA", "Pennsylvania", "", nil},
+	{"US-PR", "Puerto Rico", "", nil},
+	{"US-RI", "Rhode Island", "", nil},
+	{"US-SC", "South Carolina", "", nil},
+	{"US-SD", "South Dakota", "", nil},
+	{"US-TN", "Tennessee", "", nil},
+	{"US-TX", "Texas", "", nil},
+	{"US-UM", "United States Minor Outlying Islands", "", nil},
+	{"US-UT", "Utah", "", nil},
+	{"US-VA", "Virginia", "", nil},
+	{"US-VI", "U.S. Virgin Islands", "", []string{"Virgin Islands", "Virgin Islands, U.S."}},
+	{"US-VT", "Vermont", "", nil},
+	{"US-WA", "Washington", "", nil},
+	{"US-WI", "Wisconsin", "", nil},
+	{"US-WV", "West Virginia", "", nil},
+	{"US-WY", "Wyoming", "", nil},
+
+	// Uruguay
+	{"UY-AR", "Artigas", "", nil},
+	{"UY-CA", "Canelones", "", nil},
+	{"UY-CL", "Cerro Largo", "", nil},
+	{"UY-CO", "Colonia", "", nil},
+	{"UY-DU", "Durazno", "", nil},
+	{"UY-FD", "Florida", "", nil},
+	{"UY-FS", "Flores", "", nil},
+	{"UY-LA", "Lavalleja", "", nil},
+	{"UY-MA", "Maldonado", "", nil},
+	{"UY-MO", "Montevideo", "", nil},
+	{"UY-PA", "Paysandú", "", nil},
+	{"UY-RN", "Río Negro", "", nil},
+	{"UY-RO", "Rocha", "", nil},
+	{"UY-RV", "Rivera", "", nil},
+	{"UY-SA", "Salto", "", nil},
+	{"UY-SJ", "San José", "", nil},
+	{"UY-SO", "Soriano", "", nil},
+	{"UY-TA", "Tacuarembó", "", nil},
+	{"UY-TT", "Treinta y Tres", "", nil},
+
+	// Uzbekistan
+	{"UZ-AN", "Andijon", "", nil},
+	{"UZ-BU", "Buxoro", "", nil},
+	{"UZ-FA", "Farg‘ona", "", nil},
+	{"UZ-JI", "Jizzax", "", nil},
+	{"UZ-NG", "Namangan", "", nil},
+	{"UZ-NW", "Navoiy", "", nil},
+	{"UZ-QA", "Qashqadaryo", "", nil},
+	{"UZ-QR", "Qoraqalpog‘iston Respublikasi", "", nil},
+	{"UZ-SA", "Samarqand", "", nil},
+	{"UZ-SI", "Sirdaryo", "", nil},
+	{"UZ-SU", "Surxondaryo", "", nil},
+	{"UZ-TK", "Toshkent", "", nil},
+	{"UZ-TO", "Toshkent", "", nil},
+	{"UZ-XO", "Xorazm", "", nil},
+
+	// Saint Vincent and the Grenadines
+	{"VC-01", "Charlotte", "", nil},
+	{"VC-02", "Saint Andrew", "", nil},
+	{"VC-03", "Saint David", "", nil},
+	{"VC-04", "Saint George", "", nil},
+	{"VC-05", "Saint Patrick", "", nil},
+	{"VC-06", "Grenadines", "", nil},
+
+	// Venezuela
+	{"VE-A", "Distrito Capital", "", nil},
+	{"VE-B", "Anzoátegui", "", nil},
+	{"VE-C", "Apure", "", nil},
+	{"VE-D", "Aragua", "", nil},
+	{"VE-E", "Barinas", "", nil},
+	{"VE-F", "Bolívar", "", nil},
+	{"VE-G", "Carabobo", "", nil},
+	{"VE-H", "Cojedes", "", nil},
+	{"VE-I", "Falcón", "", nil},
+	{"VE-J", "Guárico", "", nil},
+	{"VE-K", "Lara", "", nil},
+	{"VE-L", "Mérida", "", nil},
+	{"VE-M", "Miranda", "", nil},
+	{"VE-N", "Monagas", "", nil},
+	{"VE-O", "Nueva Esparta", "", nil},
+	{"VE-P", "Portuguesa", "", nil},
+	{"VE-R", "Sucre", "", nil},
+	{"VE-S", "Táchira", "", nil},
+	{"VE-T", "Trujillo", "", nil},
+	{"VE-U", "Yaracuy", "", nil},
+	{"VE-V", "Zulia", "", nil},
+	{"VE-W", "Dependencias Federales", "", nil},
+	{"VE-X", "La Guaira", "", nil},
+	{"VE-Y", "Delta Amacuro", "", nil},
+	{"VE-Z", "Amazonas", "", nil},
+
+	// Vietnam
+	{"VN-01", "Lai Châu", "", nil},
+	{"VN-02", "Lào Cai", "", nil},
+	{"VN-03", "Hà Giang", "", nil},
+	{"VN-04", "Cao Bằng", "", nil},
+	{"VN-05", "Sơn La", "", nil},
+	{"VN-06", "Yên Bái", "", nil},
+	{"VN-07", "Tuyên Quang", "", nil},
+	{"VN-09", "Lạng Sơn", "", nil},
+	{"VN-13", "Quảng Ninh", "", nil},
+	{"VN-14", "Hòa Bình", "", nil},
+	{"VN-18", "Ninh Bình", "", nil},
+	{"VN-20", "Thái Bình", "", nil},
+	{"VN-21", "Thanh Hóa", "", nil},
+	{"VN-22", "Nghệ An", "", nil},
+	{"VN-23", "Hà Tĩnh", "", nil},
+	{"VN-24", "Quảng Bình", "", nil},
+	{"VN-25", "Quảng Trị", "", nil},
+	{"VN-26", "Thừa Thiên-Huế", "", nil},
+	{"VN-27", "Quảng Nam", "", nil},
+	{"VN-28", "Kon Tum", "", nil},
+	{"VN-29", "Quảng Ngãi", "", nil},
+	{"VN-30", "Gia Lai", "", nil},
+	{"VN-31", "Bình Định", "", nil},
+	{"VN-32", "Phú Yên", "", nil},
+	{"VN-33", "Đắk Lắk", "", nil},
+	{"VN-34", "Khánh Hòa", "", nil},
+	{"VN-35", "Lâm Đồng", "", nil},
+	{"VN-36", "Ninh Thuận", "", nil},
+	{"VN-37", "Tây Ninh", "", nil},
+	{"VN-39", "Đồng Nai", "", nil},
+	{"VN-40", "Bình Thuận", "", nil},
+	{"VN-41", "Long An", "", nil},
+	{"VN-43", "Bà Rịa - Vũng Tàu", "", nil},
+	{"VN-44", "An Giang", "", nil},
+	{"VN-45", "Đồng Tháp", "", nil},
+	{"VN-46", "Tiền Giang", "", nil},
+	{"VN-47", "Kiến Giang", "", nil},
+	{"VN-49", "Vĩnh Long", "", nil},
+	{"VN-50", "Bến Tre", "", nil},
+	{"VN-51", "Trà Vinh", "", nil},
+	{"VN-52", "Sóc Trăng", "", nil},
+	{"VN-53", "Bắc Kạn", "", nil},
+	{"VN-54", "Bắc Giang", "", nil},
+	{"VN-55", "Bạc Liêu", "", nil},
+	{"VN-56", "Bắc Ninh", "", nil},
+	{"VN-57", "Bình Dương", "", nil},
+	{"VN-58", "Bình Phước", "", nil},
+	{"VN-59", "Cà Mau", "", nil},
+	{"VN-61", "Hải Dương", "", nil},
+	{"VN-63", "Hà Nam", "", nil},
+	{"VN-66", "Hưng Yên", "", nil},
+	{"VN-67", "Nam Định", "", nil},
+	{"VN-68", "Phú Thọ", "", nil},
+	{"VN-69", "Thái Nguyên", "", nil},
+	{"VN-70", "Vĩnh Phúc", "", nil},
+	{"VN-71", "Điện Biên", "", nil},
+	{"VN-72", "Đắk Nông", "", nil},
+	{"VN-73", "Hậu Giang", "", nil},
+	{"VN-CT", "Cần Thơ", "", nil},
+	{"VN-DN", "Đà Nẵng", "", nil},
+	{"VN-HN", "Hà Nội", "", nil},
+	{"VN-HP", "Hải Phòng", "", nil},
+	{"VN-SG", "Hồ Chí Minh", "", nil},
+
+	// Vanuatu
+	{"VU-MAP", "Malampa", "", nil},
+	{"VU-PAM", "Pénama", "", nil},
+	{"VU-SAM", "Sanma", "", nil},
+	{"VU-SEE", "Shéfa", "", nil},
+	{"VU-TAE", "Taféa", "", nil},
+	{"VU-TOB", "Torba", "", nil},
+
+	// Wallis and Futuna
+	{"WF-AL", "Alo", "", nil},
+	{"WF-SG", "Sigave", "", nil},
+	{"WF-UV", "Uvea", "", nil},
+
+	// Samoa
+	{"WS-AA", "A'ana", "", nil},
+	{"WS-AL", "Aiga-i-le-Tai", "", nil},
+	{"WS-AT", "Atua", "", nil},
+	{"WS-FA", "Fa'asaleleaga", "", nil},
+	{"WS-GE", "Gaga'emauga", "", nil},
+	{"WS-GI", "Gagaifomauga", "", nil},
+	{"WS-PA", "Palauli", "", nil},
+	{"WS-SA", "Satupa'itea", "", nil},
+	{"WS-TU", "Tuamasaga", "", nil},
+	{"WS-VF", "Va'a-o-Fonoti", "", nil},
+	{"WS-VS", "Vaisigano", "", nil},
+
+	// Yemen
+	{"YE-AB", "Abyan", "", nil},
+	{"YE-AD", "‘Adan", "", nil},
+	{"YE-AM", "‘Amrān", "", nil},
+	{"YE-BA", "Al Bayḑā’", "", nil},
+	{"YE-DA", "Aḑ Ḑāli‘", "", nil},
+	{"YE-DH", "Dhamār", "", nil},
+	{"YE-HD", "Ḩaḑramawt", "", nil},
+	{"YE-HJ", "Ḩajjah", "", nil},
+	{"YE-HU", "Al Ḩudaydah", "", nil},
+	{"YE-IB", "Ibb", "", nil},
+	{"YE-JA", "Al Jawf", "", nil},
+	{"YE-LA", "Laḩij", "", nil},
+	{"YE-MA", "Ma’rib", "", nil},
+	{"YE-MR", "Al Mahrah", "", nil},
+	{"YE-MW", "Al Maḩwīt", "", nil},
+	{"YE-RA", "Raymah", "", nil},
+	{"YE-SA", "Amānat al ‘Āşimah", "", nil},
+	{"YE-SD", "Şāʻdah", "", nil},
+	{"YE-SH", "Shabwah", "", nil},
+	{"YE-SN", "Şanʻā’", "", nil},
+	{"YE-SU", "Arkhabīl Suquţrá", "", nil},
+	{"YE-TA", "Tāʻizz", "", nil},
+
+	// South Africa
+	{"ZA-EC", "Eastern Cape", "", nil},
+	{"ZA-FS", "Free State", "", nil},
+	{"ZA-GP", "Gauteng", "", nil},
+	{"ZA-KZN", "Kwazulu-Natal", "", nil},
+	{"ZA-LP", "Limpopo", "", nil},
+	{"ZA-MP", "Mpumalanga", "", nil},
+	{"ZA-NC", "Northern Cape", "", nil},
+	{"ZA-NW", "North-West", "", nil},
+	{"ZA-WC", "Western Cape", "", nil},
+
+	// Zambia
+	{"ZM-01", "Western", "", nil},
+	{"ZM-02", "Central", "", nil},
+	{"ZM-03", "Eastern", "", nil},
+	{"ZM-04", "Luapula", "", nil},
+	{"ZM-05", "Northern", "", nil},
+	{"ZM-06", "North-Western", "", nil},
+	{"ZM-07", "Southern", "", nil},
+	{"ZM-08", "Copperbelt", "", nil},
+	{"ZM-09", "Lusaka", "", nil},
+	{"ZM-10", "Muchinga", "", nil},
+
+	// Zimbabwe
+	{"ZW-BU", "Bulawayo", "", nil},
+	{"ZW-HA", "Harare", "", nil},
+	{"ZW-MA", "Manicaland", "", nil},
+	{"ZW-MC", "Mashonaland Central", "", nil},
+	{"ZW-ME", "Mashonaland East", "", nil},
+	{"ZW-MI", "Midlands", "", nil},
+	{"ZW-MN", "Matabeleland North", "", nil},
+	{"ZW-MS", "Matabeleland South", "", nil},
+	{"ZW-MV", "Masvingo", "", nil},
+	{"ZW-MW", "Mashonaland West", "", nil},
+}
@@ -0,0 +1,610 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// lookupTableCacheTTL bounds how long a table read by the engine is reused.
+// Changes made through the API are seen immediately by this process.
+const lookupTableCacheTTL = 60 * time.Second
+
+// lookupUpdateAttempts bounds how often an entry update is retried after
+// another update changed the table first
+const lookupUpdateAttempts = 5
+
+var (
+	lookupTablesCollection *mongo.Collection
+
+	// ErrLookupTableNotFound is returned when a lookup table does not exist
+	ErrLookupTableNotFound = errors.New("lookup table not found")
+)
+
+// Lookup tables loaded by the engine, by name
+var lookupTables = struct {
+	sync.Mutex
+	byName      map[string]*cachedLookupTable
+	invalidated uint64 // Invalidations so far, so that a load that raced one is not cached
+}{byName: make(map[string]*cachedLookupTable)}
+
+// cachedLookupTable is a lookup table indexed by normalized key
+type cachedLookupTable struct {
+	caseSensitive bool
+	values        map[string]interface{}
+	loadedAt      time.Time
+}
+
+// LookupTableDocument is the stored form of a lookup table. Entries are kept as
+// a list so that keys may contain any character.
+type LookupTableDocument struct {
+	Name          string        `bson:"_id"`
+	Description   string        `bson:"description"`
+	CaseSensitive bool          `bson:"caseSensitive"`
+	Entries       []LookupEntry `bson:"entries"`
+	CreatedAt     time.Time     `bson:"createdAt"`
+	UpdatedAt     time.Time     `bson:"updatedAt"`
+}
+
+// LookupEntry maps one key to its value. Values imported from CSV files with
+// more than two columns are objects holding the other columns.
+type LookupEntry struct {
+	Key   string      `bson:"key" json:"key"`
+	Value interface{} `bson:"value" json:"value"`
+}
+
+// LookupTable is the API view of a lookup table
+type LookupTable struct {
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	CaseSensitive bool                   `json:"caseSensitive"`
+	Entries       map[string]interface{} `json:"entries"`
+	CreatedAt     time.Time              `json:"createdAt"`
+	UpdatedAt     time.Time              `json:"updatedAt"`
+}
+
+// LookupTableInfo is the listing view of a lookup table
+type LookupTableInfo struct {
+	Name          string    `bson:"_id" json:"name"`
+	Description   string    `bson:"description" json:"description"`
+	CaseSensitive bool      `bson:"caseSensitive" json:"caseSensitive"`
+	EntryCount    int       `bson:"entryCount" json:"entryCount"`
+	CreatedAt     time.Time `bson:"createdAt" json:"createdAt"`
+	UpdatedAt     time.Time `bson:"updatedAt" json:"updatedAt"`
+}
+
+// LookupTableInput is the body accepted when creating or replacing a lookup table
+type LookupTableInput struct {
+	Name          string                 `json:"name"`
+	Description   string                 `json:"description"`
+	CaseSensitive bool                   `json:"caseSensitive"`
+	Entries       map[string]interface{} `json:"entries"`
+}
+
+// LookupCSVOptions controls how a CSV file is imported into a lookup table
+type LookupCSVOptions struct {
+	KeyColumn   string // Column holding the keys (default: the first one)
+	ValueColumn string // Column holding the values (default: the second one, or all others as an object when there are more)
+	NoHeader    bool   // The first row is data; columns are then referred to by number, starting at 1
+	Merge       bool   // Add to the existing entries instead of replacing them
+	Description string // Description of a table created by the import
+}
+
+// InitLookupTableStore prepares the lookup tables collection
+func InitLookupTableStore() error {
+	lookupTablesCollection = database.Collection("lookup_tables")
+	return nil
+}
+
+// normalizeLookupKey returns the form of a key used for matching
+func normalizeLookupKey(key string, caseSensitive bool) string {
+	key = strings.TrimSpace(key)
+	if !caseSensitive {
+		key = strings.ToLower(key)
+	}
+	return key
+}
+
+// validateLookupTableInput checks the name and entries of a lookup table
+func validateLookupTableInput(input LookupTableInput) error {
+	if !credentialNameRegex.MatchString(input.Name) {
+		return errors.New("invalid lookup table name: must start with a letter and contain only letters, digits, '_' or '-'")
+	}
+
+	seen := make(map[string]string, len(input.Entries))
+	for key := range input.Entries {
+		normalized := normalizeLookupKey(key, input.CaseSensitive)
+		if normalized == "" {
+			return errors.New("invalid lookup table entries: keys must not be empty")
+		}
+		if other, ok := seen[normalized]; ok {
+			return fmt.Errorf("invalid lookup table entries: keys %q and %q are the same", other, key)
+		}
+		seen[normalized] = key
+	}
+	return nil
+}
+
+// lookupEntries converts entries to their stored form, sorted by key
+func lookupEntries(entries map[string]interface{}) []LookupEntry {
+	list := make([]LookupEntry, 0, len(entries))
+	for key, value := range entries {
+		list = append(list, LookupEntry{Key: strings.TrimSpace(key), Value: value})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Key < list[j].Key })
+	return list
+}
+
+// toLookupTable converts a stored lookup table to its API view
+func toLookupTable(doc LookupTableDocument) LookupTable {
+	entries := make(map[string]interface{}, len(doc.Entries))
+	for _, entry := range doc.Entries {
+		entries[entry.Key] = normalizeMongoDocument(entry.Value)
+	}
+	return LookupTable{
+		Name:          doc.Name,
+		Description:   doc.Description,
+		CaseSensitive: doc.CaseSensitive,
+		Entries:       entries,
+		CreatedAt:     doc.CreatedAt,
+		UpdatedAt:     doc.UpdatedAt,
+	}
+}
+
+// SaveLookupTableToDB stores a lookup table. When create is true the table
+// must not exist yet; otherwise it must exist and its entries are replaced.
+func SaveLookupTableToDB(input LookupTableInput, create bool) (LookupTable, error) {
+	if err := validateLookupTableInput(input); err != nil {
+		return LookupTable{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	now := time.Now()
+	doc := LookupTableDocument{
+		Name:          input.Name,
+		Description:   input.Description,
+		CaseSensitive: input.CaseSensitive,
+		Entries:       lookupEntries(input.Entries),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	defer invalidateLookupTable(input.Name)
+
+	if create {
+		if _, err := lookupTablesCollection.InsertOne(ctx, doc); err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return LookupTable{}, fmt.Errorf("lookup table %s already exists", input.Name)
+			}
+			return LookupTable{}, fmt.Errorf("failed to save lookup table: %w", err)
+		}
+		return toLookupTable(doc), nil
+	}
+
+	update := bson.M{"$set": bson.M{
+		"description":   doc.Description,
+		"caseSensitive": doc.CaseSensitive,
+		"entries":       doc.Entries,
+		"updatedAt":     now,
+	}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	var updated LookupTableDocument
+	if err := lookupTablesCollection.FindOneAndUpdate(ctx, bson.M{"_id": input.Name}, update, opts).Decode(&updated); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return LookupTable{}, ErrLookupTableNotFound
+		}
+		return LookupTable{}, fmt.Errorf("failed to update lookup table: %w", err)
+	}
+	return toLookupTable(updated), nil
+}
+
+// UpdateLookupEntriesInDB sets and removes individual entries of a lookup
+// table. The entries are written back only if the table has not changed since
+// they were read, so that concurrent updates are not lost; otherwise the
+// update starts over from the new entries.
+func UpdateLookupEntriesInDB(name string, set map[string]interface{}, remove []string) (LookupTable, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	defer invalidateLookupTable(name)
+
+	for attempt := 0; attempt < lookupUpdateAttempts; attempt++ {
+		doc, err := getLookupTableDocument(ctx, name)
+		if err != nil {
+			return LookupTable{}, err
+		}
+		table := toLookupTable(doc)
+
+		// Keys are matched the way lookups match them, so "israel" replaces "Israel"
+		byKey := make(map[string]string, len(table.Entries))
+		for key := range table.Entries {
+			byKey[normalizeLookupKey(key, table.CaseSensitive)] = key
+		}
+		for _, key := range remove {
+			if existing, ok := byKey[normalizeLookupKey(key, table.CaseSensitive)]; ok {
+				delete(table.Entries, existing)
+			}
+		}
+		for key, value := range set {
+			if existing, ok := byKey[normalizeLookupKey(key, table.CaseSensitive)]; ok {
+				delete(table.Entries, existing)
+			}
+			table.Entries[key] = value
+		}
+		input := LookupTableInput{Name: name, CaseSensitive: table.CaseSensitive, Entries: table.Entries}
+		if err := validateLookupTableInput(input); err != nil {
+			return LookupTable{}, err
+		}
+
+		// Mongo keeps milliseconds; the new updatedAt must differ from the one read
+		now := time.Now().Truncate(time.Millisecond)
+		if !now.After(doc.UpdatedAt) {
+			now = doc.UpdatedAt.Add(time.Millisecond)
+		}
+		filter := bson.M{"_id": name, "updatedAt": doc.UpdatedAt}
+		update := bson.M{"$set": bson.M{"entries": lookupEntries(table.Entries), "updatedAt": now}}
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+		var updated LookupTableDocument
+		err = lookupTablesCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&updated)
+		if err == mongo.ErrNoDocuments {
+			// Changed (or deleted) since it was read
+			continue
+		}
+		if err != nil {
+			return LookupTable{}, fmt.Errorf("failed to update lookup table: %w", err)
+		}
+		return toLookupTable(updated), nil
+	}
+	return LookupTable{}, fmt.Errorf("failed to update lookup table %s: it kept changing during the update", name)
+}
+
+// ImportLookupCSV fills a lookup table from a CSV file, creating the table
+// when it does not exist
+func ImportLookupCSV(name string, r io.Reader, opts LookupCSVOptions) (LookupTable, int, error) {
+	entries, err := parseLookupCSV(r, opts)
+	if err != nil {
+		return LookupTable{}, 0, err
+	}
+
+	input := LookupTableInput{Name: name, Description: opts.Description, Entries: entries}
+	existing, err := GetLookupTableFromDB(name)
+	switch {
+	case errors.Is(err, ErrLookupTableNotFound):
+		table, err := SaveLookupTableToDB(input, true)
+		return table, len(entries), err
+	case err != nil:
+		return LookupTable{}, 0, err
+	}
+
+	input.CaseSensitive = existing.CaseSensitive
+	if input.Description == "" {
+		input.Description = existing.Description
+	}
+	if opts.Merge {
+		table, err := UpdateLookupEntriesInDB(name, entries, nil)
+		return table, len(entries), err
+	}
+	table, err := SaveLookupTableToDB(input, false)
+	return table, len(entries), err
+}
+
+// parseLookupCSV reads the entries of a CSV file. Empty keys are skipped.
+func parseLookupCSV(r io.Reader, opts LookupCSVOptions) (map[string]interface{}, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("invalid CSV: the file is empty")
+	}
+
+	var header []string
+	if opts.NoHeader {
+		for i := range rows[0] {
+			header = append(header, fmt.Sprint(i+1))
+		}
+	} else {
+		header = rows[0]
+		rows = rows[1:]
+		if len(header) > 0 {
+			header[0] = strings.TrimPrefix(header[0], "\ufeff")
+		}
+	}
+	if len(header) < 2 {
+		return nil, errors.New("invalid CSV: at least two columns are required")
+	}
+
+	column := func(name string, fallback int) (int, error) {
+		if name == "" {
+			return fallback, nil
+		}
+		for i, h := range header {
+			if strings.EqualFold(strings.TrimSpace(h), name) {
+				return i, nil
+			}
+		}
+		return 0, fmt.Errorf("invalid CSV: column %s not found", name)
+	}
+	keyIndex, err := column(opts.KeyColumn, 0)
+	if err != nil {
+		return nil, err
+	}
+	valueIndex := -1
+	if opts.ValueColumn != "" || len(header) == 2 {
+		fallback := 1
+		if keyIndex == 1 {
+			fallback = 0
+		}
+		if valueIndex, err = column(opts.ValueColumn, fallback); err != nil {
+			return nil, err
+		}
+	}
+
+	entries := make(map[string]interface{}, len(rows))
+	for _, row := range rows {
+		if keyIndex >= len(row) || strings.TrimSpace(row[keyIndex]) == "" {
+			continue
+		}
+		key := strings.TrimSpace(row[keyIndex])
+		if valueIndex >= 0 {
+			value := ""
+			if valueIndex < len(row) {
+				value = row[valueIndex]
+			}
+			entries[key] = value
+			continue
+		}
+
+		// All other columns become an object
+		value := make(map[string]interface{}, len(header)-1)
+		for i, h := range header {
+			if i == keyIndex {
+				continue
+			}
+			cell := ""
+			if i < len(row) {
+				cell = row[i]
+			}
+			value[strings.TrimSpace(h)] = cell
+		}
+		entries[key] = value
+	}
+	return entries, nil
+}
+
+// getLookupTableDocument loads the stored form of a lookup table
+func getLookupTableDocument(ctx context.Context, name string) (LookupTableDocument, error) {
+	var doc LookupTableDocument
+	if lookupTablesCollection == nil {
+		return doc, errors.New("lookup table store is not initialized")
+	}
+	if err := lookupTablesCollection.FindOne(ctx, bson.M{"_id": name}).Decode(&doc); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return doc, ErrLookupTableNotFound
+		}
+		return doc, fmt.Errorf("failed to get lookup table: %w", err)
+	}
+	return doc, nil
+}
+
+// GetLookupTableFromDB returns a lookup table with all its entries
+func GetLookupTableFromDB(name string) (LookupTable, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	doc, err := getLookupTableDocument(ctx, name)
+	if err != nil {
+		return LookupTable{}, err
+	}
+	return toLookupTable(doc), nil
+}
+
+// ListLookupTablesFromDB returns the metadata of all lookup tables
+func ListLookupTablesFromDB() ([]LookupTableInfo, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$project", Value: bson.M{
+			"description":   1,
+			"caseSensitive": 1,
+			"entryCount":    bson.M{"$size": "$entries"},
+			"createdAt":     1,
+			"updatedAt":     1,
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "_id", Value: 1}}}},
+	}
+	cursor, err := lookupTablesCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list lookup tables: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	infos := []LookupTableInfo{}
+	if err := cursor.All(ctx, &infos); err != nil {
+		return nil, fmt.Errorf("failed to decode lookup tables: %w", err)
+	}
+	return infos, nil
+}
+
+// DeleteLookupTableFromDB deletes a lookup table
+func DeleteLookupTableFromDB(name string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	result, err := lookupTablesCollection.DeleteOne(ctx, bson.M{"_id": name})
+	if err != nil {
+		return fmt.Errorf("failed to delete lookup table: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrLookupTableNotFound
+	}
+
+	invalidateLookupTable(name)
+	return nil
+}
+
+// invalidateLookupTable drops the cached copy of a lookup table
+func invalidateLookupTable(name string) {
+	lookupTables.Lock()
+	defer lookupTables.Unlock()
+	delete(lookupTables.byName, name)
+	lookupTables.invalidated++
+}
+
+// getCachedLookupTable returns a lookup table indexed for lookups, loading it
+// when it is not cached or the cached copy is too old. The table is read
+// without holding the cache lock, so a slow read does not hold up other tables.
+func getCachedLookupTable(name string) (*cachedLookupTable, error) {
+	lookupTables.Lock()
+	if table, ok := lookupTables.byName[name]; ok && time.Since(table.loadedAt) < lookupTableCacheTTL {
+		lookupTables.Unlock()
+		return table, nil
+	}
+	invalidated := lookupTables.invalidated
+	lookupTables.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	doc, err := getLookupTableDocument(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &cachedLookupTable{
+		caseSensitive: doc.CaseSensitive,
+		values:        make(map[string]interface{}, len(doc.Entries)),
+		loadedAt:      time.Now(),
+	}
+	for _, entry := range doc.Entries {
+		table.values[normalizeLookupKey(entry.Key, doc.CaseSensitive)] = normalizeMongoDocument(entry.Value)
+	}
+
+	lookupTables.Lock()
+	defer lookupTables.Unlock()
+	// A table changed while it was read is used once but not cached
+	if lookupTables.invalidated == invalidated {
+		lookupTables.byName[name] = table
+	}
+	return table, nil
+}
+
+// lookupValue finds the value of a key in a lookup table. With column set, the
+// value must be an object and that column of it is returned.
+func lookupValue(table, key, column string) (interface{}, bool, error) {
+	cached, err := getCachedLookupTable(table)
+	if err != nil {
+		return nil, false, fmt.Errorf("lookup table %s: %w", table, err)
+	}
+
+	value, ok := cached.values[normalizeLookupKey(key, cached.caseSensitive)]
+	if !ok || column == "" {
+		return value, ok, nil
+	}
+	obj, isObject := value.(map[string]interface{})
+	if !isObject {
+		return nil, false, fmt.Errorf("lookup table %s: values have no column %s", table, column)
+	}
+	value, ok = obj[column]
+	return value, ok, nil
+}
+
+// executeLookup translates values through a lookup table. With key set it
+// looks up a single value; otherwise it reads field from every item of
+// sourceArray and stores the translation in target (field by default). Keys
+// that are not found get default, or with onMissing set to "skip" or "fail"
+// drop the item or fail the node.
+func (we *WorkflowEngine) executeLookup(node *Node) error {
+	table, _ := we.resolveTemplateValue(node.Parameters["table"]).(string)
+	if table == "" {
+		return fmt.Errorf("'table' is required")
+	}
+	column, _ := node.Parameters["column"].(string)
+	defaultValue := we.resolveTemplateRaw(node.Parameters["default"])
+	onMissing, _ := node.Parameters["onMissing"].(string)
+	switch onMissing {
+	case "", "default", "skip", "fail":
+	default:
+		return fmt.Errorf("invalid onMissing %q: expected default, skip or fail", onMissing)
+	}
+
+	if rawKey, ok := node.Parameters["key"]; ok {
+		key := valueString(we.resolveTemplateRaw(rawKey))
+		value, found, err := lookupValue(table, key, column)
+		if err != nil {
+			return err
+		}
+		if !found {
+			if onMissing == "fail" {
+				return fmt.Errorf("key %q not found in lookup table %s", key, table)
+			}
+			value = defaultValue
+		}
+		we.context.NodeResults[node.ID] = map[string]interface{}{
+			"key":   key,
+			"value": value,
+			"found": found,
+		}
+		return nil
+	}
+
+	field, _ := node.Parameters["field"].(string)
+	if field == "" {
+		return fmt.Errorf("either 'key' or 'field' is required")
+	}
+	target, _ := node.Parameters["target"].(string)
+	if target == "" {
+		target = field
+	}
+	items, _, err := we.transformInput(node.Parameters["sourceArray"])
+	if err != nil {
+		return err
+	}
+
+	output := []interface{}{}
+	missing := []interface{}{}
+	for i, item := range items {
+		key := valueString(lookupPath(item, field))
+		value, found, err := lookupValue(table, key, column)
+		if err != nil {
+			return err
+		}
+		if !found {
+			missing = append(missing, key)
+			switch onMissing {
+			case "fail":
+				return fmt.Errorf("item %d: key %q not found in lookup table %s", i, key, table)
+			case "skip":
+				continue
+			}
+			value = defaultValue
+		}
+
+		result := map[string]interface{}{}
+		if obj, ok := item.(map[string]interface{}); ok {
+			result = copyItem(obj)
+		}
+		setPath(result, target, value)
+		output = append(output, result)
+	}
+
+	we.context.NodeResults[node.ID] = map[string]interface{}{
+		"output":  output,
+		"count":   len(output),
+		"missing": missing,
+	}
+	return nil
+}
@@ -15,6 +15,42 @@ func main() {
 	}
 	defer CloseMongoDB()
 
+	// Initialize the encrypted credential store
+	if err := InitCredentialStore(); err != nil {
+		log.Fatalf("Failed to initialize credential store: %v", err)
+	}
+
+	// Prepare the store of reusable field mappings
+	if err := InitFieldMappingStore(); err != nil {
+		log.Fatalf("Failed to initialize field mapping store: %v", err)
+	}
+
+	// Prepare the store of lookup tables used by templates
+	if err := InitLookupTableStore(); err != nil {
+		log.Fatalf("Failed to initialize lookup table store: %v", err)
+	}
+
+	// Restrict which hosts the connectors may reach
+	if err := InitOutboundPolicy(); err != nil {
+		log.Fatalf("Failed to initialize outbound policy: %v", err)
+	}
+
+	// Load rate limits for hosts and credentials
+	if err := InitRateLimits(); err != nil {
+		log.Fatalf("Failed to initialize rate limits: %v", err)
+	}
+
+	// Load circuit breaker settings for downstream systems
+	if err := InitCircuitBreakers(); err != nil {
+		log.Fatalf("Failed to initialize circuit breakers: %v", err)
+	}
+
+	// Log out pooled SAP Service Layer sessions on shutdown
+	defer CloseSAPSessions()
+	defer CloseHTTPTransports()
+	defer CloseSQLPools()
+	defer CloseMongoClients()
+
 	// Set Gin mode
 	ginMode := gin.DebugMode
 	gin.SetMode(ginMode)
@@ -1,5 +1,7 @@
 package main
 
+import "time"
+
 // Workflow represents the entire workflow structure, including metadata, nodes, connections, and configuration.
 type Workflow struct {
 	Workflow    WorkflowInfo           `json:"workflow"`    // Metadata about the workflow.
@@ -10,8 +12,10 @@ type Workflow struct {
 
 // WorkflowInfo contains metadata about the workflow.
 type WorkflowInfo struct {
-	Name        string `json:"name"`        // Name of the workflow.
-	Description string `json:"description"` // Description of the workflow.
+	Name        string   `json:"name"`             // Name of the workflow.
+	Description string   `json:"description"`      // Description of the workflow.
+	Tags        []string `json:"tags,omitempty"`   // Tags used to group and filter workflows.
+	Active      *bool    `json:"active,omitempty"` // Whether the workflow is active (defaults to true).
 }
 
 // Node represents a single node in the workflow.
@@ -52,8 +56,12 @@ type NumberCondition struct {
 
 // ExecutionContext holds the runtime state of the workflow execution.
 type ExecutionContext struct {
-	NodeResults map[string]map[string]interface{} // Results of each node execution.
-	Config      map[string]interface{}            // Runtime configuration.
+	NodeResults     map[string]map[string]interface{} // Results of each node execution.
+	Config          map[string]interface{}            // Runtime configuration.
+	Credentials     map[string]map[string]interface{} // Decrypted credentials referenced by the executed nodes.
+	CredentialTypes map[string]string                 // Type of each loaded credential.
+	RateLimitWait   time.Duration                     // Time the current node waited for rate limits.
+	Item            interface{}                       // Item being processed by a transform node ($item in templates).
 }
 
 // WorkflowEngine is responsible for executing the workflow.
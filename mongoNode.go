@@ -0,0 +1,534 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	// mongoDefaultLimit caps find results unless the node sets a limit
+	mongoDefaultLimit = 1000
+	// mongoDefaultTimeout bounds an operation unless the node sets a timeout
+	mongoDefaultTimeout = 60 * time.Second
+)
+
+// mongoClients holds the clients of mongodb credentials. Each client keeps its
+// own connection pool, shared by all executions.
+var mongoClients = struct {
+	sync.Mutex
+	byName map[string]*mongo.Client
+}{byName: make(map[string]*mongo.Client)}
+
+// getMongoClient returns the client of a mongodb credential, creating it on
+// first use. Connections go through the outbound policy.
+func getMongoClient(name string, data map[string]interface{}) (*mongo.Client, error) {
+	mongoClients.Lock()
+	defer mongoClients.Unlock()
+
+	if client, ok := mongoClients.byName[name]; ok {
+		return client, nil
+	}
+
+	uri, _ := data["uri"].(string)
+	dialer := contextDialerFunc(policyDialContext(&net.Dialer{Timeout: 15 * time.Second, KeepAlive: 30 * time.Second}))
+	clientOptions := options.Client().
+		ApplyURI(uri).
+		SetDialer(dialer).
+		SetServerSelectionTimeout(15 * time.Second)
+	if err := clientOptions.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid uri of mongodb credential %s: %w", name, err)
+	}
+
+	// Connect does not dial; servers are contacted on the first operation
+	client, err := mongo.Connect(context.Background(), clientOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create MongoDB client for credential %s: %w", name, err)
+	}
+	mongoClients.byName[name] = client
+	return client, nil
+}
+
+// invalidateMongoClient disconnects the client of a credential so changed values apply.
+func invalidateMongoClient(name string) {
+	mongoClients.Lock()
+	client, ok := mongoClients.byName[name]
+	delete(mongoClients.byName, name)
+	mongoClients.Unlock()
+
+	if ok {
+		go disconnectMongoClient(name, client)
+	}
+}
+
+// CloseMongoClients disconnects the clients of all mongodb credentials; called on shutdown.
+func CloseMongoClients() {
+	mongoClients.Lock()
+	clients := mongoClients.byName
+	mongoClients.byName = make(map[string]*mongo.Client)
+	mongoClients.Unlock()
+
+	for name, client := range clients {
+		disconnectMongoClient(name, client)
+	}
+}
+
+// disconnectMongoClient closes the connections of a client.
+func disconnectMongoClient(name string, client *mongo.Client) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Disconnect(ctx); err != nil {
+		log.Printf("Failed to disconnect MongoDB client of credential %s: %v", name, err)
+	}
+}
+
+// executeMongoDB runs the mongodb node against a collection. The connection is
+// a mongodb credential (uri and optional database); the service's own server
+// is never used, so workflows cannot reach the engine's data.
+//
+// Operations: find (filter, projection, sort, skip, limit), aggregate
+// (pipeline), insertMany (documents), updateMany (filter, update, upsert) and
+// deleteMany (filter). Templates in filters and documents keep the type of
+// their value, and Extended JSON such as {"$oid": "..."} or {"$date": "..."}
+// is accepted in the node's parameters. Template values are only ever bound as
+// values: they are not parsed as Extended JSON and may not contain operators.
+func (we *WorkflowEngine) executeMongoDB(node *Node) error {
+	resolvedParams := we.resolveTemplateValue(node.Parameters).(map[string]interface{})
+
+	credentialName, _ := resolvedParams["credential"].(string)
+	if credentialName == "" {
+		return fmt.Errorf("mongodb node requires a mongodb 'credential'")
+	}
+	if credentialType := we.context.CredentialTypes[credentialName]; credentialType != "mongodb" {
+		return fmt.Errorf("credential %s of type %s is not a mongodb credential", credentialName, credentialType)
+	}
+	data := we.context.Credentials[credentialName]
+	client, err := getMongoClient(credentialName, data)
+	if err != nil {
+		return err
+	}
+	databaseName, _ := resolvedParams["database"].(string)
+	if databaseName == "" {
+		databaseName, _ = data["database"].(string)
+	}
+
+	collectionName, _ := resolvedParams["collection"].(string)
+	if databaseName == "" || collectionName == "" {
+		return fmt.Errorf("mongodb node requires 'database' and 'collection'")
+	}
+	coll := client.Database(databaseName).Collection(collectionName)
+
+	timeout := mongoDefaultTimeout
+	if seconds, err := toNumber(resolvedParams["timeout"]); err == nil && seconds > 0 {
+		timeout = time.Duration(seconds * float64(time.Second))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	filter, err := we.mongoDocument(node.Parameters["filter"])
+	if err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	if filter == nil {
+		filter = bson.M{}
+	}
+
+	operation, _ := resolvedParams["operation"].(string)
+	var result map[string]interface{}
+	switch operation {
+	case "find":
+		result, err = we.mongoFind(ctx, coll, filter, node.Parameters, resolvedParams)
+	case "aggregate":
+		result, err = we.mongoAggregate(ctx, coll, node.Parameters["pipeline"])
+	case "insertMany":
+		result, err = we.mongoInsertMany(ctx, coll, node.Parameters["documents"])
+	case "updateMany":
+		result, err = we.mongoUpdateMany(ctx, coll, filter, node.Parameters["update"], resolvedParams)
+	case "deleteMany":
+		result, err = mongoDeleteMany(ctx, coll, filter, resolvedParams)
+	default:
+		return fmt.Errorf("unsupported mongodb operation: %s", operation)
+	}
+	if err != nil {
+		return err
+	}
+
+	we.context.NodeResults[node.ID] = result
+	return nil
+}
+
+// mongoFind returns the documents matching filter.
+func (we *WorkflowEngine) mongoFind(ctx context.Context, coll *mongo.Collection, filter interface{}, rawParams, params map[string]interface{}) (map[string]interface{}, error) {
+	findOptions := options.Find().SetLimit(mongoDefaultLimit)
+	if limit, err := toNumber(params["limit"]); err == nil && limit > 0 {
+		findOptions.SetLimit(int64(limit))
+	}
+	if skip, err := toNumber(params["skip"]); err == nil && skip > 0 {
+		findOptions.SetSkip(int64(skip))
+	}
+	if rawParams["projection"] != nil {
+		projection, err := we.mongoDocument(rawParams["projection"])
+		if err != nil {
+			return nil, fmt.Errorf("invalid projection: %w", err)
+		}
+		findOptions.SetProjection(projection)
+	}
+	if params["sort"] != nil {
+		sort, err := mongoSort(params["sort"])
+		if err != nil {
+			return nil, err
+		}
+		findOptions.SetSort(sort)
+	}
+
+	cursor, err := coll.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("find failed: %w", err)
+	}
+	return readMongoCursor(ctx, cursor)
+}
+
+// mongoAggregate runs a pipeline and returns its documents.
+func (we *WorkflowEngine) mongoAggregate(ctx context.Context, coll *mongo.Collection, rawPipeline interface{}) (map[string]interface{}, error) {
+	pipeline, err := we.mongoDocument(rawPipeline)
+	if err != nil {
+		return nil, fmt.Errorf("invalid pipeline: %w", err)
+	}
+	if _, ok := pipeline.(bson.A); !ok {
+		return nil, fmt.Errorf("aggregate requires a 'pipeline' array")
+	}
+
+	cursor, err := coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate failed: %w", err)
+	}
+	return readMongoCursor(ctx, cursor)
+}
+
+// mongoInsertMany inserts an array of documents.
+func (we *WorkflowEngine) mongoInsertMany(ctx context.Context, coll *mongo.Collection, rawDocuments interface{}) (map[string]interface{}, error) {
+	resolved, err := we.mongoDocument(rawDocuments)
+	if err != nil {
+		return nil, fmt.Errorf("invalid documents: %w", err)
+	}
+	if rawDocuments == nil {
+		return nil, fmt.Errorf("insertMany requires a 'documents' array")
+	}
+	var documents []interface{}
+	switch v := resolved.(type) {
+	case bson.A:
+		documents = v
+	case bson.M:
+		documents = []interface{}{v}
+	}
+	if len(documents) == 0 {
+		return nil, fmt.Errorf("insertMany requires a 'documents' array")
+	}
+
+	res, err := coll.InsertMany(ctx, documents)
+	if err != nil {
+		return nil, fmt.Errorf("insertMany failed: %w", err)
+	}
+	return map[string]interface{}{
+		"insertedCount": len(res.InsertedIDs),
+		"insertedIds":   convertMongoValue(res.InsertedIDs),
+	}, nil
+}
+
+// mongoUpdateMany applies an update document or pipeline to the matching documents.
+func (we *WorkflowEngine) mongoUpdateMany(ctx context.Context, coll *mongo.Collection, filter, rawUpdate interface{}, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := checkMongoFilter(filter, params); err != nil {
+		return nil, err
+	}
+	if rawUpdate == nil {
+		return nil, fmt.Errorf("updateMany requires 'update'")
+	}
+	update, err := we.mongoDocument(rawUpdate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid update: %w", err)
+	}
+	upsert, _ := toBoolean(params["upsert"])
+
+	res, err := coll.UpdateMany(ctx, filter, update, options.Update().SetUpsert(upsert))
+	if err != nil {
+		return nil, fmt.Errorf("updateMany failed: %w", err)
+	}
+	return map[string]interface{}{
+		"matchedCount":  res.MatchedCount,
+		"modifiedCount": res.ModifiedCount,
+		"upsertedCount": res.UpsertedCount,
+		"upsertedId":    convertMongoValue(res.UpsertedID),
+	}, nil
+}
+
+// mongoDeleteMany deletes the matching documents.
+func mongoDeleteMany(ctx context.Context, coll *mongo.Collection, filter interface{}, params map[string]interface{}) (map[string]interface{}, error) {
+	if err := checkMongoFilter(filter, params); err != nil {
+		return nil, err
+	}
+
+	res, err := coll.DeleteMany(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("deleteMany failed: %w", err)
+	}
+	return map[string]interface{}{
+		"deletedCount": res.DeletedCount,
+	}, nil
+}
+
+// checkMongoFilter refuses to update or delete a whole collection unless
+// allowEmptyFilter is set, since an unresolved template can leave the filter empty.
+func checkMongoFilter(filter interface{}, params map[string]interface{}) error {
+	if doc, ok := filter.(bson.M); ok && len(doc) > 0 {
+		return nil
+	}
+	if allow, _ := toBoolean(params["allowEmptyFilter"]); allow {
+		return nil
+	}
+	return fmt.Errorf("an empty filter matches every document; set allowEmptyFilter to confirm")
+}
+
+// mongoDocument resolves templates in a filter, document or pipeline and
+// converts it to BSON. Objects may use Extended JSON; a string must be JSON
+// with its templates inside quoted strings. nil stays nil.
+func (we *WorkflowEngine) mongoDocument(raw interface{}) (interface{}, error) {
+	if raw == nil {
+		return nil, nil
+	}
+
+	// A string is parsed before its templates are resolved, so that resolved
+	// text can never add keys or operators to the document
+	if str, ok := raw.(string); ok {
+		if err := json.Unmarshal([]byte(str), &raw); err != nil {
+			return nil, fmt.Errorf("a document given as a string must be JSON with templates inside quoted strings: %w", err)
+		}
+	}
+	bound, err := we.bindMongoTemplates(raw)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(bound)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wrapped so that arrays and objects parse alike
+	var wrapper bson.M
+	if err := bson.UnmarshalExtJSON([]byte(`{"v":`+string(data)+`}`), false, &wrapper); err != nil {
+		return nil, err
+	}
+	return normalizeMongoDocument(wrapper["v"]), nil
+}
+
+// bindMongoTemplates resolves the templates of a document, keeping the type of
+// every lone {{expression}}. Objects that come from data may not have keys
+// starting with $, so that a value such as {"$ne": null} cannot become an
+// operator.
+func (we *WorkflowEngine) bindMongoTemplates(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		bound := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			b, err := we.bindMongoTemplates(elem)
+			if err != nil {
+				return nil, err
+			}
+			bound[key] = b
+		}
+		return bound, nil
+	case []interface{}:
+		bound := make([]interface{}, len(v))
+		for i, elem := range v {
+			b, err := we.bindMongoTemplates(elem)
+			if err != nil {
+				return nil, err
+			}
+			bound[i] = b
+		}
+		return bound, nil
+	case string:
+		if !strings.Contains(v, "{{") {
+			return v, nil
+		}
+		resolved := we.resolveTemplateRaw(v)
+		if err := checkMongoData(resolved); err != nil {
+			return nil, fmt.Errorf("value of %s: %w", v, err)
+		}
+		return resolved, nil
+	}
+	return value, nil
+}
+
+// checkMongoData rejects operator keys in a value that comes from data.
+func checkMongoData(value interface{}) error {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, elem := range v {
+			if strings.HasPrefix(key, "$") {
+				return fmt.Errorf("key %s is not allowed in a template value; operators must be written in the node", key)
+			}
+			if err := checkMongoData(elem); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, elem := range v {
+			if err := checkMongoData(elem); err != nil {
+				return err
+			}
+		}
+	case []map[string]interface{}:
+		for _, elem := range v {
+			if err := checkMongoData(elem); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// normalizeMongoDocument turns the documents decoded from Extended JSON into
+// bson.M and bson.A so they can be inspected.
+func normalizeMongoDocument(value interface{}) interface{} {
+	switch v := value.(type) {
+	case bson.D:
+		doc := make(bson.M, len(v))
+		for _, elem := range v {
+			doc[elem.Key] = normalizeMongoDocument(elem.Value)
+		}
+		return doc
+	case bson.M:
+		for key, elem := range v {
+			v[key] = normalizeMongoDocument(elem)
+		}
+		return v
+	case bson.A:
+		for i, elem := range v {
+			v[i] = normalizeMongoDocument(elem)
+		}
+		return v
+	}
+	return value
+}
+
+// mongoSort builds a sort document from "name,-createdAt", an object such as
+// {"name": 1}, or an array of such objects when the order of several keys matters.
+func mongoSort(value interface{}) (bson.D, error) {
+	var sort bson.D
+	addObject := func(obj map[string]interface{}) error {
+		for key, direction := range obj {
+			n, err := toNumber(direction)
+			if err != nil || (n != 1 && n != -1) {
+				return fmt.Errorf("invalid sort direction for %s: expected 1 or -1", key)
+			}
+			sort = append(sort, bson.E{Key: key, Value: int(n)})
+		}
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		for _, field := range stringList(v) {
+			if name, ok := strings.CutPrefix(field, "-"); ok {
+				sort = append(sort, bson.E{Key: name, Value: -1})
+			} else {
+				sort = append(sort, bson.E{Key: strings.TrimPrefix(field, "+"), Value: 1})
+			}
+		}
+	case map[string]interface{}:
+		if len(v) > 1 {
+			return nil, fmt.Errorf("sort object with several keys has no defined order; use an array or \"a,-b\"")
+		}
+		if err := addObject(v); err != nil {
+			return nil, err
+		}
+	case []interface{}:
+		for _, item := range v {
+			obj, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("sort array items must be objects")
+			}
+			if err := addObject(obj); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("invalid sort")
+	}
+	return sort, nil
+}
+
+// readMongoCursor reads all documents of a cursor.
+func readMongoCursor(ctx context.Context, cursor *mongo.Cursor) (map[string]interface{}, error) {
+	defer cursor.Close(ctx)
+
+	documents := []interface{}{}
+	for cursor.Next(ctx) {
+		var doc bson.D
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("failed to decode document: %w", err)
+		}
+		documents = append(documents, convertMongoValue(doc))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read documents: %w", err)
+	}
+
+	return map[string]interface{}{
+		"documents": documents,
+		"count":     len(documents),
+	}, nil
+}
+
+// convertMongoValue turns BSON values into JSON friendly ones: ObjectIDs as
+// hex strings, dates as RFC 3339, decimals as strings and binary as base64.
+func convertMongoValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case bson.D:
+		doc := make(map[string]interface{}, len(v))
+		for _, elem := range v {
+			doc[elem.Key] = convertMongoValue(elem.Value)
+		}
+		return doc
+	case bson.M:
+		doc := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			doc[key] = convertMongoValue(elem)
+		}
+		return doc
+	case bson.A:
+		return convertMongoValue([]interface{}(v))
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, elem := range v {
+			list[i] = convertMongoValue(elem)
+		}
+		return list
+	case primitive.ObjectID:
+		return v.Hex()
+	case primitive.DateTime:
+		return v.Time().UTC().Format(time.RFC3339Nano)
+	case primitive.Timestamp:
+		return time.Unix(int64(v.T), 0).UTC().Format(time.RFC3339)
+	case primitive.Decimal128:
+		return v.String()
+	case primitive.Binary:
+		return base64.StdEncoding.EncodeToString(v.Data)
+	case primitive.Regex:
+		return v.String()
+	case int32:
+		return int64(v)
+	}
+	return value
+}
@@ -0,0 +1,304 @@
+package main
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// oauth2ExpirySkew refreshes tokens slightly before they expire
+	oauth2ExpirySkew = 60 * time.Second
+	// oauth2DefaultLifetime is assumed when the token endpoint does not return expires_in
+	oauth2DefaultLifetime = time.Hour
+)
+
+// oauth2Token is an access token obtained for a credential.
+type oauth2Token struct {
+	AccessToken string
+	TokenType   string
+	InstanceURL string
+	Expiry      time.Time
+}
+
+// valid reports whether the token can still be used.
+func (t *oauth2Token) valid() bool {
+	return t != nil && t.AccessToken != "" && time.Now().Add(oauth2ExpirySkew).Before(t.Expiry)
+}
+
+// oauth2TokenCache holds tokens per credential name, shared by all executions.
+// The mutex only guards the maps; fetches hold the lock of their credential.
+var oauth2TokenCache = struct {
+	sync.Mutex
+	tokens  map[string]*oauth2Token
+	fetches map[string]*sync.Mutex
+}{tokens: make(map[string]*oauth2Token), fetches: make(map[string]*sync.Mutex)}
+
+// isOAuth2CredentialType reports whether a credential type obtains tokens from a token endpoint.
+func isOAuth2CredentialType(credentialType string) bool {
+	return strings.HasPrefix(credentialType, "oauth2")
+}
+
+// invalidateOAuth2Token drops the cached token of a credential.
+func invalidateOAuth2Token(name string) {
+	oauth2TokenCache.Lock()
+	defer oauth2TokenCache.Unlock()
+	delete(oauth2TokenCache.tokens, name)
+}
+
+// getOAuth2Token returns a valid token for a credential, fetching a new one when
+// the cached token is missing, expired or forceRefresh is set.
+func getOAuth2Token(name, credentialType string, data map[string]interface{}, forceRefresh bool) (*oauth2Token, error) {
+	seen := cachedOAuth2Token(name)
+	if !forceRefresh && seen.valid() {
+		return seen, nil
+	}
+
+	// Concurrent executions share one request per credential, without holding
+	// up other credentials while a token endpoint is slow
+	fetch := oauth2FetchLock(name)
+	fetch.Lock()
+	defer fetch.Unlock()
+
+	// Another execution may have fetched a token while this one waited
+	if token := cachedOAuth2Token(name); token.valid() && (!forceRefresh || token != seen) {
+		return token, nil
+	}
+
+	token, err := fetchOAuth2Token(name, credentialType, data)
+
+	oauth2TokenCache.Lock()
+	defer oauth2TokenCache.Unlock()
+	if err != nil {
+		delete(oauth2TokenCache.tokens, name)
+		return nil, err
+	}
+	oauth2TokenCache.tokens[name] = token
+	return token, nil
+}
+
+// cachedOAuth2Token returns the cached token of a credential, or nil.
+func cachedOAuth2Token(name string) *oauth2Token {
+	oauth2TokenCache.Lock()
+	defer oauth2TokenCache.Unlock()
+	return oauth2TokenCache.tokens[name]
+}
+
+// oauth2FetchLock returns the lock that serializes token fetches of a credential.
+func oauth2FetchLock(name string) *sync.Mutex {
+	oauth2TokenCache.Lock()
+	defer oauth2TokenCache.Unlock()
+	lock, ok := oauth2TokenCache.fetches[name]
+	if !ok {
+		lock = &sync.Mutex{}
+		oauth2TokenCache.fetches[name] = lock
+	}
+	return lock
+}
+
+// fetchOAuth2Token requests a new token from the credential's token endpoint.
+func fetchOAuth2Token(name, credentialType string, data map[string]interface{}) (*oauth2Token, error) {
+	str := func(key string) string {
+		value, _ := data[key].(string)
+		return value
+	}
+
+	form := url.Values{}
+	switch credentialType {
+	case "oauth2ClientCredentials":
+		form.Set("grant_type", "client_credentials")
+		form.Set("client_id", str("clientId"))
+		form.Set("client_secret", str("clientSecret"))
+	case "oauth2RefreshToken":
+		form.Set("grant_type", "refresh_token")
+		form.Set("refresh_token", str("refreshToken"))
+		form.Set("client_id", str("clientId"))
+		if secret := str("clientSecret"); secret != "" {
+			form.Set("client_secret", secret)
+		}
+	case "oauth2JwtBearer":
+		assertion, err := buildJWTAssertion(data)
+		if err != nil {
+			return nil, fmt.Errorf("credential %s: %w", name, err)
+		}
+		form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+		form.Set("assertion", assertion)
+	default:
+		return nil, fmt.Errorf("credential %s is not an OAuth2 credential", name)
+	}
+	if scope := str("scope"); scope != "" {
+		form.Set("scope", scope)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, str("tokenUrl"), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request for %s: %w", name, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	client, err := newHTTPClient(httpClientConfigFromCredential(data), name, defaultHTTPTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("credential %s: %w", name, err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request for %s failed: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token response for %s: %w", name, err)
+	}
+
+	var tokenResp struct {
+		AccessToken  string      `json:"access_token"`
+		TokenType    string      `json:"token_type"`
+		ExpiresIn    json.Number `json:"expires_in"`
+		RefreshToken string      `json:"refresh_token"`
+		InstanceURL  string      `json:"instance_url"`
+		Error        string      `json:"error"`
+		ErrorDesc    string      `json:"error_description"`
+	}
+	if err := json.Unmarshal(respBody, &tokenResp); err != nil {
+		return nil, fmt.Errorf("token request for %s returned status %d with an invalid body", name, resp.StatusCode)
+	}
+	if resp.StatusCode >= 400 || tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("token request for %s failed with status %d: %s %s",
+			name, resp.StatusCode, tokenResp.Error, tokenResp.ErrorDesc)
+	}
+
+	lifetime := oauth2DefaultLifetime
+	if seconds, err := tokenResp.ExpiresIn.Int64(); err == nil && seconds > 0 {
+		lifetime = time.Duration(seconds) * time.Second
+	}
+
+	token := &oauth2Token{
+		AccessToken: tokenResp.AccessToken,
+		TokenType:   tokenResp.TokenType,
+		InstanceURL: tokenResp.InstanceURL,
+		Expiry:      time.Now().Add(lifetime),
+	}
+	if token.TokenType == "" || strings.EqualFold(token.TokenType, "bearer") {
+		token.TokenType = "Bearer"
+	}
+	if token.InstanceURL == "" {
+		token.InstanceURL = str("instanceUrl")
+	}
+
+	// Providers that rotate refresh tokens return a new one that must be kept
+	if tokenResp.RefreshToken != "" && credentialType == "oauth2RefreshToken" && tokenResp.RefreshToken != str("refreshToken") {
+		if err := storeRotatedRefreshToken(name, tokenResp.RefreshToken); err != nil {
+			log.Printf("Warning: failed to store rotated refresh token for %s: %v", name, err)
+		}
+	}
+
+	return token, nil
+}
+
+// storeRotatedRefreshToken replaces the refresh token of a stored credential.
+// The stored fields are reloaded rather than taken from the engine, whose copy
+// also holds the access token and instance URL of the current execution.
+func storeRotatedRefreshToken(name, refreshToken string) error {
+	credentialType, data, err := GetCredentialData(name)
+	if err != nil {
+		return err
+	}
+	data["refreshToken"] = refreshToken
+
+	input := CredentialInput{Name: name, Type: credentialType, Data: data}
+	if info, err := GetCredentialInfoFromDB(name); err == nil {
+		input.Description = info.Description
+	}
+	_, err = saveCredential(input, false)
+	return err
+}
+
+// buildJWTAssertion signs an RS256 JWT for the JWT bearer grant (RFC 7523).
+// The client ID is the issuer, the username the subject and the audience
+// defaults to the origin of the token URL.
+func buildJWTAssertion(data map[string]interface{}) (string, error) {
+	str := func(key string) string {
+		value, _ := data[key].(string)
+		return value
+	}
+
+	key, err := parseRSAPrivateKey(str("privateKey"))
+	if err != nil {
+		return "", err
+	}
+
+	audience := str("audience")
+	if audience == "" {
+		tokenURL, err := url.Parse(str("tokenUrl"))
+		if err != nil {
+			return "", fmt.Errorf("invalid tokenUrl: %w", err)
+		}
+		audience = tokenURL.Scheme + "://" + tokenURL.Host
+	}
+
+	now := time.Now()
+	header := map[string]string{"alg": "RS256", "typ": "JWT"}
+	claims := map[string]interface{}{
+		"iss": str("clientId"),
+		"sub": str("username"),
+		"aud": audience,
+		"iat": now.Unix(),
+		"exp": now.Add(3 * time.Minute).Unix(),
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign JWT: %w", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// parseRSAPrivateKey reads a PEM encoded PKCS#1 or PKCS#8 RSA private key.
+func parseRSAPrivateKey(pemData string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(pemData))
+	if block == nil {
+		return nil, errors.New("privateKey is not PEM encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse privateKey: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("privateKey is not an RSA key")
+	}
+	return key, nil
+}
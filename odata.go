@@ -0,0 +1,350 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// defaultODataMaxPages bounds how many nextLinks are followed when no maxPages is set
+const defaultODataMaxPages = 50
+
+// odataFilterOperators maps the structured filter operators to OData syntax.
+// Comparison operators render as "field op value", functions as "fn(field,value)".
+var odataFilterOperators = map[string]string{
+	"eq":          "eq",
+	"ne":          "ne",
+	"gt":          "gt",
+	"ge":          "ge",
+	"lt":          "lt",
+	"le":          "le",
+	"startswith":  "startswith",
+	"endswith":    "endswith",
+	"contains":    "contains",
+	"substringof": "substringof",
+}
+
+// ODataOptions are the OData related parameters of an HTTP or SAP node.
+//
+//	"odata": {
+//	  "filter": [{"field": "CardType", "operator": "eq", "value": "cCustomer"}],
+//	  "filterJoin": "and",
+//	  "select": ["CardCode", "CardName"],
+//	  "orderby": ["CardCode desc"],
+//	  "top": 100, "skip": 0,
+//	  "pageSize": 100, "followNextLink": true, "maxPages": 50,
+//	  "serviceRoot": "https://sap:50000/b1s/v1"
+//	}
+//
+// filter may also be a raw OData string, and select/orderby/expand comma separated strings.
+type ODataOptions struct {
+	Query          string // Encoded query string built from filter/select/orderby/top/skip/expand.
+	ServiceRoot    string // Base for relative nextLinks; defaults to the request URL.
+	PageSize       int    // Requested page size, sent as Prefer: odata.maxpagesize.
+	FollowNextLink bool   // Whether nextLinks are followed.
+	MaxPages       int    // Maximum number of pages to fetch, including the first.
+}
+
+// parseODataOptions reads the "odata" node parameter. It returns nil when the node has none.
+func parseODataOptions(params map[string]interface{}) (*ODataOptions, error) {
+	raw, ok := params["odata"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	opts := &ODataOptions{FollowNextLink: true, MaxPages: defaultODataMaxPages}
+	opts.ServiceRoot, _ = raw["serviceRoot"].(string)
+	var parts []string
+
+	if filter, ok := raw["filter"]; ok && filter != nil {
+		join, _ := raw["filterJoin"].(string)
+		expr, err := buildODataFilter(filter, join)
+		if err != nil {
+			return nil, err
+		}
+		if expr != "" {
+			parts = append(parts, "$filter="+escapeODataQueryValue(expr))
+		}
+	}
+
+	for _, name := range []string{"select", "orderby", "expand"} {
+		if list := odataList(raw[name]); list != "" {
+			parts = append(parts, "$"+name+"="+escapeODataQueryValue(list))
+		}
+	}
+
+	for _, name := range []string{"top", "skip"} {
+		if value, ok := raw[name]; ok && value != nil && value != "" {
+			n, err := toNumber(value)
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid odata %s: %v", name, value)
+			}
+			parts = append(parts, "$"+name+"="+strconv.Itoa(int(n)))
+		}
+	}
+
+	if value, ok := raw["pageSize"]; ok {
+		n, err := toNumber(value)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid odata pageSize: %v", value)
+		}
+		opts.PageSize = int(n)
+	}
+	if value, ok := raw["maxPages"]; ok {
+		n, err := toNumber(value)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid odata maxPages: %v", value)
+		}
+		opts.MaxPages = int(n)
+	}
+	if value, ok := raw["followNextLink"]; ok {
+		follow, err := toBoolean(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid odata followNextLink: %v", value)
+		}
+		opts.FollowNextLink = follow
+	}
+
+	opts.Query = strings.Join(parts, "&")
+	return opts, nil
+}
+
+// odataList joins a list parameter given as an array or a comma separated string.
+func odataList(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			if s := strings.TrimSpace(fmt.Sprintf("%v", item)); s != "" {
+				items = append(items, s)
+			}
+		}
+		return strings.Join(items, ",")
+	default:
+		return ""
+	}
+}
+
+// buildODataFilter renders a raw filter string or a list of structured conditions.
+func buildODataFilter(filter interface{}, join string) (string, error) {
+	if raw, ok := filter.(string); ok {
+		return raw, nil
+	}
+
+	conditions, ok := filter.([]interface{})
+	if !ok {
+		return "", fmt.Errorf("invalid odata filter: expected a string or a list of conditions")
+	}
+
+	join = strings.ToLower(strings.TrimSpace(join))
+	if join == "" {
+		join = "and"
+	}
+	if join != "and" && join != "or" {
+		return "", fmt.Errorf("invalid odata filterJoin: %s", join)
+	}
+
+	exprs := make([]string, 0, len(conditions))
+	for _, rawCond := range conditions {
+		cond, ok := rawCond.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("invalid odata filter condition: %v", rawCond)
+		}
+
+		field, _ := cond["field"].(string)
+		if field == "" {
+			return "", fmt.Errorf("invalid odata filter condition: missing field")
+		}
+		operator, _ := cond["operator"].(string)
+		if operator == "" {
+			operator = "eq"
+		}
+		opSyntax, ok := odataFilterOperators[strings.ToLower(operator)]
+		if !ok {
+			return "", fmt.Errorf("invalid odata filter operator: %s", operator)
+		}
+
+		valueType, _ := cond["type"].(string)
+		literal := odataLiteral(cond["value"], valueType)
+
+		switch opSyntax {
+		case "startswith", "endswith", "contains":
+			exprs = append(exprs, fmt.Sprintf("%s(%s,%s)", opSyntax, field, literal))
+		case "substringof":
+			exprs = append(exprs, fmt.Sprintf("substringof(%s,%s)", literal, field))
+		default:
+			exprs = append(exprs, fmt.Sprintf("%s %s %s", field, opSyntax, literal))
+		}
+	}
+
+	if len(exprs) > 1 {
+		for i, expr := range exprs {
+			exprs[i] = "(" + expr + ")"
+		}
+	}
+	return strings.Join(exprs, " "+join+" "), nil
+}
+
+// odataLiteral renders a value as an OData literal. Strings are single quoted with
+// embedded quotes doubled; numbers, booleans and null are written as is. The
+// optional type forces "string", "number", "datetime" or "raw" rendering.
+func odataLiteral(value interface{}, valueType string) string {
+	switch valueType {
+	case "raw":
+		return fmt.Sprintf("%v", value)
+	case "number":
+		if n, err := toNumber(value); err == nil {
+			return strconv.FormatFloat(n, 'f', -1, 64)
+		}
+	case "datetime":
+		t, ok := value.(time.Time)
+		if !ok {
+			var err error
+			if t, err = time.Parse(time.RFC3339, fmt.Sprintf("%v", value)); err != nil {
+				break
+			}
+		}
+		// The literal has no offset, so it is written in UTC
+		return "'" + t.UTC().Format("2006-01-02T15:04:05") + "'"
+	case "string":
+		return "'" + strings.ReplaceAll(fmt.Sprintf("%v", value), "'", "''") + "'"
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	default:
+		return "'" + strings.ReplaceAll(fmt.Sprintf("%v", v), "'", "''") + "'"
+	}
+}
+
+// escapeODataQueryValue percent-encodes a query value. Spaces become %20 rather
+// than "+", which SAP Service Layer does not decode.
+func escapeODataQueryValue(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "+", "%20")
+}
+
+// appendQuery adds an encoded query string to a URL or path.
+func appendQuery(target, query string) string {
+	if query == "" {
+		return target
+	}
+	if strings.Contains(target, "?") {
+		return target + "&" + query
+	}
+	return target + "?" + query
+}
+
+// odataNextLink returns the nextLink of an OData response, for both OData v3
+// ("odata.nextLink") and v4 ("@odata.nextLink").
+func odataNextLink(body []byte) string {
+	var page map[string]interface{}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return ""
+	}
+	for _, key := range []string{"@odata.nextLink", "odata.nextLink"} {
+		if link, ok := page[key].(string); ok && link != "" {
+			return link
+		}
+	}
+	return ""
+}
+
+// followODataNextLinks fetches the pages after the first one and merges all rows.
+// fetch receives the raw nextLink and must resolve it against the service root.
+// A failing page is returned as is so that its error reaches the node.
+func followODataNextLinks(first httpPage, maxPages int, fetch func(nextLink string) (httpPage, error)) (pagedResult, error) {
+	result := pagedResult{httpPage: first, Pages: 1}
+
+	var firstBody map[string]interface{}
+	if first.StatusCode >= 400 || json.Unmarshal(first.Body, &firstBody) != nil {
+		return result, nil
+	}
+	rows, ok := firstBody["value"].([]interface{})
+	if !ok {
+		return result, nil
+	}
+
+	page := first
+	for {
+		nextLink := odataNextLink(page.Body)
+		if nextLink == "" {
+			break
+		}
+		if result.Pages >= maxPages {
+			result.HasMore = true
+			break
+		}
+
+		next, err := fetch(nextLink)
+		if err != nil {
+			return result, err
+		}
+		result.Pages++
+		if next.StatusCode >= 400 {
+			result.httpPage = next
+			return result, nil
+		}
+
+		var nextBody map[string]interface{}
+		if err := json.Unmarshal(next.Body, &nextBody); err != nil {
+			return result, fmt.Errorf("failed to parse OData page %d: %w", result.Pages, err)
+		}
+		pageRows, _ := nextBody["value"].([]interface{})
+		rows = append(rows, pageRows...)
+		page = next
+	}
+
+	combined, err := json.Marshal(map[string]interface{}{"value": rows})
+	if err != nil {
+		return result, fmt.Errorf("failed to combine OData pages: %w", err)
+	}
+	result.StatusCode = page.StatusCode
+	result.Header = page.Header
+	result.Body = combined
+	return result, nil
+}
+
+// resolveODataLink resolves a nextLink against the service root (or, without one,
+// the URL of the request that returned it).
+func resolveODataLink(nextLink, serviceRoot, requestURL string) (string, error) {
+	// Some servers return nextLinks with unencoded spaces inside $filter
+	nextLink = strings.ReplaceAll(nextLink, " ", "%20")
+
+	link, err := url.Parse(nextLink)
+	if err != nil {
+		return "", fmt.Errorf("invalid nextLink %q: %w", nextLink, err)
+	}
+	if link.IsAbs() {
+		return nextLink, nil
+	}
+
+	base := requestURL
+	if serviceRoot != "" && !strings.HasPrefix(nextLink, "/") {
+		base = strings.TrimRight(serviceRoot, "/") + "/"
+	}
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("invalid base URL %q: %w", base, err)
+	}
+	return baseURL.ResolveReference(link).String(), nil
+}
+
+// preferHeader returns the Prefer header value requesting the configured page size.
+func (o *ODataOptions) preferHeader() string {
+	if o == nil || o.PageSize <= 0 {
+		return ""
+	}
+	return "odata.maxpagesize=" + strconv.Itoa(o.PageSize)
+}
@@ -0,0 +1,290 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// ErrOutboundBlocked is returned when a connection violates the outbound policy.
+var ErrOutboundBlocked = errors.New("outbound connection blocked by policy")
+
+// defaultOutboundDeny protects loopback, link-local (including cloud metadata
+// endpoints) and unspecified addresses when WORKFLOW_OUTBOUND_DENY is not set
+const defaultOutboundDeny = "127.0.0.0/8,::1/128,0.0.0.0/8,::/128,169.254.0.0/16,fe80::/10,fd00:ec2::254/128,localhost,*.localhost"
+
+// outboundRule matches a host name ("host", "*.domain") or a network (CIDR or single IP).
+type outboundRule struct {
+	host    string
+	network *net.IPNet
+}
+
+// OutboundPolicy decides which hosts the connectors may reach. It is configured with
+//
+//	WORKFLOW_OUTBOUND_SCHEMES  allowed URL schemes (default "http,https")
+//	WORKFLOW_OUTBOUND_ALLOW    hosts, *.domains and CIDRs; when set, nothing else is reachable
+//	WORKFLOW_OUTBOUND_DENY     hosts, *.domains and CIDRs that are never reachable
+//	                           (default: loopback, link-local and metadata addresses)
+//
+// Deny rules win over allow rules. Addresses are checked after DNS resolution
+// when connecting, so a host name cannot be pointed at a denied address. When
+// a proxy is used the proxy itself must be allowed as well.
+type OutboundPolicy struct {
+	schemes map[string]bool
+	allow   []outboundRule
+	deny    []outboundRule
+}
+
+// outboundPolicy is the policy applied to all outbound connections.
+var outboundPolicy = mustOutboundPolicy("http,https", "", defaultOutboundDeny)
+
+// InitOutboundPolicy reads the outbound policy from the environment.
+func InitOutboundPolicy() error {
+	schemes := os.Getenv("WORKFLOW_OUTBOUND_SCHEMES")
+	if schemes == "" {
+		schemes = "http,https"
+	}
+	deny, ok := os.LookupEnv("WORKFLOW_OUTBOUND_DENY")
+	if !ok {
+		deny = defaultOutboundDeny
+	}
+
+	policy, err := NewOutboundPolicy(schemes, os.Getenv("WORKFLOW_OUTBOUND_ALLOW"), deny)
+	if err != nil {
+		return err
+	}
+	outboundPolicy = policy
+	return nil
+}
+
+// NewOutboundPolicy builds a policy from comma separated lists.
+func NewOutboundPolicy(schemes, allow, deny string) (*OutboundPolicy, error) {
+	policy := &OutboundPolicy{schemes: make(map[string]bool)}
+	for _, scheme := range strings.Split(schemes, ",") {
+		if scheme = strings.ToLower(strings.TrimSpace(scheme)); scheme != "" {
+			policy.schemes[scheme] = true
+		}
+	}
+
+	var err error
+	if policy.allow, err = parseOutboundRules(allow); err != nil {
+		return nil, fmt.Errorf("invalid WORKFLOW_OUTBOUND_ALLOW: %w", err)
+	}
+	if policy.deny, err = parseOutboundRules(deny); err != nil {
+		return nil, fmt.Errorf("invalid WORKFLOW_OUTBOUND_DENY: %w", err)
+	}
+	return policy, nil
+}
+
+// mustOutboundPolicy builds a built-in policy.
+func mustOutboundPolicy(schemes, allow, deny string) *OutboundPolicy {
+	policy, err := NewOutboundPolicy(schemes, allow, deny)
+	if err != nil {
+		panic(err)
+	}
+	return policy
+}
+
+// parseOutboundRules parses a comma separated list of hosts, *.domains, IPs and CIDRs.
+func parseOutboundRules(list string) ([]outboundRule, error) {
+	var rules []outboundRule
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		if entry == "" {
+			continue
+		}
+
+		if strings.Contains(entry, "/") {
+			_, network, err := net.ParseCIDR(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR %q", entry)
+			}
+			rules = append(rules, outboundRule{network: network})
+			continue
+		}
+		if ip := net.ParseIP(entry); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			rules = append(rules, outboundRule{network: &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}})
+			continue
+		}
+		if strings.Contains(strings.TrimPrefix(entry, "*."), "*") {
+			return nil, fmt.Errorf("invalid host pattern %q", entry)
+		}
+		rules = append(rules, outboundRule{host: strings.TrimSuffix(entry, ".")})
+	}
+	return rules, nil
+}
+
+// matchesHost reports whether a rule matches a host name.
+func (r outboundRule) matchesHost(host string) bool {
+	if r.host == "" {
+		return false
+	}
+	if domain, ok := strings.CutPrefix(r.host, "*."); ok {
+		return strings.HasSuffix(host, "."+domain)
+	}
+	return host == r.host
+}
+
+// matchesIP reports whether a rule matches an address.
+func (r outboundRule) matchesIP(ip net.IP) bool {
+	return r.network != nil && ip != nil && r.network.Contains(ip)
+}
+
+// normalizeHost lower-cases a host name and strips a trailing dot.
+func normalizeHost(host string) string {
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
+
+// checkScheme rejects URL schemes that are not allowed.
+func (p *OutboundPolicy) checkScheme(scheme string) error {
+	if !p.schemes[strings.ToLower(scheme)] {
+		return fmt.Errorf("%w: scheme %q is not allowed", ErrOutboundBlocked, scheme)
+	}
+	return nil
+}
+
+// checkAddress decides whether host, resolved to ip, may be contacted. ip is nil
+// when the address is not known yet; only the host name rules apply then.
+func (p *OutboundPolicy) checkAddress(host string, ip net.IP) error {
+	host = normalizeHost(host)
+	target := host
+	if ip != nil && ip.String() != host {
+		target = fmt.Sprintf("%s (%s)", host, ip)
+	}
+
+	for _, rule := range p.deny {
+		if rule.matchesHost(host) || rule.matchesIP(ip) {
+			return fmt.Errorf("%w: %s is denied", ErrOutboundBlocked, target)
+		}
+	}
+	if len(p.allow) == 0 {
+		return nil
+	}
+	for _, rule := range p.allow {
+		if rule.matchesHost(host) || rule.matchesIP(ip) {
+			return nil
+		}
+	}
+	if ip == nil && p.hasNetworkRules() {
+		// The address may still match an allowed network once resolved
+		return nil
+	}
+	return fmt.Errorf("%w: %s is not in the allow list", ErrOutboundBlocked, target)
+}
+
+// hasNetworkRules reports whether the allow list contains networks.
+func (p *OutboundPolicy) hasNetworkRules() bool {
+	for _, rule := range p.allow {
+		if rule.network != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveAndCheck resolves a host and checks every address it resolves to, so
+// that one denied record fails the connection. It returns the addresses.
+func (p *OutboundPolicy) resolveAndCheck(ctx context.Context, host string) ([]net.IP, error) {
+	if ip := net.ParseIP(host); ip != nil {
+		return []net.IP{ip}, p.checkAddress(host, ip)
+	}
+	if err := p.checkAddress(host, nil); err != nil {
+		return nil, err
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	ips := make([]net.IP, 0, len(addrs))
+	for _, addr := range addrs {
+		if err := p.checkAddress(host, addr.IP); err != nil {
+			return nil, err
+		}
+		ips = append(ips, addr.IP)
+	}
+	return ips, nil
+}
+
+// policyDialContext returns a dial function that enforces the outbound policy
+// on the resolved addresses and connects to exactly those addresses.
+func policyDialContext(dialer *net.Dialer) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+
+		ips, err := outboundPolicy.resolveAndCheck(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+
+		var lastErr error
+		for _, ip := range ips {
+			conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+			if err == nil {
+				return conn, nil
+			}
+			lastErr = err
+		}
+		if lastErr == nil {
+			lastErr = fmt.Errorf("no addresses found for %s", host)
+		}
+		return nil, lastErr
+	}
+}
+
+// contextDialerFunc adapts a dial function to the dialer interfaces of database drivers.
+type contextDialerFunc func(ctx context.Context, network, addr string) (net.Conn, error)
+
+// DialContext implements the driver dialer interfaces.
+func (f contextDialerFunc) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	return f(ctx, network, addr)
+}
+
+// policyTransport checks the scheme and host of every request, including
+// redirects, before handing it to the shared transport. Requests sent through
+// a proxy have their target resolved and checked here, since the dialer only
+// sees the proxy.
+type policyTransport struct {
+	base *http.Transport
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *policyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := outboundPolicy.checkScheme(req.URL.Scheme); err != nil {
+		return nil, err
+	}
+
+	host := req.URL.Hostname()
+	proxied := false
+	if t.base.Proxy != nil {
+		proxyURL, err := t.base.Proxy(req)
+		if err != nil {
+			return nil, err
+		}
+		proxied = proxyURL != nil
+	}
+
+	if proxied {
+		ctx, cancel := context.WithTimeout(req.Context(), 10*time.Second)
+		defer cancel()
+		if _, err := outboundPolicy.resolveAndCheck(ctx, host); err != nil {
+			return nil, err
+		}
+	} else if err := outboundPolicy.checkAddress(host, net.ParseIP(host)); err != nil {
+		return nil, err
+	}
+
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,430 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultPaginationMaxPages bounds how many pages are fetched when no maxPages is set
+	defaultPaginationMaxPages = 50
+	// paginationMaxThrottleRetries is how often a page answered with 429 is retried
+	paginationMaxThrottleRetries = 3
+)
+
+// linkNextRegex finds the rel="next" target of an RFC 8288 Link header.
+var linkNextRegex = regexp.MustCompile(`<([^>]*)>\s*;[^,]*\brel="?next"?`)
+
+// httpPage is one response of a paged collection.
+type httpPage struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// pagedResult is the combination of all fetched pages.
+type pagedResult struct {
+	httpPage      // Last page status and headers, with Body holding all rows as {"value": [...]}.
+	Pages    int  // Number of pages fetched.
+	HasMore  bool // Whether more pages were left unfetched because of maxPages.
+}
+
+// HTTPPagination is the "pagination" parameter of an HTTP node.
+//
+//	"pagination": {
+//	  "type": "cursor",
+//	  "itemsPath": "data",
+//	  "cursorPath": "meta.nextCursor", "cursorParam": "cursor",
+//	  "hasMorePath": "meta.hasMore",
+//	  "maxPages": 20, "delay": 250
+//	}
+//
+// Types:
+//   - nextUrl:    the next page URL is read from nextUrlPath
+//   - cursor:     the token at cursorPath is sent as cursorParam (in the query, or
+//     the JSON body with "cursorIn": "body")
+//   - linkHeader: the rel="next" URL of the Link header is followed
+//   - offset:     offsetParam is increased by pageSize (sent as limitParam)
+//   - page:       pageParam is increased by one, starting at "start"
+//
+// Paging stops when there is no next page, a page has no items, hasMorePath is
+// false, totalPath items were collected or maxPages is reached. offset and page
+// also stop at a page shorter than pageSize.
+type HTTPPagination struct {
+	Type        string
+	ItemsPath   string // Path of the item array in the response; empty when the response is the array.
+	NextURLPath string
+	CursorPath  string
+	CursorParam string
+	CursorIn    string
+	OffsetParam string
+	LimitParam  string
+	PageParam   string
+	PageSize    int
+	Start       int
+	HasMorePath string
+	TotalPath   string
+	MaxPages    int
+	Delay       time.Duration // Pause between page requests.
+}
+
+// parsePagination reads the "pagination" node parameter. It returns nil when the node has none.
+func parsePagination(params map[string]interface{}) (*HTTPPagination, error) {
+	raw, ok := params["pagination"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	str := func(key, fallback string) string {
+		if value, ok := raw[key].(string); ok && value != "" {
+			return value
+		}
+		return fallback
+	}
+	number := func(key string, fallback, min int) (int, error) {
+		value, ok := raw[key]
+		if !ok || value == nil || value == "" {
+			return fallback, nil
+		}
+		n, err := toNumber(value)
+		if err != nil || int(n) < min {
+			return 0, fmt.Errorf("invalid pagination %s: %v", key, value)
+		}
+		return int(n), nil
+	}
+
+	p := &HTTPPagination{
+		Type:        str("type", ""),
+		ItemsPath:   str("itemsPath", ""),
+		NextURLPath: str("nextUrlPath", ""),
+		CursorPath:  str("cursorPath", ""),
+		CursorParam: str("cursorParam", "cursor"),
+		CursorIn:    str("cursorIn", "query"),
+		OffsetParam: str("offsetParam", "offset"),
+		LimitParam:  str("limitParam", "limit"),
+		PageParam:   str("pageParam", "page"),
+		HasMorePath: str("hasMorePath", ""),
+		TotalPath:   str("totalPath", ""),
+	}
+
+	var err error
+	if p.PageSize, err = number("pageSize", 0, 0); err != nil {
+		return nil, err
+	}
+	if p.MaxPages, err = number("maxPages", defaultPaginationMaxPages, 1); err != nil {
+		return nil, err
+	}
+	delay, err := number("delay", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	p.Delay = time.Duration(delay) * time.Millisecond
+
+	switch p.Type {
+	case "nextUrl":
+		if p.NextURLPath == "" {
+			return nil, fmt.Errorf("nextUrl pagination requires 'nextUrlPath'")
+		}
+	case "cursor":
+		if p.CursorPath == "" {
+			return nil, fmt.Errorf("cursor pagination requires 'cursorPath'")
+		}
+		if p.CursorIn != "query" && p.CursorIn != "body" {
+			return nil, fmt.Errorf("invalid pagination cursorIn: %s", p.CursorIn)
+		}
+	case "linkHeader":
+	case "offset":
+		if p.PageSize == 0 {
+			return nil, fmt.Errorf("offset pagination requires 'pageSize'")
+		}
+		p.Start, err = number("start", 0, 0)
+	case "page":
+		p.Start, err = number("start", 1, 0)
+	default:
+		return nil, fmt.Errorf("unsupported pagination type: %s", p.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// firstURL adds the parameters of the first page to the request URL.
+func (p *HTTPPagination) firstURL(requestURL string) (string, error) {
+	switch p.Type {
+	case "offset":
+		return setQueryParams(requestURL, map[string]string{
+			p.OffsetParam: strconv.Itoa(p.Start),
+			p.LimitParam:  strconv.Itoa(p.PageSize),
+		})
+	case "page":
+		params := map[string]string{p.PageParam: strconv.Itoa(p.Start)}
+		if p.PageSize > 0 {
+			params[p.LimitParam] = strconv.Itoa(p.PageSize)
+		}
+		return setQueryParams(requestURL, params)
+	default:
+		return requestURL, nil
+	}
+}
+
+// paginationFetch sends the request of a following page.
+type paginationFetch func(method, pageURL string, body []byte) (httpPage, error)
+
+// follow fetches the pages after the first one and concatenates their items.
+// method, requestURL and body describe the first request; nextUrl and
+// linkHeader pages are fetched with GET, the other types repeat the request.
+// A failing page is returned as is so that its error reaches the node.
+func (p *HTTPPagination) follow(first httpPage, method, requestURL string, body []byte, fetch paginationFetch) (pagedResult, error) {
+	result := pagedResult{httpPage: first, Pages: 1}
+	if first.StatusCode >= 400 {
+		return result, nil
+	}
+
+	page := first
+	pageURL := requestURL
+	seen := map[string]bool{requestURL + "\x00" + string(body): true}
+	offset, pageNumber := p.Start, p.Start
+	var items []interface{}
+
+pages:
+	for {
+		var data interface{}
+		if err := json.Unmarshal(page.Body, &data); err != nil {
+			return result, fmt.Errorf("failed to parse page %d: %w", result.Pages, err)
+		}
+		pageItems, err := p.items(data)
+		if err != nil {
+			return result, fmt.Errorf("page %d: %w", result.Pages, err)
+		}
+		items = append(items, pageItems...)
+
+		if p.lastPage(data, len(pageItems), len(items)) {
+			break pages
+		}
+
+		// Work out the request of the next page
+		nextMethod, nextURL, nextBody := method, pageURL, body
+		switch p.Type {
+		case "nextUrl", "linkHeader":
+			link := p.nextLink(data, page.Header)
+			if link == "" {
+				break pages
+			}
+			resolved, err := resolveODataLink(link, "", pageURL)
+			if err != nil {
+				return result, err
+			}
+			nextMethod, nextURL, nextBody = http.MethodGet, resolved, nil
+		case "cursor":
+			cursor := lookupPath(data, p.CursorPath)
+			if cursor == nil || fmt.Sprintf("%v", cursor) == "" {
+				break pages
+			}
+			if nextBody, nextURL, err = p.withCursor(fmt.Sprintf("%v", cursor), body, requestURL); err != nil {
+				return result, err
+			}
+		case "offset":
+			offset += p.PageSize
+			nextURL, err = setQueryParams(pageURL, map[string]string{p.OffsetParam: strconv.Itoa(offset)})
+		case "page":
+			pageNumber++
+			nextURL, err = setQueryParams(pageURL, map[string]string{p.PageParam: strconv.Itoa(pageNumber)})
+		}
+		if err != nil {
+			return result, err
+		}
+
+		// Stop if the API hands back a page that was already fetched
+		requestKey := nextURL + "\x00" + string(nextBody)
+		if seen[requestKey] {
+			break
+		}
+		seen[requestKey] = true
+
+		if result.Pages >= p.MaxPages {
+			result.HasMore = true
+			break
+		}
+
+		next, err := p.fetchWithPacing(fetch, nextMethod, nextURL, nextBody)
+		if err != nil {
+			return result, err
+		}
+		result.Pages++
+		if next.StatusCode >= 400 {
+			result.httpPage = next
+			return result, nil
+		}
+		page, pageURL, body = next, nextURL, nextBody
+	}
+
+	if items == nil {
+		items = []interface{}{}
+	}
+	combined, err := json.Marshal(map[string]interface{}{"value": items})
+	if err != nil {
+		return result, fmt.Errorf("failed to combine pages: %w", err)
+	}
+	result.StatusCode = page.StatusCode
+	result.Header = page.Header
+	result.Body = combined
+	return result, nil
+}
+
+// items returns the item array of a page.
+func (p *HTTPPagination) items(data interface{}) ([]interface{}, error) {
+	value := data
+	if p.ItemsPath != "" {
+		value = lookupPath(data, p.ItemsPath)
+	}
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("items at '%s' are not an array", p.ItemsPath)
+	}
+}
+
+// lastPage applies the stop conditions that do not depend on the next link.
+func (p *HTTPPagination) lastPage(data interface{}, pageItems, totalItems int) bool {
+	if pageItems == 0 {
+		return true
+	}
+	if (p.Type == "offset" || p.Type == "page") && p.PageSize > 0 && pageItems < p.PageSize {
+		return true
+	}
+	if p.HasMorePath != "" {
+		if hasMore, err := toBoolean(lookupPath(data, p.HasMorePath)); err != nil || !hasMore {
+			return true
+		}
+	}
+	if p.TotalPath != "" {
+		if total, err := toNumber(lookupPath(data, p.TotalPath)); err == nil && totalItems >= int(total) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextLink returns the next page URL from the body or the Link header.
+func (p *HTTPPagination) nextLink(data interface{}, header http.Header) string {
+	if p.Type == "linkHeader" {
+		for _, link := range header.Values("Link") {
+			if matches := linkNextRegex.FindStringSubmatch(link); matches != nil {
+				return matches[1]
+			}
+		}
+		return ""
+	}
+	if link, ok := lookupPath(data, p.NextURLPath).(string); ok {
+		return link
+	}
+	return ""
+}
+
+// withCursor places the cursor in the query string or the JSON body of the request.
+func (p *HTTPPagination) withCursor(cursor string, body []byte, requestURL string) ([]byte, string, error) {
+	if p.CursorIn == "query" {
+		nextURL, err := setQueryParams(requestURL, map[string]string{p.CursorParam: cursor})
+		return body, nextURL, err
+	}
+
+	payload := map[string]interface{}{}
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return nil, "", fmt.Errorf("cursor pagination in the body requires a JSON object body: %w", err)
+		}
+	}
+	payload[p.CursorParam] = cursor
+	nextBody, err := json.Marshal(payload)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal request body: %w", err)
+	}
+	return nextBody, requestURL, nil
+}
+
+// fetchWithPacing waits the configured delay before fetching a page and backs
+// off when the server answers 429 Too Many Requests.
+func (p *HTTPPagination) fetchWithPacing(fetch paginationFetch, method, pageURL string, body []byte) (httpPage, error) {
+	for attempt := 0; ; attempt++ {
+		if p.Delay > 0 {
+			time.Sleep(p.Delay)
+		}
+		page, err := fetch(method, pageURL, body)
+		if err != nil || page.StatusCode != http.StatusTooManyRequests || attempt >= paginationMaxThrottleRetries {
+			return page, err
+		}
+
+		wait := time.Second << attempt
+		if seconds, err := strconv.Atoi(page.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			wait = time.Duration(seconds) * time.Second
+		}
+		log.Printf("Page request was throttled, retrying in %v", wait)
+		time.Sleep(wait)
+	}
+}
+
+// lookupPath returns the value at a dot separated path; numeric segments index arrays.
+func lookupPath(data interface{}, path string) interface{} {
+	current := data
+	for _, part := range strings.Split(path, ".") {
+		switch v := current.(type) {
+		case map[string]interface{}:
+			current = v[part]
+		case []interface{}:
+			index, err := strconv.Atoi(part)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil
+			}
+			current = v[index]
+		default:
+			return nil
+		}
+	}
+	return current
+}
+
+// setQueryParams sets query parameters on a URL, leaving the encoding of the
+// other parameters untouched.
+func setQueryParams(target string, params map[string]string) (string, error) {
+	parsed, err := url.Parse(target)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %w", target, err)
+	}
+
+	var parts []string
+	for _, part := range strings.Split(parsed.RawQuery, "&") {
+		if part == "" {
+			continue
+		}
+		name, _, _ := strings.Cut(part, "=")
+		if decoded, err := url.QueryUnescape(name); err == nil {
+			name = decoded
+		}
+		if _, replaced := params[name]; !replaced {
+			parts = append(parts, part)
+		}
+	}
+	names := make([]string, 0, len(params))
+	for name := range params {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		parts = append(parts, url.QueryEscape(name)+"="+url.QueryEscape(params[name]))
+	}
+
+	parsed.RawQuery = strings.Join(parts, "&")
+	return parsed.String(), nil
+}
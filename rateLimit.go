@@ -0,0 +1,236 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultRateLimitMaxWait bounds how long a request waits for a rate limit or a free slot
+const defaultRateLimitMaxWait = 5 * time.Minute
+
+// RateLimitConfig limits the requests made to one host or with one credential.
+// Zero values mean no limit.
+type RateLimitConfig struct {
+	RequestsPerSecond float64 `json:"requestsPerSecond"` // Sustained request rate.
+	Burst             int     `json:"burst"`             // Requests allowed at once above the rate (default 1).
+	MaxConcurrent     int     `json:"maxConcurrent"`     // Requests in flight at the same time.
+	MaxWait           int     `json:"maxWait"`           // Seconds a request may wait before failing (default 300).
+}
+
+// rateLimitConfigs holds the limits from WORKFLOW_RATE_LIMITS, keyed by
+// "host:<hostname>", "host:*" (each other host) or "credential:<name>":
+//
+//	{"host:sap.internal": {"requestsPerSecond": 10, "burst": 20, "maxConcurrent": 4},
+//	 "credential:salesforce": {"requestsPerSecond": 5}}
+//
+// Credentials may also carry rateLimit, rateBurst and maxConcurrent fields,
+// which apply when WORKFLOW_RATE_LIMITS has no entry for the credential.
+var rateLimitConfigs = map[string]RateLimitConfig{}
+
+// rateLimiters are shared by all executions in the process.
+var rateLimiters = struct {
+	sync.Mutex
+	byKey map[string]*rateLimiter
+}{byKey: make(map[string]*rateLimiter)}
+
+// rateLimiter is a token bucket combined with a concurrency cap.
+type rateLimiter struct {
+	mu      sync.Mutex
+	rate    float64
+	burst   float64
+	tokens  float64
+	last    time.Time
+	slots   chan struct{}
+	maxWait time.Duration
+}
+
+// InitRateLimits reads the limits from WORKFLOW_RATE_LIMITS.
+func InitRateLimits() error {
+	raw := os.Getenv("WORKFLOW_RATE_LIMITS")
+	if raw == "" {
+		return nil
+	}
+
+	configs := map[string]RateLimitConfig{}
+	if err := json.Unmarshal([]byte(raw), &configs); err != nil {
+		return fmt.Errorf("invalid WORKFLOW_RATE_LIMITS: %w", err)
+	}
+	for key := range configs {
+		if !strings.HasPrefix(key, "host:") && !strings.HasPrefix(key, "credential:") {
+			return fmt.Errorf("invalid WORKFLOW_RATE_LIMITS key %q: expected host:<name> or credential:<name>", key)
+		}
+	}
+	rateLimitConfigs = configs
+	return nil
+}
+
+// newRateLimiter creates a limiter, or returns nil when the config sets no limit.
+func newRateLimiter(config RateLimitConfig) *rateLimiter {
+	if config.RequestsPerSecond <= 0 && config.MaxConcurrent <= 0 {
+		return nil
+	}
+
+	limiter := &rateLimiter{
+		rate:    config.RequestsPerSecond,
+		burst:   float64(config.Burst),
+		last:    time.Now(),
+		maxWait: time.Duration(config.MaxWait) * time.Second,
+	}
+	if limiter.burst < 1 {
+		limiter.burst = 1
+	}
+	limiter.tokens = limiter.burst
+	if limiter.maxWait <= 0 {
+		limiter.maxWait = defaultRateLimitMaxWait
+	}
+	if config.MaxConcurrent > 0 {
+		limiter.slots = make(chan struct{}, config.MaxConcurrent)
+	}
+	return limiter
+}
+
+// acquire waits for a request token and a free slot. It returns how long it
+// waited and a function that frees the slot once the response has been read.
+func (l *rateLimiter) acquire(name string) (time.Duration, func(), error) {
+	start := time.Now()
+
+	if l.rate > 0 {
+		l.mu.Lock()
+		now := time.Now()
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+		l.last = now
+
+		// Reserve a token; a negative balance queues the callers behind each other
+		var delay time.Duration
+		if l.tokens < 1 {
+			delay = time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		}
+		if delay > l.maxWait {
+			l.mu.Unlock()
+			return 0, nil, fmt.Errorf("rate limit for %s would delay the request by %v", name, delay.Round(time.Millisecond))
+		}
+		l.tokens--
+		l.mu.Unlock()
+
+		time.Sleep(delay)
+	}
+
+	if l.slots == nil {
+		return time.Since(start), func() {}, nil
+	}
+
+	timer := time.NewTimer(l.maxWait - time.Since(start))
+	defer timer.Stop()
+	select {
+	case l.slots <- struct{}{}:
+		var once sync.Once
+		return time.Since(start), func() { once.Do(func() { <-l.slots }) }, nil
+	case <-timer.C:
+		return time.Since(start), nil, fmt.Errorf("no free request slot for %s within %v", name, l.maxWait)
+	}
+}
+
+// getRateLimiter returns the shared limiter of a key, creating it from config on first use.
+func getRateLimiter(key string, config func() (RateLimitConfig, bool)) *rateLimiter {
+	rateLimiters.Lock()
+	defer rateLimiters.Unlock()
+
+	if limiter, ok := rateLimiters.byKey[key]; ok {
+		return limiter
+	}
+	cfg, ok := config()
+	if !ok {
+		rateLimiters.byKey[key] = nil
+		return nil
+	}
+	limiter := newRateLimiter(cfg)
+	rateLimiters.byKey[key] = limiter
+	return limiter
+}
+
+// invalidateRateLimiter drops the limiter of a credential so changed limits apply.
+func invalidateRateLimiter(name string) {
+	rateLimiters.Lock()
+	defer rateLimiters.Unlock()
+	delete(rateLimiters.byKey, "credential:"+name)
+}
+
+// credentialRateLimitConfig reads the limit fields of credential data.
+func credentialRateLimitConfig(data map[string]interface{}) (RateLimitConfig, bool) {
+	var config RateLimitConfig
+	number := func(key string) float64 {
+		n, _ := toNumber(data[key])
+		return n
+	}
+	config.RequestsPerSecond = number("rateLimit")
+	config.Burst = int(number("rateBurst"))
+	config.MaxConcurrent = int(number("maxConcurrent"))
+	return config, config.RequestsPerSecond > 0 || config.MaxConcurrent > 0
+}
+
+// throttle waits for the credential's and the host's limits before a request
+// to target. The waiting time is added to the current node's result; the
+// returned function must be called when the response has been read.
+func (we *WorkflowEngine) throttle(target, credentialName string) (func(), error) {
+	var limiters []*rateLimiter
+	var names []string
+
+	if credentialName != "" {
+		key := "credential:" + credentialName
+		limiter := getRateLimiter(key, func() (RateLimitConfig, bool) {
+			if config, ok := rateLimitConfigs[key]; ok {
+				return config, true
+			}
+			return credentialRateLimitConfig(we.context.Credentials[credentialName])
+		})
+		if limiter != nil {
+			limiters = append(limiters, limiter)
+			names = append(names, "credential "+credentialName)
+		}
+	}
+
+	if parsed, err := url.Parse(target); err == nil && parsed.Hostname() != "" {
+		host := strings.ToLower(parsed.Hostname())
+		key := "host:" + host
+		limiter := getRateLimiter(key, func() (RateLimitConfig, bool) {
+			if config, ok := rateLimitConfigs[key]; ok {
+				return config, true
+			}
+			config, ok := rateLimitConfigs["host:*"]
+			return config, ok
+		})
+		if limiter != nil {
+			limiters = append(limiters, limiter)
+			names = append(names, "host "+host)
+		}
+	}
+
+	var releases []func()
+	release := func() {
+		for _, r := range releases {
+			r()
+		}
+	}
+	for i, limiter := range limiters {
+		waited, r, err := limiter.acquire(names[i])
+		we.context.RateLimitWait += waited
+		if err != nil {
+			release()
+			return nil, err
+		}
+		if waited > time.Second {
+			log.Printf("Waited %v for the rate limit of %s", waited.Round(time.Millisecond), names[i])
+		}
+		releases = append(releases, r)
+	}
+	return release, nil
+}
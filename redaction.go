@@ -0,0 +1,458 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// redactedValue replaces every masked secret.
+const redactedValue = "********"
+
+// secretFieldNames are keys whose values are always masked (compared case-insensitively).
+var secretFieldNames = map[string]bool{
+	"password":      true,
+	"passwd":        true,
+	"secret":        true,
+	"clientsecret":  true,
+	"client_secret": true,
+	"token":         true,
+	"accesstoken":   true,
+	"access_token":  true,
+	"refreshtoken":  true,
+	"refresh_token": true,
+	"id_token":      true,
+	"apikey":        true,
+	"api_key":       true,
+	"x-api-key":     true,
+	"authorization": true,
+	"cookie":        true,
+	"set-cookie":    true,
+	"sessionid":     true,
+	"b1session":     true,
+	"privatekey":    true,
+	"clientkey":     true,
+	"private_key":   true,
+}
+
+var (
+	// secretJSONFieldRegex finds "key": "value" pairs of secret fields inside raw JSON text
+	secretJSONFieldRegex = regexp.MustCompile(`(?i)"(password|passwd|secret|client_?secret|access_?token|refresh_?token|id_token|token|api_?key|authorization|cookie|sessionid|b1session|private_?key|client_?key)"(\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	// secretInlineRegex finds session cookies and bearer tokens inside free text
+	secretInlineRegex = regexp.MustCompile(`(?i)(B1SESSION=|ROUTEID=|Bearer\s+|Basic\s+)[^\s;,"']+`)
+)
+
+// globalRedactPaths are extra paths masked in every workflow, read from WORKFLOW_REDACT_PATHS
+var globalRedactPaths = parseRedactPaths(os.Getenv("WORKFLOW_REDACT_PATHS"))
+
+// Redactor masks secrets in values and text before they leave the engine.
+// It masks known secret field names, the values of loaded credentials and
+// configured paths (dot separated, "*" matches any key or array index).
+type Redactor struct {
+	values []string   // Literal secret values masked wherever they appear.
+	paths  [][]string // Paths whose values are masked.
+}
+
+// NewRedactor creates a redactor for the given secret values and paths.
+func NewRedactor(secretValues []string, paths [][]string) *Redactor {
+	r := &Redactor{paths: paths}
+	for _, value := range secretValues {
+		r.addValue(value)
+	}
+	return r
+}
+
+// parseRedactPaths splits a comma separated list of dot separated paths.
+func parseRedactPaths(list string) [][]string {
+	var paths [][]string
+	for _, path := range strings.Split(list, ",") {
+		if path = strings.TrimSpace(path); path != "" {
+			paths = append(paths, strings.Split(path, "."))
+		}
+	}
+	return paths
+}
+
+// addValue registers a literal secret. Very short values are ignored since
+// masking them would garble unrelated text.
+func (r *Redactor) addValue(value string) {
+	if len(value) < 4 || value == redactedValue {
+		return
+	}
+	for _, existing := range r.values {
+		if existing == value {
+			return
+		}
+	}
+	r.values = append(r.values, value)
+	// Replace longer values first so that overlapping secrets are fully masked
+	sort.Slice(r.values, func(i, j int) bool { return len(r.values[i]) > len(r.values[j]) })
+}
+
+// isSecretField reports whether a key always holds a secret.
+func isSecretField(key string) bool {
+	return secretFieldNames[strings.ToLower(key)]
+}
+
+// Value returns a masked copy of a value. Secrets found under secret keys are
+// also remembered so that copies of them inside raw text are masked.
+func (r *Redactor) Value(value interface{}) interface{} {
+	r.collect(value, nil)
+	return r.mask(value, nil)
+}
+
+// collect remembers the values of secret fields and configured paths.
+func (r *Redactor) collect(value interface{}, path []string) {
+	if r.matchesPath(path) {
+		r.collectAll(value)
+		return
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if isSecretField(key) {
+				r.collectAll(val)
+				continue
+			}
+			r.collect(val, append(path, key))
+		}
+	case map[string]map[string]interface{}:
+		for key, val := range v {
+			r.collect(val, append(path, key))
+		}
+	case http.Header:
+		for key, val := range v {
+			if isSecretField(key) {
+				r.collectAll(val)
+			}
+		}
+	case []interface{}:
+		for i, val := range v {
+			r.collect(val, append(path, strconv.Itoa(i)))
+		}
+	case []map[string]interface{}:
+		for i, val := range v {
+			r.collect(val, append(path, strconv.Itoa(i)))
+		}
+	}
+}
+
+// collectAll remembers every string inside a secret value.
+func (r *Redactor) collectAll(value interface{}) {
+	switch v := value.(type) {
+	case string:
+		r.addValue(v)
+		// Cookies carry the secret after the "name=" prefix
+		for _, part := range strings.Split(v, ";") {
+			if _, cookieValue, ok := strings.Cut(strings.TrimSpace(part), "="); ok {
+				r.addValue(cookieValue)
+			}
+		}
+	case []string:
+		for _, s := range v {
+			r.collectAll(s)
+		}
+	case []interface{}:
+		for _, val := range v {
+			r.collectAll(val)
+		}
+	case map[string]interface{}:
+		for _, val := range v {
+			r.collectAll(val)
+		}
+	}
+}
+
+// mask returns a copy of value with secrets replaced.
+func (r *Redactor) mask(value interface{}, path []string) interface{} {
+	if r.matchesPath(path) {
+		return redactedValue
+	}
+
+	switch v := value.(type) {
+	case string:
+		return r.String(v)
+	case map[string]interface{}:
+		masked := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			if isSecretField(key) {
+				masked[key] = redactedValue
+				continue
+			}
+			masked[key] = r.mask(val, append(path, key))
+		}
+		return masked
+	case map[string]map[string]interface{}:
+		masked := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			masked[key] = r.mask(val, append(path, key))
+		}
+		return masked
+	case http.Header:
+		masked := make(map[string]interface{}, len(v))
+		for key, vals := range v {
+			if isSecretField(key) {
+				masked[key] = []string{redactedValue}
+				continue
+			}
+			maskedVals := make([]string, len(vals))
+			for i, val := range vals {
+				maskedVals[i] = r.String(val)
+			}
+			masked[key] = maskedVals
+		}
+		return masked
+	case []interface{}:
+		masked := make([]interface{}, len(v))
+		for i, val := range v {
+			masked[i] = r.mask(val, append(path, strconv.Itoa(i)))
+		}
+		return masked
+	case []map[string]interface{}:
+		masked := make([]interface{}, len(v))
+		for i, val := range v {
+			masked[i] = r.mask(val, append(path, strconv.Itoa(i)))
+		}
+		return masked
+	case []string:
+		masked := make([]string, len(v))
+		for i, val := range v {
+			masked[i] = r.String(val)
+		}
+		return masked
+	default:
+		return v
+	}
+}
+
+// matchesPath reports whether a path is configured for redaction.
+func (r *Redactor) matchesPath(path []string) bool {
+	if len(path) == 0 {
+		return false
+	}
+	for _, pattern := range r.paths {
+		if len(pattern) != len(path) {
+			continue
+		}
+		matched := true
+		for i, segment := range pattern {
+			if segment != "*" && segment != path[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// String masks known secret values, secret JSON fields, session cookies and
+// authorization header values inside free text.
+func (r *Redactor) String(s string) string {
+	for _, value := range r.values {
+		s = strings.ReplaceAll(s, value, redactedValue)
+	}
+	s = secretJSONFieldRegex.ReplaceAllString(s, `"$1"$2"`+redactedValue+`"`)
+	s = secretInlineRegex.ReplaceAllString(s, "${1}"+redactedValue)
+	return s
+}
+
+// Redactor builds a redactor for this execution. It masks the values of loaded
+// credentials, secret looking config entries and the paths listed in
+// WORKFLOW_REDACT_PATHS and the workflow's "redactPaths" config entry.
+func (we *WorkflowEngine) Redactor() *Redactor {
+	r := NewRedactor(nil, globalRedactPaths)
+
+	for _, credential := range we.context.Credentials {
+		r.collectAll(credential)
+	}
+
+	for key, value := range we.context.Config {
+		if secretKeyRegex.MatchString(key) {
+			r.collectAll(value)
+		}
+	}
+
+	switch paths := we.context.Config["redactPaths"].(type) {
+	case string:
+		r.paths = append(r.paths, parseRedactPaths(paths)...)
+	case []interface{}:
+		for _, path := range paths {
+			if pathStr, ok := path.(string); ok {
+				r.paths = append(r.paths, parseRedactPaths(pathStr)...)
+			}
+		}
+	}
+
+	// Pick up secrets already present in node results (e.g. session IDs)
+	r.collect(we.context.NodeResults, nil)
+	return r
+}
+
+// RedactedResults returns the node results with secrets masked.
+func (we *WorkflowEngine) RedactedResults() map[string]interface{} {
+	return we.Redactor().Value(we.context.NodeResults).(map[string]interface{})
+}
+
+// redactError returns the text of an error with secrets masked, for logs and API responses.
+func (we *WorkflowEngine) redactError(err error) string {
+	return we.Redactor().String(err.Error())
+}
+
+// isWorkflowSecretKey reports whether a key of a workflow definition holds a secret.
+func isWorkflowSecretKey(key string) bool {
+	return isSecretField(key) || secretKeyRegex.MatchString(key)
+}
+
+// redactWorkflow returns a copy of a stored workflow definition for the API.
+// Literal values of secret looking keys are masked, along with copies of them
+// elsewhere in the definition. Templates are kept, since they only name the
+// credential or config entry that holds the secret.
+func redactWorkflow(workflowData map[string]interface{}) (map[string]interface{}, error) {
+	data, err := copyWorkflowData(workflowData)
+	if err != nil {
+		return nil, err
+	}
+	r := NewRedactor(nil, nil)
+	collectWorkflowSecrets(data, r)
+	return maskWorkflowSecrets(data, r).(map[string]interface{}), nil
+}
+
+// copyWorkflowData round-trips a workflow through JSON to get a private copy
+// made of plain maps and slices, whatever types it was decoded into.
+func copyWorkflowData(workflowData map[string]interface{}) (map[string]interface{}, error) {
+	raw, err := json.Marshal(workflowData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal workflow: %w", err)
+	}
+	var data map[string]interface{}
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, fmt.Errorf("failed to copy workflow: %w", err)
+	}
+	return data, nil
+}
+
+// collectWorkflowSecrets remembers the literal values of secret looking keys.
+func collectWorkflowSecrets(value interface{}, r *Redactor) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if str, ok := val.(string); ok && isWorkflowSecretKey(key) && !isTemplateString(str) {
+				r.addValue(str)
+				continue
+			}
+			collectWorkflowSecrets(val, r)
+		}
+	case []interface{}:
+		for _, val := range v {
+			collectWorkflowSecrets(val, r)
+		}
+	}
+}
+
+// maskWorkflowSecrets masks a workflow definition in place.
+func maskWorkflowSecrets(value interface{}, r *Redactor) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, val := range v {
+			if str, ok := val.(string); ok && str != "" && isWorkflowSecretKey(key) && !isTemplateString(str) {
+				v[key] = redactedValue
+				continue
+			}
+			v[key] = maskWorkflowSecrets(val, r)
+		}
+		return v
+	case []interface{}:
+		for i, val := range v {
+			v[i] = maskWorkflowSecrets(val, r)
+		}
+		return v
+	case string:
+		if isTemplateString(v) {
+			// Only known values; the free text patterns would mask "Bearer {{...}}"
+			for _, secret := range r.values {
+				v = strings.ReplaceAll(v, secret, redactedValue)
+			}
+			return v
+		}
+		return r.String(v)
+	default:
+		return v
+	}
+}
+
+// restoreWorkflowSecrets puts the stored secrets back into a workflow that was
+// edited from its redacted copy, so that saving it does not overwrite them with
+// the mask. A string is restored when the stored value at the same place masks
+// to it; nodes are matched by ID so that reordering them does not matter.
+func restoreWorkflowSecrets(workflowData, stored map[string]interface{}) error {
+	stored, err := copyWorkflowData(stored)
+	if err != nil {
+		return err
+	}
+	redacted, err := redactWorkflow(stored)
+	if err != nil {
+		return err
+	}
+	restoreRedacted(workflowData, stored, redacted)
+	return nil
+}
+
+// restoreRedacted replaces masked strings in value with the stored value whose
+// redacted form they equal.
+func restoreRedacted(value, stored, redacted interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		storedMap, _ := stored.(map[string]interface{})
+		redactedMap, _ := redacted.(map[string]interface{})
+		for key, val := range v {
+			v[key] = restoreRedacted(val, storedMap[key], redactedMap[key])
+		}
+		return v
+	case []interface{}:
+		storedList, _ := stored.([]interface{})
+		redactedList, _ := redacted.([]interface{})
+		for i, val := range v {
+			if j := matchingWorkflowItem(val, storedList, i); j >= 0 && j < len(redactedList) {
+				v[i] = restoreRedacted(val, storedList[j], redactedList[j])
+			}
+		}
+		return v
+	case string:
+		if strings.Contains(v, redactedValue) && stored != nil && v == redacted {
+			return stored
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// matchingWorkflowItem returns the index of the stored array item that
+// corresponds to item: the one with the same ID, or the one at the same index
+// for items without an ID.
+func matchingWorkflowItem(item interface{}, stored []interface{}, index int) int {
+	itemMap, _ := item.(map[string]interface{})
+	id, _ := itemMap["id"].(string)
+	if id == "" {
+		if index < len(stored) {
+			return index
+		}
+		return -1
+	}
+	for j, candidate := range stored {
+		candidateMap, _ := candidate.(map[string]interface{})
+		if candidateID, _ := candidateMap["id"].(string); candidateID == id {
+			return j
+		}
+	}
+	return -1
+}
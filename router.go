@@ -2,7 +2,13 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
+	"io"
+	"log"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 )
@@ -20,7 +26,49 @@ func RegisterRoutes(router *gin.Engine) {
 	router.GET("/api/v1/get/:workflowID", GetWorkflow)          // Retrieve a workflow
 	router.DELETE("/api/v1/delete/:workflowID", DeleteWorkflow) // Delete a workflow
 	router.GET("/api/v1/get_all", GetAllWorkflows)              // Get all workflow IDs
+	router.GET("/api/v1/workflows", ListWorkflows)              // List workflows with metadata
 	router.POST("/api/v1/run/:workflowID", RunWorkflow)         // Execute a workflow
+
+	// Credential management endpoints (secret values are never returned)
+	router.GET("/api/v1/credentials", ListCredentials)                  // List credentials
+	router.POST("/api/v1/credentials", CreateCredential)                // Create a credential
+	router.GET("/api/v1/credentials/:name", GetCredential)              // Get credential metadata
+	router.PUT("/api/v1/credentials/:name", UpdateCredential)           // Replace (rotate) credential values
+	router.DELETE("/api/v1/credentials/:name", DeleteCredential)        // Delete a credential
+	router.POST("/api/v1/credentials/rotate_key", RotateCredentialKeys) // Re-encrypt with the current master key
+
+	// Downstream system health
+	router.GET("/api/v1/circuit_breakers", ListCircuitBreakers)              // List circuit breaker states
+	router.POST("/api/v1/circuit_breakers/:key/reset", ResetCircuitBreakers) // Close a circuit breaker
+
+	// Database connection pools
+	router.GET("/api/v1/databases", ListDatabasePools)              // List open pools with their statistics
+	router.POST("/api/v1/databases/:name/check", CheckDatabasePool) // Ping the database of a credential
+
+	// Field mappings shared by workflows
+	router.GET("/api/v1/mappings", ListFieldMappings)                       // List the latest version of each mapping
+	router.POST("/api/v1/mappings", CreateFieldMapping)                     // Create a mapping
+	router.POST("/api/v1/mappings/validate", ValidateFieldMapping)          // Validate a mapping and preview it on samples
+	router.GET("/api/v1/mappings/:name", GetFieldMapping)                   // Get the latest or a given version
+	router.PUT("/api/v1/mappings/:name", UpdateFieldMapping)                // Save a new version
+	router.GET("/api/v1/mappings/:name/versions", ListFieldMappingVersions) // List the versions of a mapping
+	router.DELETE("/api/v1/mappings/:name", DeleteFieldMapping)             // Delete a mapping with all its versions
+
+	// Lookup tables used by the lookup template function and node
+	router.GET("/api/v1/lookups", ListLookupTables)                    // List lookup tables
+	router.POST("/api/v1/lookups", CreateLookupTable)                  // Create a lookup table
+	router.GET("/api/v1/lookups/:name", GetLookupTable)                // Get a lookup table with its entries
+	router.PUT("/api/v1/lookups/:name", UpdateLookupTable)             // Replace a lookup table
+	router.PATCH("/api/v1/lookups/:name/entries", UpdateLookupEntries) // Set or remove individual entries
+	router.POST("/api/v1/lookups/:name/import", ImportLookupTable)     // Import entries from a CSV file
+	router.DELETE("/api/v1/lookups/:name", DeleteLookupTable)          // Delete a lookup table
+
+	// Template functions
+	router.GET("/api/v1/functions", ListTemplateFunctions) // List template functions with their signatures
+
+	// Promotion between environments
+	router.GET("/api/v1/export", ExportWorkflows)  // Export workflows as a bundle
+	router.POST("/api/v1/import", ImportWorkflows) // Import a bundle
 }
 
 // HealthCheck returns the health status of the API
@@ -62,6 +110,16 @@ func SaveWorkflow(c *gin.Context) {
 		return
 	}
 
+	// A workflow edited from its redacted copy keeps the stored secrets
+	if stored, err := GetWorkflowFromDB(workflowID); err == nil {
+		if err := restoreWorkflowSecrets(workflowData, stored); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to save workflow: " + err.Error(),
+			})
+			return
+		}
+	}
+
 	// Save the workflow to MongoDB
 	err := SaveWorkflowToDB(workflowID, workflowData)
 	if err != nil {
@@ -108,8 +166,15 @@ func GetWorkflow(c *gin.Context) {
 		return
 	}
 
-	// Respond with the retrieved workflow
-	c.JSON(http.StatusOK, workflow)
+	// Respond with the retrieved workflow, without its secrets
+	redacted, err := redactWorkflow(workflow)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to retrieve workflow: " + err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, redacted)
 }
 
 // DeleteWorkflow deletes a workflow from MongoDB
@@ -167,6 +232,687 @@ func GetAllWorkflows(c *gin.Context) {
 	})
 }
 
+// ListWorkflows returns a page of workflow summaries.
+// Query parameters: q (text search), tags (comma separated, all must match),
+// active (true/false), sort (name, createdAt, updatedAt; "-" prefix for descending),
+// limit and cursor (from the previous page's nextCursor).
+func ListWorkflows(c *gin.Context) {
+	query := WorkflowListQuery{
+		Search: strings.TrimSpace(c.Query("q")),
+		Sort:   c.Query("sort"),
+		Cursor: c.Query("cursor"),
+	}
+
+	// Collect the tag filters, ignoring empty entries
+	if tags := c.Query("tags"); tags != "" {
+		for _, tag := range strings.Split(tags, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				query.Tags = append(query.Tags, tag)
+			}
+		}
+	}
+
+	if active := c.Query("active"); active != "" {
+		activeVal, err := strconv.ParseBool(active)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "active must be true or false",
+			})
+			return
+		}
+		query.Active = &activeVal
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		limitVal, err := strconv.ParseInt(limit, 10, 64)
+		if err != nil || limitVal <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "limit must be a positive integer",
+			})
+			return
+		}
+		query.Limit = limitVal
+	}
+
+	// Retrieve the requested page from MongoDB
+	workflows, nextCursor, err := ListWorkflowsFromDB(query)
+	if err != nil {
+		if strings.HasPrefix(err.Error(), "invalid") {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to list workflows: " + err.Error(),
+		})
+		return
+	}
+
+	// Respond with the page and the cursor for the next one
+	c.JSON(http.StatusOK, gin.H{
+		"workflows":  workflows,
+		"count":      len(workflows),
+		"nextCursor": nextCursor,
+	})
+}
+
+// respondCredentialError maps credential store errors to HTTP responses
+func respondCredentialError(c *gin.Context, action string, err error) {
+	switch {
+	case errors.Is(err, ErrCredentialNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Credential not found",
+		})
+	case errors.Is(err, ErrCredentialStoreDisabled):
+		c.JSON(http.StatusServiceUnavailable, gin.H{
+			"error": err.Error(),
+		})
+	case strings.HasPrefix(err.Error(), "invalid"), strings.HasSuffix(err.Error(), "already exists"):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to " + action + " credential: " + err.Error(),
+		})
+	}
+}
+
+// ListCredentials returns the metadata of all stored credentials
+func ListCredentials(c *gin.Context) {
+	credentials, err := ListCredentialsFromDB()
+	if err != nil {
+		respondCredentialError(c, "list", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"credentials": credentials,
+		"count":       len(credentials),
+	})
+}
+
+// CreateCredential encrypts and stores a new credential
+func CreateCredential(c *gin.Context) {
+	var input CredentialInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid JSON format: " + err.Error(),
+		})
+		return
+	}
+
+	info, err := SaveCredentialToDB(input, true)
+	if err != nil {
+		respondCredentialError(c, "save", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message":    "Credential saved successfully",
+		"credential": info,
+	})
+}
+
+// GetCredential returns the metadata of a credential
+func GetCredential(c *gin.Context) {
+	info, err := GetCredentialInfoFromDB(c.Param("name"))
+	if err != nil {
+		respondCredentialError(c, "retrieve", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, info)
+}
+
+// UpdateCredential replaces the values of a credential. Workflows reference
+// credentials by name, so rotating a secret needs no workflow changes.
+func UpdateCredential(c *gin.Context) {
+	var input CredentialInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid JSON format: " + err.Error(),
+		})
+		return
+	}
+	input.Name = c.Param("name")
+
+	info, err := SaveCredentialToDB(input, false)
+	if err != nil {
+		respondCredentialError(c, "update", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":    "Credential updated successfully",
+		"credential": info,
+	})
+}
+
+// DeleteCredential deletes a credential
+func DeleteCredential(c *gin.Context) {
+	name := c.Param("name")
+	if err := DeleteCredentialFromDB(name); err != nil {
+		respondCredentialError(c, "delete", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Credential deleted successfully",
+		"name":    name,
+	})
+}
+
+// RotateCredentialKeys re-encrypts all credentials with the current master key
+func RotateCredentialKeys(c *gin.Context) {
+	rotated, err := RotateCredentialMasterKey()
+	if err != nil {
+		respondCredentialError(c, "rotate", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Credentials re-encrypted",
+		"rotated": rotated,
+	})
+}
+
+// ListCircuitBreakers returns the state of the circuit breaker of every system called so far
+func ListCircuitBreakers(c *gin.Context) {
+	states := CircuitBreakerStates()
+
+	c.JSON(http.StatusOK, gin.H{
+		"circuitBreakers": states,
+		"count":           len(states),
+	})
+}
+
+// ResetCircuitBreakers closes a circuit breaker so that requests are sent again
+func ResetCircuitBreakers(c *gin.Context) {
+	key := c.Param("key")
+	if !ResetCircuitBreaker(key) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Circuit breaker not found: " + key,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Circuit breaker reset",
+		"key":     key,
+	})
+}
+
+// ListDatabasePools returns the status and statistics of every open database pool
+func ListDatabasePools(c *gin.Context) {
+	pools := SQLPoolStatuses()
+
+	c.JSON(http.StatusOK, gin.H{
+		"databases": pools,
+		"count":     len(pools),
+	})
+}
+
+// CheckDatabasePool opens the pool of a database credential if needed and pings it
+func CheckDatabasePool(c *gin.Context) {
+	status, err := CheckSQLPool(c.Param("name"))
+	if err != nil {
+		respondCredentialError(c, "check", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, status)
+}
+
+// ListTemplateFunctions returns the functions that can be used in template pipelines
+func ListTemplateFunctions(c *gin.Context) {
+	functions := TemplateFunctions()
+
+	c.JSON(http.StatusOK, gin.H{
+		"functions": functions,
+		"count":     len(functions),
+	})
+}
+
+// respondFieldMappingError maps field mapping store errors to HTTP responses
+func respondFieldMappingError(c *gin.Context, action string, err error) {
+	switch {
+	case errors.Is(err, ErrFieldMappingNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Field mapping not found",
+		})
+	case strings.HasPrefix(err.Error(), "invalid"), strings.HasSuffix(err.Error(), "already exists"):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+	case strings.Contains(err.Error(), "changed concurrently"):
+		c.JSON(http.StatusConflict, gin.H{
+			"error": err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to " + action + " field mapping: " + err.Error(),
+		})
+	}
+}
+
+// ListFieldMappings returns the latest version of every field mapping
+func ListFieldMappings(c *gin.Context) {
+	mappings, err := ListFieldMappingsFromDB()
+	if err != nil {
+		respondFieldMappingError(c, "list", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"mappings": mappings,
+		"count":    len(mappings),
+	})
+}
+
+// CreateFieldMapping stores the first version of a field mapping
+func CreateFieldMapping(c *gin.Context) {
+	var mapping FieldMapping
+	if err := c.ShouldBindJSON(&mapping); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid JSON format: " + err.Error(),
+		})
+		return
+	}
+
+	saved, err := SaveFieldMappingToDB(mapping, true)
+	if err != nil {
+		respondFieldMappingError(c, "save", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Field mapping saved successfully",
+		"mapping": saved,
+	})
+}
+
+// GetFieldMapping returns the latest version of a field mapping, or the one
+// given by the version query parameter
+func GetFieldMapping(c *gin.Context) {
+	version := 0
+	if v := c.Query("version"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid version: " + v,
+			})
+			return
+		}
+		version = n
+	}
+
+	mapping, err := GetFieldMappingFromDB(c.Param("name"), version)
+	if err != nil {
+		respondFieldMappingError(c, "retrieve", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, mapping)
+}
+
+// UpdateFieldMapping saves a new version of a field mapping. Map nodes that
+// do not pin a version use it from their next run.
+func UpdateFieldMapping(c *gin.Context) {
+	var mapping FieldMapping
+	if err := c.ShouldBindJSON(&mapping); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid JSON format: " + err.Error(),
+		})
+		return
+	}
+	mapping.Name = c.Param("name")
+
+	saved, err := SaveFieldMappingToDB(mapping, false)
+	if err != nil {
+		respondFieldMappingError(c, "update", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Field mapping updated successfully",
+		"mapping": saved,
+	})
+}
+
+// ListFieldMappingVersions returns the versions of a field mapping, newest first
+func ListFieldMappingVersions(c *gin.Context) {
+	versions, err := ListFieldMappingVersionsFromDB(c.Param("name"))
+	if err != nil {
+		respondFieldMappingError(c, "list", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"versions": versions,
+		"count":    len(versions),
+	})
+}
+
+// DeleteFieldMapping deletes a field mapping with all its versions
+func DeleteFieldMapping(c *gin.Context) {
+	name := c.Param("name")
+	if err := DeleteFieldMappingFromDB(name); err != nil {
+		respondFieldMappingError(c, "delete", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Field mapping deleted successfully",
+		"name":    name,
+	})
+}
+
+// ValidateFieldMapping checks a field mapping without saving it and applies it
+// to the items in samples
+func ValidateFieldMapping(c *gin.Context) {
+	var request struct {
+		FieldMapping
+		Samples []interface{} `json:"samples"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid JSON format: " + err.Error(),
+		})
+		return
+	}
+
+	problems, results := PreviewFieldMapping(request.FieldMapping, request.Samples)
+	c.JSON(http.StatusOK, gin.H{
+		"valid":    len(problems) == 0,
+		"problems": problems,
+		"results":  results,
+	})
+}
+
+// respondLookupTableError maps lookup table store errors to HTTP responses
+func respondLookupTableError(c *gin.Context, action string, err error) {
+	switch {
+	case errors.Is(err, ErrLookupTableNotFound):
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": "Lookup table not found",
+		})
+	case strings.HasPrefix(err.Error(), "invalid"), strings.HasSuffix(err.Error(), "already exists"):
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to " + action + " lookup table: " + err.Error(),
+		})
+	}
+}
+
+// ListLookupTables returns the metadata of all lookup tables
+func ListLookupTables(c *gin.Context) {
+	tables, err := ListLookupTablesFromDB()
+	if err != nil {
+		respondLookupTableError(c, "list", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"lookups": tables,
+		"count":   len(tables),
+	})
+}
+
+// CreateLookupTable stores a new lookup table
+func CreateLookupTable(c *gin.Context) {
+	var input LookupTableInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid JSON format: " + err.Error(),
+		})
+		return
+	}
+
+	table, err := SaveLookupTableToDB(input, true)
+	if err != nil {
+		respondLookupTableError(c, "save", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"message": "Lookup table saved successfully",
+		"lookup":  table,
+	})
+}
+
+// GetLookupTable returns a lookup table with its entries
+func GetLookupTable(c *gin.Context) {
+	table, err := GetLookupTableFromDB(c.Param("name"))
+	if err != nil {
+		respondLookupTableError(c, "retrieve", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, table)
+}
+
+// UpdateLookupTable replaces the description and entries of a lookup table
+func UpdateLookupTable(c *gin.Context) {
+	var input LookupTableInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid JSON format: " + err.Error(),
+		})
+		return
+	}
+	input.Name = c.Param("name")
+
+	table, err := SaveLookupTableToDB(input, false)
+	if err != nil {
+		respondLookupTableError(c, "update", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Lookup table updated successfully",
+		"lookup":  table,
+	})
+}
+
+// UpdateLookupEntries sets the entries in set and removes the keys in remove
+func UpdateLookupEntries(c *gin.Context) {
+	var request struct {
+		Set    map[string]interface{} `json:"set"`
+		Remove []string               `json:"remove"`
+	}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid JSON format: " + err.Error(),
+		})
+		return
+	}
+
+	table, err := UpdateLookupEntriesInDB(c.Param("name"), request.Set, request.Remove)
+	if err != nil {
+		respondLookupTableError(c, "update", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Lookup table updated successfully",
+		"lookup":  table,
+	})
+}
+
+// ImportLookupTable reads a CSV file from the request body (or the "file" field
+// of a multipart form) into a lookup table, creating it if needed. The query
+// parameters keyColumn, valueColumn, header=false, mode=merge and description
+// control the import; by default the entries replace those of the table.
+func ImportLookupTable(c *gin.Context) {
+	var body io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/") {
+		file, err := c.FormFile("file")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Missing file: " + err.Error(),
+			})
+			return
+		}
+		f, err := file.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Failed to read uploaded file: " + err.Error(),
+			})
+			return
+		}
+		defer f.Close()
+		body = f
+	}
+
+	opts := LookupCSVOptions{
+		KeyColumn:   c.Query("keyColumn"),
+		ValueColumn: c.Query("valueColumn"),
+		NoHeader:    c.Query("header") == "false",
+		Merge:       c.Query("mode") == "merge",
+		Description: c.Query("description"),
+	}
+	if mode := c.Query("mode"); mode != "" && mode != "merge" && mode != "replace" {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid mode: expected replace or merge",
+		})
+		return
+	}
+
+	table, imported, err := ImportLookupCSV(c.Param("name"), body, opts)
+	if err != nil {
+		respondLookupTableError(c, "import", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message":  "Lookup table imported successfully",
+		"imported": imported,
+		"lookup":   table,
+	})
+}
+
+// DeleteLookupTable deletes a lookup table
+func DeleteLookupTable(c *gin.Context) {
+	name := c.Param("name")
+	if err := DeleteLookupTableFromDB(name); err != nil {
+		respondLookupTableError(c, "delete", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Lookup table deleted successfully",
+		"name":    name,
+	})
+}
+
+// ExportWorkflows packages workflows into a bundle with environment specific values
+// replaced by placeholders. The ids query parameter takes a comma separated list of
+// workflow IDs; all workflows are exported when it is omitted.
+func ExportWorkflows(c *gin.Context) {
+	var workflowIDs []string
+	if ids := c.Query("ids"); ids != "" {
+		seen := make(map[string]bool)
+		for _, id := range strings.Split(ids, ",") {
+			if id = strings.TrimSpace(id); id != "" && !seen[id] {
+				seen[id] = true
+				workflowIDs = append(workflowIDs, id)
+			}
+		}
+	}
+
+	// Retrieve the workflows from MongoDB
+	docs, err := GetWorkflowDocumentsFromDB(workflowIDs)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Failed to retrieve workflows: " + err.Error(),
+		})
+		return
+	}
+
+	// Report requested IDs that do not exist
+	if len(workflowIDs) > 0 && len(docs) != len(workflowIDs) {
+		found := make(map[string]bool)
+		for _, doc := range docs {
+			found[doc.WorkflowID] = true
+		}
+		var missing []string
+		for _, id := range workflowIDs {
+			if !found[id] {
+				missing = append(missing, id)
+			}
+		}
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Workflow not found",
+			"missing": missing,
+		})
+		return
+	}
+
+	// Build the bundle
+	exporter := newBundleExporter()
+	for _, doc := range docs {
+		if err := exporter.Add(doc.WorkflowID, doc.UpdatedAt, doc.WorkflowData); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": "Failed to export workflow: " + err.Error(),
+			})
+			return
+		}
+	}
+
+	exporter.DescribeCredentials()
+
+	// Respond with the bundle as a downloadable file
+	filename := "workflows-" + time.Now().UTC().Format("20060102-150405") + ".bundle.json"
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.JSON(http.StatusOK, exporter.Bundle())
+}
+
+// ImportWorkflows saves the workflows of a bundle, substituting placeholder values
+// for the target environment and handling ID conflicts as requested
+func ImportWorkflows(c *gin.Context) {
+	// Parse the import request
+	var req BundleImportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid JSON format: " + err.Error(),
+		})
+		return
+	}
+
+	if len(req.Bundle.Workflows) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Bundle contains no workflows",
+		})
+		return
+	}
+
+	// Import the workflows
+	results, err := ImportWorkflowBundle(req)
+	if err != nil {
+		status := http.StatusBadRequest
+		if results != nil {
+			// Failed part way through, after the request was validated
+			status = http.StatusInternalServerError
+		}
+		c.JSON(status, gin.H{
+			"error":   "Failed to import bundle: " + err.Error(),
+			"results": results,
+		})
+		return
+	}
+
+	// Respond with the outcome for each workflow
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Bundle imported",
+		"results": results,
+	})
+}
+
 // RunWorkflow retrieves and executes a workflow
 func RunWorkflow(c *gin.Context) {
 	// Extract workflowID from the URL parameter
@@ -225,21 +971,32 @@ func RunWorkflow(c *gin.Context) {
 		return
 	}
 
-	// Execute the workflow
-	if err := engine.Execute(); err != nil {
+	// Execute the workflow and record the outcome for the listing.
+	// Errors and results are redacted before they leave the engine.
+	summary := ExecutionSummary{Status: "success", StartedAt: time.Now()}
+	execErr := engine.Execute()
+	summary.FinishedAt = time.Now()
+	execErrMsg := ""
+	if execErr != nil {
+		execErrMsg = engine.redactError(execErr)
+		summary.Status = "error"
+		summary.Error = execErrMsg
+	}
+	if err := RecordWorkflowExecution(workflowID, summary); err != nil {
+		log.Printf("Warning: %v", err)
+	}
+
+	if execErr != nil {
 		// Return error if execution fails
 		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":      "Workflow execution failed: " + err.Error(),
+			"error":      "Workflow execution failed: " + execErrMsg,
 			"workflowID": workflowID,
 		})
 		return
 	}
 
-	// Prepare execution results
-	results := make(map[string]interface{})
-	for nodeID, result := range engine.context.NodeResults {
-		results[nodeID] = result
-	}
+	// Prepare execution results with secrets masked
+	results := engine.RedactedResults()
 
 	// Respond with execution results
 	c.JSON(http.StatusOK, gin.H{
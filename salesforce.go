@@ -0,0 +1,781 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// salesforceDefaultAPIVersion is used when the node has no apiVersion
+	salesforceDefaultAPIVersion = "v64.0"
+	// salesforceCompositeBatchSize is the record limit of one sObject Collections request
+	salesforceCompositeBatchSize = 200
+	// salesforceDefaultMaxPages bounds how many nextRecordsUrl pages a query follows
+	salesforceDefaultMaxPages = 50
+	// salesforceBulkPollInterval is the default wait between Bulk API job status checks
+	salesforceBulkPollInterval = 2 * time.Second
+	// salesforceBulkTimeout is the default time a Bulk API job may take to finish
+	salesforceBulkTimeout = 10 * time.Minute
+)
+
+// salesforceClient sends REST calls for a salesforce node through the engine, so
+// the credential's token is applied and refreshed on 401.
+type salesforceClient struct {
+	we          *WorkflowEngine
+	credential  string
+	httpClient  *http.Client
+	instanceURL string
+	apiVersion  string
+}
+
+// url returns the absolute URL of a path relative to /services/data/{version}.
+// Paths that already start with /services (like nextRecordsUrl) are kept as is.
+func (c *salesforceClient) url(path string) string {
+	if strings.HasPrefix(path, "/services/") {
+		return c.instanceURL + path
+	}
+	return c.instanceURL + "/services/data/" + c.apiVersion + "/" + strings.TrimLeft(path, "/")
+}
+
+// do sends a request with an optional JSON (or, with contentType, raw) body.
+func (c *salesforceClient) do(method, path string, body interface{}, contentType string) (*http.Response, []byte, error) {
+	headers := map[string]interface{}{"Accept": "application/json"}
+
+	var payload []byte
+	switch v := body.(type) {
+	case nil:
+	case []byte:
+		payload = v
+		headers["Content-Type"] = contentType
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to marshal Salesforce request body: %w", err)
+		}
+		payload = data
+		headers["Content-Type"] = "application/json"
+	}
+
+	return c.we.sendHTTPRequest(c.httpClient, method, c.url(path), headers, payload, c.credential)
+}
+
+// salesforceError formats a failed Salesforce response.
+func salesforceError(statusCode int, body []byte) error {
+	return fmt.Errorf("Salesforce request failed with status %d: %s", statusCode, string(body))
+}
+
+// executeSalesforce runs a salesforce node.
+//
+// Operations:
+//   - query:     SOQL query ("soql"), following nextRecordsUrl up to "maxPages";
+//     template values are escaped (see resolveSOQLTemplate)
+//   - get:       GET    sobjects/{sobject}/{id}
+//   - create:    POST   sobjects/{sobject}
+//   - update:    PATCH  sobjects/{sobject}/{id}
+//   - delete:    DELETE sobjects/{sobject}/{id}
+//   - upsert:    PATCH  sobjects/{sobject}/{externalIdField}/{externalId}
+//   - composite: sObject Collections create, update or upsert ("action") of
+//     "records", sent in requests of up to 200 records
+//   - bulk:      Bulk API 2.0 ingest job ("action" insert, update, upsert or
+//     delete) for large volumes of "records"
+//
+// "record" holds the fields of create, update and upsert; "records" may be a
+// template such as "{{$node['rows'].json.value}}" that yields an array.
+func (we *WorkflowEngine) executeSalesforce(node *Node) error {
+	resolvedParams := we.resolveTemplateValue(node.Parameters).(map[string]interface{})
+
+	credentialName, _ := resolvedParams["credential"].(string)
+	if credentialName == "" {
+		return fmt.Errorf("salesforce node requires 'credential'")
+	}
+	if credentialType := we.context.CredentialTypes[credentialName]; !isOAuth2CredentialType(credentialType) {
+		return fmt.Errorf("credential %s has type %s, expected an OAuth2 credential", credentialName, credentialType)
+	}
+
+	instanceURL, _ := resolvedParams["instanceUrl"].(string)
+	if instanceURL == "" {
+		instanceURL, _ = we.context.Credentials[credentialName]["instanceUrl"].(string)
+	}
+	if instanceURL == "" {
+		return fmt.Errorf("credential %s has no instanceUrl", credentialName)
+	}
+
+	apiVersion, _ := resolvedParams["apiVersion"].(string)
+	if apiVersion == "" {
+		apiVersion = salesforceDefaultAPIVersion
+	}
+	if !strings.HasPrefix(apiVersion, "v") {
+		apiVersion = "v" + apiVersion
+	}
+
+	httpClient, err := we.httpClient(resolvedParams, credentialName)
+	if err != nil {
+		return err
+	}
+
+	client := &salesforceClient{
+		we:          we,
+		credential:  credentialName,
+		httpClient:  httpClient,
+		instanceURL: strings.TrimRight(instanceURL, "/"),
+		apiVersion:  apiVersion,
+	}
+
+	// Records keep their types, e.g. an array produced by a previous node
+	record := we.resolveTemplateRaw(node.Parameters["record"])
+	records := we.resolveTemplateRaw(node.Parameters["records"])
+
+	if soql, ok := node.Parameters["soql"].(string); ok {
+		if resolvedParams["soql"], err = we.resolveSOQLTemplate(soql); err != nil {
+			return err
+		}
+	}
+
+	operation, _ := resolvedParams["operation"].(string)
+	var result map[string]interface{}
+	switch operation {
+	case "query":
+		result, err = client.query(resolvedParams)
+	case "get", "create", "update", "delete", "upsert":
+		result, err = client.sobject(operation, resolvedParams, record)
+	case "composite":
+		result, err = client.composite(resolvedParams, records)
+	case "bulk":
+		result, err = client.bulk(resolvedParams, records)
+	default:
+		return fmt.Errorf("unsupported salesforce operation: %s", operation)
+	}
+	if result != nil {
+		we.context.NodeResults[node.ID] = result
+	}
+	return err
+}
+
+// soqlTokenRegex matches the values that may be inserted into SOQL outside
+// quotes: numbers, dates, date literals such as LAST_N_DAYS:7 and field names
+var soqlTokenRegex = regexp.MustCompile(`^[\w.:+-]*$`)
+
+// resolveSOQLTemplate resolves the templates of a SOQL query. Values between
+// single quotes are escaped as string literals and values elsewhere must be
+// plain tokens, so data cannot change the query. Values piped through
+// soqlString or raw are inserted as is.
+func (we *WorkflowEngine) resolveSOQLTemplate(template string) (string, error) {
+	var out strings.Builder
+	quoted := false // Inside a string literal of the template text
+	last := 0
+
+	for _, match := range templateRegex.FindAllStringSubmatchIndex(template, -1) {
+		literal := template[last:match[0]]
+		for i := 0; i < len(literal); i++ {
+			if literal[i] == '\\' {
+				i++
+			} else if literal[i] == '\'' {
+				quoted = !quoted
+			}
+		}
+		out.WriteString(literal)
+		last = match[1]
+
+		expr := template[match[2]:match[3]]
+		value := fmt.Sprintf("%v", we.resolveExpression(expr))
+		funcs := pipelineFunctions(expr)
+		switch {
+		case funcs["raw"] || funcs["soqlString"]:
+		case quoted:
+			value = soqlString(value)
+		case !soqlTokenRegex.MatchString(value):
+			return "", fmt.Errorf("value of {{%s}} cannot be used outside quotes in SOQL: %q", strings.TrimSpace(expr), value)
+		}
+		out.WriteString(value)
+	}
+
+	out.WriteString(template[last:])
+	return out.String(), nil
+}
+
+// query runs a SOQL query and collects the records of every page.
+func (c *salesforceClient) query(params map[string]interface{}) (map[string]interface{}, error) {
+	soql, _ := params["soql"].(string)
+	if soql == "" {
+		return nil, fmt.Errorf("salesforce query requires 'soql'")
+	}
+
+	maxPages := salesforceDefaultMaxPages
+	if value, ok := params["maxPages"]; ok {
+		n, err := toNumber(value)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("invalid salesforce maxPages: %v", value)
+		}
+		maxPages = int(n)
+	}
+
+	// queryAll also returns deleted and archived records
+	resource := "query"
+	if includeDeleted, _ := toBoolean(params["includeDeleted"]); includeDeleted {
+		resource = "queryAll"
+	}
+
+	var page struct {
+		TotalSize      int           `json:"totalSize"`
+		Done           bool          `json:"done"`
+		NextRecordsURL string        `json:"nextRecordsUrl"`
+		Records        []interface{} `json:"records"`
+	}
+
+	path := resource + "?q=" + escapeODataQueryValue(soql)
+	var records []interface{}
+	pages := 0
+	for {
+		resp, respBody, err := c.do(http.MethodGet, path, nil, "")
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode >= 400 {
+			return buildHTTPResult(resp.StatusCode, resp.Header, respBody), salesforceError(resp.StatusCode, respBody)
+		}
+
+		page.NextRecordsURL = ""
+		page.Records = nil
+		if err := json.Unmarshal(respBody, &page); err != nil {
+			return nil, fmt.Errorf("failed to parse Salesforce query page %d: %w", pages+1, err)
+		}
+		records = append(records, page.Records...)
+		pages++
+
+		if page.Done || page.NextRecordsURL == "" || pages >= maxPages {
+			break
+		}
+		path = page.NextRecordsURL
+	}
+
+	if records == nil {
+		records = []interface{}{}
+	}
+	combined := map[string]interface{}{
+		"totalSize": page.TotalSize,
+		"done":      page.Done,
+		"records":   records,
+	}
+
+	result := map[string]interface{}{
+		"json":      combined,
+		"totalSize": page.TotalSize,
+		"done":      page.Done,
+		"records":   records,
+		"rowCount":  len(records),
+		"pageCount": pages,
+		"hasMore":   !page.Done,
+	}
+	// Like other collection results, the first row is available directly
+	if len(records) > 0 {
+		if first, ok := records[0].(map[string]interface{}); ok {
+			for key, value := range first {
+				if key != "attributes" {
+					result[key] = value
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// sobject runs a single record operation.
+func (c *salesforceClient) sobject(operation string, params map[string]interface{}, record interface{}) (map[string]interface{}, error) {
+	sobject, _ := params["sobject"].(string)
+	if sobject == "" {
+		return nil, fmt.Errorf("salesforce %s requires 'sobject'", operation)
+	}
+	path := "sobjects/" + url.PathEscape(sobject)
+
+	recordID := func() (string, error) {
+		id := fmt.Sprintf("%v", params["id"])
+		if params["id"] == nil || id == "" {
+			return "", fmt.Errorf("salesforce %s requires 'id'", operation)
+		}
+		return "/" + url.PathEscape(id), nil
+	}
+	fields := func() (map[string]interface{}, error) {
+		fieldMap, ok := record.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("salesforce %s requires 'record' to be an object", operation)
+		}
+		// Salesforce rejects the system fields Id and attributes in the body
+		body := make(map[string]interface{}, len(fieldMap))
+		for key, value := range fieldMap {
+			if key != "attributes" && key != "Id" {
+				body[key] = value
+			}
+		}
+		return body, nil
+	}
+
+	var method string
+	var body interface{}
+	var err error
+	switch operation {
+	case "get":
+		method = http.MethodGet
+		var id string
+		if id, err = recordID(); err == nil {
+			path += id
+			if list := odataList(params["fields"]); list != "" {
+				path += "?fields=" + url.QueryEscape(list)
+			}
+		}
+	case "create":
+		method = http.MethodPost
+		body, err = fields()
+	case "update":
+		method = http.MethodPatch
+		var id string
+		if id, err = recordID(); err == nil {
+			path += id
+			body, err = fields()
+		}
+	case "delete":
+		method = http.MethodDelete
+		var id string
+		if id, err = recordID(); err == nil {
+			path += id
+		}
+	case "upsert":
+		method = http.MethodPatch
+		externalIDField, _ := params["externalIdField"].(string)
+		if externalIDField == "" {
+			return nil, fmt.Errorf("salesforce upsert requires 'externalIdField'")
+		}
+		var fieldMap map[string]interface{}
+		if fieldMap, err = fields(); err != nil {
+			return nil, err
+		}
+		// The external ID may be given explicitly or taken from the record
+		externalID, ok := params["externalId"]
+		if !ok || externalID == nil || externalID == "" {
+			externalID = fieldMap[externalIDField]
+		}
+		if externalID == nil || fmt.Sprintf("%v", externalID) == "" {
+			return nil, fmt.Errorf("salesforce upsert requires a value for %s", externalIDField)
+		}
+		// The external ID is part of the URL and must not be repeated in the body
+		delete(fieldMap, externalIDField)
+		path += "/" + url.PathEscape(externalIDField) + "/" + url.PathEscape(fmt.Sprintf("%v", externalID))
+		body = fieldMap
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp, respBody, err := c.do(method, path, body, "")
+	if err != nil {
+		return nil, err
+	}
+
+	result := buildHTTPResult(resp.StatusCode, resp.Header, respBody)
+	if operation == "upsert" && resp.StatusCode < 400 {
+		// 201 means the record was created, 200/204 that an existing one was updated
+		result["created"] = resp.StatusCode == http.StatusCreated
+	}
+	if id, ok := result["id"]; ok {
+		result["Id"] = id
+	}
+	if resp.StatusCode >= 400 {
+		return result, salesforceError(resp.StatusCode, respBody)
+	}
+	return result, nil
+}
+
+// composite sends records through the sObject Collections API in batches of 200.
+// When a batch fails the results of the batches already sent are returned with
+// the error, and a failed create is not retried.
+func (c *salesforceClient) composite(params map[string]interface{}, records interface{}) (map[string]interface{}, error) {
+	action, _ := params["action"].(string)
+	sobject, _ := params["sobject"].(string)
+	externalIDField, _ := params["externalIdField"].(string)
+	allOrNone, _ := toBoolean(params["allOrNone"])
+
+	rows, err := salesforceRecords(records)
+	if err != nil {
+		return nil, err
+	}
+	if sobject == "" {
+		return nil, fmt.Errorf("salesforce composite requires 'sobject'")
+	}
+
+	var method, path string
+	switch action {
+	case "create":
+		method, path = http.MethodPost, "composite/sobjects"
+	case "update":
+		method, path = http.MethodPatch, "composite/sobjects"
+	case "upsert":
+		if externalIDField == "" {
+			return nil, fmt.Errorf("salesforce composite upsert requires 'externalIdField'")
+		}
+		method, path = http.MethodPatch, "composite/sobjects/"+url.PathEscape(sobject)+"/"+url.PathEscape(externalIDField)
+	default:
+		return nil, fmt.Errorf("unsupported salesforce composite action: %s", action)
+	}
+
+	results := make([]interface{}, 0, len(rows))
+	successCount, failureCount := 0, 0
+	summary := func() map[string]interface{} {
+		return map[string]interface{}{
+			"results":      results,
+			"rowCount":     len(rows),
+			"successCount": successCount,
+			"failureCount": failureCount,
+		}
+	}
+	// Records of a failed create may already exist, so a node retry would
+	// create them again; updates and upserts can safely be repeated
+	failed := func(err error) error {
+		if action == "create" {
+			return fmt.Errorf("%w (%w)", err, ErrNotRetryable)
+		}
+		return err
+	}
+
+	for start := 0; start < len(rows); start += salesforceCompositeBatchSize {
+		end := start + salesforceCompositeBatchSize
+		if end > len(rows) {
+			end = len(rows)
+		}
+
+		batch := make([]interface{}, 0, end-start)
+		for _, row := range rows[start:end] {
+			item := make(map[string]interface{}, len(row)+1)
+			for key, value := range row {
+				item[key] = value
+			}
+			item["attributes"] = map[string]interface{}{"type": sobject}
+			batch = append(batch, item)
+		}
+
+		// The results of earlier batches are returned with the error, since
+		// those records were written
+		resp, respBody, err := c.do(method, path, map[string]interface{}{"allOrNone": allOrNone, "records": batch}, "")
+		if err != nil {
+			return summary(), failed(fmt.Errorf("salesforce composite batch of records %d-%d: %w", start+1, end, err))
+		}
+		if resp.StatusCode >= 400 {
+			result := summary()
+			result["failedBatch"] = buildHTTPResult(resp.StatusCode, resp.Header, respBody)
+			return result, failed(fmt.Errorf("salesforce composite batch of records %d-%d: %w", start+1, end, salesforceError(resp.StatusCode, respBody)))
+		}
+
+		var batchResults []interface{}
+		if err := json.Unmarshal(respBody, &batchResults); err != nil {
+			return summary(), failed(fmt.Errorf("failed to parse Salesforce composite response: %w", err))
+		}
+		for _, item := range batchResults {
+			if itemMap, ok := item.(map[string]interface{}); ok && itemMap["success"] == true {
+				successCount++
+			} else {
+				failureCount++
+			}
+		}
+		results = append(results, batchResults...)
+	}
+
+	result := summary()
+	if ignore, _ := toBoolean(params["ignoreRecordErrors"]); failureCount > 0 && !ignore {
+		return result, failed(fmt.Errorf("salesforce composite %s failed for %d of %d records: %s",
+			action, failureCount, len(rows), firstSalesforceRecordError(results)))
+	}
+	return result, nil
+}
+
+// firstSalesforceRecordError returns the message of the first failed record result.
+func firstSalesforceRecordError(results []interface{}) string {
+	for _, item := range results {
+		itemMap, ok := item.(map[string]interface{})
+		if !ok || itemMap["success"] == true {
+			continue
+		}
+		if errs, ok := itemMap["errors"].([]interface{}); ok && len(errs) > 0 {
+			if first, ok := errs[0].(map[string]interface{}); ok {
+				return fmt.Sprintf("%v: %v", first["statusCode"], first["message"])
+			}
+		}
+	}
+	return "unknown error"
+}
+
+// bulk loads records with a Bulk API 2.0 ingest job and waits for it to finish.
+func (c *salesforceClient) bulk(params map[string]interface{}, records interface{}) (map[string]interface{}, error) {
+	action, _ := params["action"].(string)
+	sobject, _ := params["sobject"].(string)
+	externalIDField, _ := params["externalIdField"].(string)
+
+	switch action {
+	case "insert", "update", "upsert", "delete", "hardDelete":
+	default:
+		return nil, fmt.Errorf("unsupported salesforce bulk action: %s", action)
+	}
+	if sobject == "" {
+		return nil, fmt.Errorf("salesforce bulk requires 'sobject'")
+	}
+	if action == "upsert" && externalIDField == "" {
+		return nil, fmt.Errorf("salesforce bulk upsert requires 'externalIdField'")
+	}
+
+	rows, err := salesforceRecords(records)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return map[string]interface{}{"rowCount": 0, "numberRecordsProcessed": 0, "numberRecordsFailed": 0}, nil
+	}
+	payload, err := buildSalesforceCSV(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	pollInterval := salesforceBulkPollInterval
+	if value, ok := params["pollInterval"]; ok {
+		n, err := toNumber(value)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid salesforce pollInterval: %v", value)
+		}
+		pollInterval = time.Duration(n) * time.Millisecond
+	}
+	timeout := salesforceBulkTimeout
+	if value, ok := params["timeout"]; ok {
+		n, err := toNumber(value)
+		if err != nil || n <= 0 {
+			return nil, fmt.Errorf("invalid salesforce timeout: %v", value)
+		}
+		timeout = time.Duration(n) * time.Second
+	}
+
+	// Create the job
+	jobRequest := map[string]interface{}{
+		"object":      sobject,
+		"operation":   action,
+		"contentType": "CSV",
+		"lineEnding":  "LF",
+	}
+	if action == "upsert" {
+		jobRequest["externalIdFieldName"] = externalIDField
+	}
+	resp, respBody, err := c.do(http.MethodPost, "jobs/ingest", jobRequest, "")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		return buildHTTPResult(resp.StatusCode, resp.Header, respBody), salesforceError(resp.StatusCode, respBody)
+	}
+	var job map[string]interface{}
+	if err := json.Unmarshal(respBody, &job); err != nil {
+		return nil, fmt.Errorf("failed to parse Salesforce bulk job: %w", err)
+	}
+	jobID, _ := job["id"].(string)
+	if jobID == "" {
+		return nil, fmt.Errorf("Salesforce did not return a bulk job id")
+	}
+	jobPath := "jobs/ingest/" + url.PathEscape(jobID)
+
+	// Upload the data and close the job so Salesforce starts processing it
+	resp, respBody, err = c.do(http.MethodPut, jobPath+"/batches", payload, "text/csv")
+	if err == nil && resp.StatusCode < 400 {
+		resp, respBody, err = c.do(http.MethodPatch, jobPath, map[string]interface{}{"state": "UploadComplete"}, "")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		// Abort the job so it does not linger in the Open state
+		c.do(http.MethodPatch, jobPath, map[string]interface{}{"state": "Aborted"}, "")
+		return buildHTTPResult(resp.StatusCode, resp.Header, respBody), salesforceError(resp.StatusCode, respBody)
+	}
+
+	// Poll until the job reaches a final state
+	deadline := time.Now().Add(timeout)
+	for {
+		resp, respBody, err = c.do(http.MethodGet, jobPath, nil, "")
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode >= 400 {
+			return buildHTTPResult(resp.StatusCode, resp.Header, respBody), salesforceError(resp.StatusCode, respBody)
+		}
+		job = nil
+		if err := json.Unmarshal(respBody, &job); err != nil {
+			return nil, fmt.Errorf("failed to parse Salesforce bulk job: %w", err)
+		}
+
+		state, _ := job["state"].(string)
+		if state == "JobComplete" || state == "Failed" || state == "Aborted" {
+			break
+		}
+		if time.Now().After(deadline) {
+			return map[string]interface{}{"jobId": jobID, "state": state},
+				fmt.Errorf("salesforce bulk job %s did not finish within %v (state %s)", jobID, timeout, state)
+		}
+		log.Printf("Salesforce bulk job %s is %s, checking again in %v", jobID, state, pollInterval)
+		time.Sleep(pollInterval)
+	}
+
+	state, _ := job["state"].(string)
+	processed, _ := toNumber(job["numberRecordsProcessed"])
+	failed, _ := toNumber(job["numberRecordsFailed"])
+	result := map[string]interface{}{
+		"json":                   job,
+		"jobId":                  jobID,
+		"state":                  state,
+		"rowCount":               len(rows),
+		"numberRecordsProcessed": int(processed),
+		"numberRecordsFailed":    int(failed),
+	}
+
+	if failed > 0 {
+		resp, respBody, err = c.do(http.MethodGet, jobPath+"/failedResults/", nil, "")
+		if err == nil && resp.StatusCode < 400 {
+			if failedRecords, err := parseSalesforceCSV(respBody); err == nil {
+				result["failedRecords"] = failedRecords
+			}
+		}
+	}
+
+	if state != "JobComplete" {
+		return result, fmt.Errorf("salesforce bulk job %s ended in state %s: %v", jobID, state, job["errorMessage"])
+	}
+	if ignore, _ := toBoolean(params["ignoreRecordErrors"]); failed > 0 && !ignore {
+		return result, fmt.Errorf("salesforce bulk job %s failed for %d of %d records", jobID, int(failed), len(rows))
+	}
+	return result, nil
+}
+
+// salesforceRecords converts the "records" parameter into a list of field maps.
+func salesforceRecords(records interface{}) ([]map[string]interface{}, error) {
+	switch v := records.(type) {
+	case []map[string]interface{}:
+		return v, nil
+	case []interface{}:
+		rows := make([]map[string]interface{}, 0, len(v))
+		for i, item := range v {
+			row, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("salesforce record %d is not an object", i)
+			}
+			rows = append(rows, row)
+		}
+		return rows, nil
+	case string:
+		// A template that rendered an array to text
+		var rows []map[string]interface{}
+		if err := json.Unmarshal([]byte(v), &rows); err != nil {
+			return nil, fmt.Errorf("salesforce 'records' must be an array of objects")
+		}
+		return rows, nil
+	default:
+		return nil, fmt.Errorf("salesforce 'records' must be an array of objects")
+	}
+}
+
+// buildSalesforceCSV renders records as Bulk API CSV. Relationship fields given
+// as nested objects become "Relationship.Field" columns and nil values "#N/A",
+// which Bulk API uses to clear a field.
+func buildSalesforceCSV(rows []map[string]interface{}) ([]byte, error) {
+	flatRows := make([]map[string]string, len(rows))
+	columnSet := make(map[string]bool)
+	for i, row := range rows {
+		flat := make(map[string]string, len(row))
+		for key, value := range row {
+			if key == "attributes" {
+				continue
+			}
+			if nested, ok := value.(map[string]interface{}); ok {
+				for nestedKey, nestedValue := range nested {
+					if nestedKey != "attributes" {
+						flat[key+"."+nestedKey] = salesforceCSVValue(nestedValue)
+					}
+				}
+				continue
+			}
+			flat[key] = salesforceCSVValue(value)
+		}
+		for column := range flat {
+			columnSet[column] = true
+		}
+		flatRows[i] = flat
+	}
+
+	columns := make([]string, 0, len(columnSet))
+	for column := range columnSet {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(columns); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	line := make([]string, len(columns))
+	for _, flat := range flatRows {
+		for i, column := range columns {
+			line[i] = flat[column]
+		}
+		if err := writer.Write(line); err != nil {
+			return nil, fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// salesforceCSVValue renders one field value for Bulk API CSV.
+func salesforceCSVValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "#N/A"
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return strings.Trim(string(data), `"`)
+	}
+}
+
+// parseSalesforceCSV reads a Bulk API result CSV into a list of rows.
+func parseSalesforceCSV(data []byte) ([]interface{}, error) {
+	lines, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+	rows := make([]interface{}, 0, len(lines))
+	for i, line := range lines {
+		if i == 0 {
+			continue
+		}
+		row := make(map[string]interface{}, len(line))
+		for j, value := range line {
+			if j < len(lines[0]) {
+				row[lines[0][j]] = value
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
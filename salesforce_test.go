@@ -0,0 +1,304 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// salesforceStub is a local Salesforce with a client credentials token endpoint.
+// Only the latest token it issued is accepted.
+type salesforceStub struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	tokens      int               // Tokens issued so far
+	tokenForms  []string          // Bodies of the token requests
+	calls       map[string]int    // API calls by path
+	handler     http.HandlerFunc  // Handles authorized API calls
+	revokeFirst bool              // Reject the first token once, as if it had expired
+	revoked     map[string]bool   // Tokens that are no longer accepted
+	headers     map[string]string // Authorization of the last API call
+}
+
+func newSalesforceStub(t *testing.T, handler http.HandlerFunc) *salesforceStub {
+	t.Helper()
+
+	// Loopback addresses are denied by the default outbound policy
+	saved := outboundPolicy
+	outboundPolicy = mustOutboundPolicy("http,https", "", "")
+	t.Cleanup(func() { outboundPolicy = saved })
+
+	stub := &salesforceStub{
+		calls:   make(map[string]int),
+		handler: handler,
+		revoked: make(map[string]bool),
+		headers: make(map[string]string),
+	}
+	stub.Server = httptest.NewServer(http.HandlerFunc(stub.serve))
+	t.Cleanup(stub.Close)
+	return stub
+}
+
+func (s *salesforceStub) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	if r.URL.Path == "/oauth/token" {
+		body, _ := io.ReadAll(r.Body)
+		s.tokens++
+		s.tokenForms = append(s.tokenForms, string(body))
+		token := fmt.Sprintf("token-%d", s.tokens)
+		s.mu.Unlock()
+		writeStubJSON(w, http.StatusOK, map[string]interface{}{
+			"access_token": token,
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"instance_url": s.URL,
+		})
+		return
+	}
+
+	auth := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	s.headers["Authorization"] = r.Header.Get("Authorization")
+	if s.revokeFirst && auth == "token-1" {
+		s.revoked[auth] = true
+	}
+	if auth != fmt.Sprintf("token-%d", s.tokens) || s.revoked[auth] {
+		s.mu.Unlock()
+		writeStubJSON(w, http.StatusUnauthorized, []interface{}{
+			map[string]interface{}{"errorCode": "INVALID_SESSION_ID", "message": "Session expired or invalid"},
+		})
+		return
+	}
+	s.calls[r.URL.Path]++
+	s.mu.Unlock()
+	s.handler(w, r)
+}
+
+func (s *salesforceStub) callCount(path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.calls[path]
+}
+
+func writeStubJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+// newSalesforceTestEngine returns an engine with an oauth2ClientCredentials
+// credential for the stub, named after the test so cached tokens are not shared.
+func newSalesforceTestEngine(t *testing.T, stub *salesforceStub) (*WorkflowEngine, string) {
+	t.Helper()
+	name := strings.ReplaceAll(t.Name(), "/", "_")
+	t.Cleanup(func() { invalidateOAuth2Token(name) })
+
+	we := newDetachedEngine()
+	we.context.Credentials[name] = map[string]interface{}{
+		"tokenUrl":     stub.URL + "/oauth/token",
+		"clientId":     "client-id",
+		"clientSecret": "client-secret",
+		"instanceUrl":  stub.URL,
+	}
+	we.context.CredentialTypes[name] = "oauth2ClientCredentials"
+	return we, name
+}
+
+func TestSalesforceQueryFollowsNextRecordsURL(t *testing.T) {
+	var queries []string
+	stub := newSalesforceStub(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/services/data/v64.0/query":
+			queries = append(queries, r.URL.Query().Get("q"))
+			writeStubJSON(w, http.StatusOK, map[string]interface{}{
+				"totalSize":      3,
+				"done":           false,
+				"nextRecordsUrl": "/services/data/v64.0/query/01gD0000002HU6KIAW-2000",
+				"records": []interface{}{
+					map[string]interface{}{"attributes": map[string]interface{}{"type": "Account"}, "Id": "001A", "Name": "O'Brien & Sons"},
+					map[string]interface{}{"Id": "001B", "Name": "Acme"},
+				},
+			})
+		case "/services/data/v64.0/query/01gD0000002HU6KIAW-2000":
+			writeStubJSON(w, http.StatusOK, map[string]interface{}{
+				"totalSize": 3,
+				"done":      true,
+				"records":   []interface{}{map[string]interface{}{"Id": "001C", "Name": "Globex"}},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	})
+	we, credential := newSalesforceTestEngine(t, stub)
+	we.context.Item = map[string]interface{}{"name": "O'Brien & Sons"}
+
+	node := &Node{ID: "sf", Name: "Query accounts", Type: "salesforce", Parameters: map[string]interface{}{
+		"credential": credential,
+		"operation":  "query",
+		"soql":       "SELECT Id, Name FROM Account WHERE Name != '{{$item.name}}'",
+	}}
+	if err := we.executeSalesforce(node); err != nil {
+		t.Fatalf("query failed: %v", err)
+	}
+
+	result := we.context.NodeResults["sf"]
+	if result["rowCount"] != 3 || result["pageCount"] != 2 || result["done"] != true {
+		t.Errorf("rowCount, pageCount, done = %v, %v, %v, want 3, 2, true", result["rowCount"], result["pageCount"], result["done"])
+	}
+	if result["Id"] != "001A" {
+		t.Errorf("first record Id = %v, want 001A", result["Id"])
+	}
+	if want := `SELECT Id, Name FROM Account WHERE Name != 'O\'Brien & Sons'`; len(queries) != 1 || queries[0] != want {
+		t.Errorf("queries = %q, want [%q]", queries, want)
+	}
+
+	// Authentication: one client credentials token, sent as a bearer token
+	if stub.tokens != 1 {
+		t.Errorf("tokens requested = %d, want 1", stub.tokens)
+	}
+	if form := stub.tokenForms[0]; !strings.Contains(form, "grant_type=client_credentials") || !strings.Contains(form, "client_id=client-id") {
+		t.Errorf("token request = %q, want a client_credentials grant for client-id", form)
+	}
+	if got := stub.headers["Authorization"]; got != "Bearer token-1" {
+		t.Errorf("Authorization = %q, want %q", got, "Bearer token-1")
+	}
+}
+
+func TestSalesforceRefreshesTokenOn401(t *testing.T) {
+	stub := newSalesforceStub(t, func(w http.ResponseWriter, r *http.Request) {
+		writeStubJSON(w, http.StatusOK, map[string]interface{}{"Id": "001A", "Name": "Acme"})
+	})
+	stub.revokeFirst = true
+	we, credential := newSalesforceTestEngine(t, stub)
+
+	node := &Node{ID: "sf", Name: "Get account", Type: "salesforce", Parameters: map[string]interface{}{
+		"credential": credential,
+		"operation":  "get",
+		"sobject":    "Account",
+		"id":         "001A",
+	}}
+	if err := we.executeSalesforce(node); err != nil {
+		t.Fatalf("get failed: %v", err)
+	}
+
+	if stub.tokens != 2 {
+		t.Errorf("tokens requested = %d, want 2 (the initial token and one refresh)", stub.tokens)
+	}
+	if got := stub.callCount("/services/data/v64.0/sobjects/Account/001A"); got != 1 {
+		t.Errorf("authorized calls = %d, want 1", got)
+	}
+	if got := we.context.NodeResults["sf"]["Name"]; got != "Acme" {
+		t.Errorf("Name = %v, want Acme", got)
+	}
+}
+
+func TestSalesforceCompositeSendsBatches(t *testing.T) {
+	var batchSizes []int
+	stub := newSalesforceStub(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			AllOrNone bool                     `json:"allOrNone"`
+			Records   []map[string]interface{} `json:"records"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		batchSizes = append(batchSizes, len(body.Records))
+
+		var results []interface{}
+		for _, record := range body.Records {
+			if record["Name"] == "" {
+				results = append(results, map[string]interface{}{"success": false, "errors": []interface{}{
+					map[string]interface{}{"statusCode": "REQUIRED_FIELD_MISSING", "message": "Required fields are missing: [Name]"},
+				}})
+				continue
+			}
+			results = append(results, map[string]interface{}{"success": true, "id": fmt.Sprintf("001%v", record["Name"])})
+		}
+		writeStubJSON(w, http.StatusOK, results)
+	})
+	we, credential := newSalesforceTestEngine(t, stub)
+
+	records := make([]interface{}, 250)
+	for i := range records {
+		records[i] = map[string]interface{}{"Name": fmt.Sprint(i)}
+	}
+	records[210] = map[string]interface{}{"Name": ""}
+	we.context.NodeResults["rows"] = map[string]interface{}{"records": records}
+
+	node := &Node{ID: "sf", Name: "Upsert accounts", Type: "salesforce", Parameters: map[string]interface{}{
+		"credential":         credential,
+		"operation":          "composite",
+		"action":             "update",
+		"sobject":            "Account",
+		"records":            "{{$node['rows'].records}}",
+		"ignoreRecordErrors": true,
+	}}
+	if err := we.executeSalesforce(node); err != nil {
+		t.Fatalf("composite failed: %v", err)
+	}
+
+	if len(batchSizes) != 2 || batchSizes[0] != 200 || batchSizes[1] != 50 {
+		t.Errorf("batch sizes = %v, want [200 50]", batchSizes)
+	}
+	result := we.context.NodeResults["sf"]
+	if result["successCount"] != 249 || result["failureCount"] != 1 {
+		t.Errorf("successCount, failureCount = %v, %v, want 249, 1", result["successCount"], result["failureCount"])
+	}
+}
+
+func TestSalesforceCompositeCreateKeepsEarlierBatchesAndIsNotRetried(t *testing.T) {
+	stub := newSalesforceStub(t, func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Records []map[string]interface{} `json:"records"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if body.Records[0]["Name"] != "0" {
+			writeStubJSON(w, http.StatusInternalServerError, []interface{}{
+				map[string]interface{}{"errorCode": "UNKNOWN_EXCEPTION", "message": "An unexpected error occurred"},
+			})
+			return
+		}
+		results := make([]interface{}, len(body.Records))
+		for i := range results {
+			results[i] = map[string]interface{}{"success": true, "id": fmt.Sprintf("001%d", i)}
+		}
+		writeStubJSON(w, http.StatusOK, results)
+	})
+	we, credential := newSalesforceTestEngine(t, stub)
+
+	records := make([]interface{}, 250)
+	for i := range records {
+		records[i] = map[string]interface{}{"Name": fmt.Sprint(i)}
+	}
+	we.context.NodeResults["rows"] = map[string]interface{}{"records": records}
+
+	node := &Node{ID: "sf", Name: "Create accounts", Type: "salesforce",
+		Retry: RetryConfig{Enabled: true, MaxAttempts: 3},
+		Parameters: map[string]interface{}{
+			"credential": credential,
+			"operation":  "composite",
+			"action":     "create",
+			"sobject":    "Account",
+			"records":    "{{$node['rows'].records}}",
+		},
+	}
+	err := we.executeNode(node)
+	if !errors.Is(err, ErrNotRetryable) {
+		t.Fatalf("error = %v, want ErrNotRetryable", err)
+	}
+
+	if got := stub.callCount("/services/data/v64.0/composite/sobjects"); got != 2 {
+		t.Errorf("composite requests = %d, want 2 (no retry after the failed batch)", got)
+	}
+	result := we.context.NodeResults["sf"]
+	if results, _ := result["results"].([]interface{}); len(results) != 200 || result["successCount"] != 200 {
+		t.Errorf("results, successCount = %d, %v, want the 200 records of the first batch", len(results), result["successCount"])
+	}
+	if _, ok := result["failedBatch"]; !ok {
+		t.Error("failedBatch is missing from the result")
+	}
+}
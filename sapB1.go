@@ -0,0 +1,540 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// sapMaxIdleSessions is the number of logged in sessions kept per credential
+	sapMaxIdleSessions = 4
+	// sapDefaultSessionTimeout is used when the login response has no SessionTimeout
+	sapDefaultSessionTimeout = 30 * time.Minute
+	// sapSessionSafetyMargin retires sessions shortly before the server would
+	sapSessionSafetyMargin = time.Minute
+)
+
+// sapSession is a logged in Service Layer session.
+type sapSession struct {
+	credential string        // Name of the credential used to log in.
+	client     *http.Client  // Client with the credential's TLS and proxy settings.
+	baseURL    string        // Service Layer root, e.g. https://host:50000/b1s/v1.
+	cookies    string        // Cookie header carrying B1SESSION (and ROUTEID behind a load balancer).
+	timeout    time.Duration // Idle timeout reported by the server.
+	lastUsed   time.Time     // When the session was last used successfully.
+}
+
+// expired reports whether the server has probably dropped the session.
+func (s *sapSession) expired() bool {
+	return time.Since(s.lastUsed) > s.timeout-sapSessionSafetyMargin
+}
+
+// sapSessionPool keeps idle sessions per credential so executions can reuse them
+// instead of logging in and out for every workflow run.
+var sapSessionPool = struct {
+	sync.Mutex
+	idle map[string][]*sapSession
+}{idle: make(map[string][]*sapSession)}
+
+// sapRequestTimeout bounds Service Layer calls, which can be slow for large documents
+const sapRequestTimeout = 60 * time.Second
+
+// acquireSAPSession returns an idle session for the credential or logs in a new one.
+func acquireSAPSession(name string, data map[string]interface{}) (*sapSession, error) {
+	sapSessionPool.Lock()
+	idle := sapSessionPool.idle[name]
+	for len(idle) > 0 {
+		session := idle[len(idle)-1]
+		idle = idle[:len(idle)-1]
+		if !session.expired() {
+			sapSessionPool.idle[name] = idle
+			sapSessionPool.Unlock()
+			return session, nil
+		}
+	}
+	sapSessionPool.idle[name] = idle
+	sapSessionPool.Unlock()
+
+	return loginSAPSession(name, data)
+}
+
+// releaseSAPSession returns a session to the pool, logging out surplus sessions.
+func releaseSAPSession(session *sapSession) {
+	sapSessionPool.Lock()
+	idle := sapSessionPool.idle[session.credential]
+	if len(idle) < sapMaxIdleSessions {
+		sapSessionPool.idle[session.credential] = append(idle, session)
+		sapSessionPool.Unlock()
+		return
+	}
+	sapSessionPool.Unlock()
+
+	go logoutSAPSession(session)
+}
+
+// invalidateSAPSessions logs out and forgets the pooled sessions of a credential.
+func invalidateSAPSessions(name string) {
+	sapSessionPool.Lock()
+	idle := sapSessionPool.idle[name]
+	delete(sapSessionPool.idle, name)
+	sapSessionPool.Unlock()
+
+	for _, session := range idle {
+		go logoutSAPSession(session)
+	}
+}
+
+// CloseSAPSessions logs out every pooled session; called on shutdown.
+func CloseSAPSessions() {
+	sapSessionPool.Lock()
+	pooled := sapSessionPool.idle
+	sapSessionPool.idle = make(map[string][]*sapSession)
+	sapSessionPool.Unlock()
+
+	for _, sessions := range pooled {
+		for _, session := range sessions {
+			logoutSAPSession(session)
+		}
+	}
+}
+
+// loginSAPSession logs in to the Service Layer with the credential's values.
+func loginSAPSession(name string, data map[string]interface{}) (*sapSession, error) {
+	str := func(key string) string {
+		value, _ := data[key].(string)
+		return value
+	}
+
+	baseURL := strings.TrimRight(str("serviceLayerUrl"), "/")
+	loginBody := map[string]interface{}{
+		"CompanyDB": str("companyDB"),
+		"UserName":  str("username"),
+		"Password":  str("password"),
+	}
+	if language, ok := data["language"]; ok {
+		loginBody["Language"] = language
+	}
+
+	bodyJSON, err := json.Marshal(loginBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal SAP login: %w", err)
+	}
+
+	client, err := newHTTPClient(httpClientConfigFromCredential(data), name, sapRequestTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("credential %s: %w", name, err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, baseURL+"/Login", bytes.NewReader(bodyJSON))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SAP login request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("SAP login for %s failed: %w", name, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read SAP login response: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("SAP login for %s failed with status %d: %s", name, resp.StatusCode, string(respBody))
+	}
+
+	var loginResp struct {
+		SessionID      string `json:"SessionId"`
+		SessionTimeout int    `json:"SessionTimeout"`
+	}
+	if err := json.Unmarshal(respBody, &loginResp); err != nil || loginResp.SessionID == "" {
+		return nil, fmt.Errorf("SAP login for %s returned no session", name)
+	}
+
+	// Keep every cookie the server set; ROUTEID pins the session to one node of a cluster
+	var cookies []string
+	hasSession := false
+	for _, cookie := range resp.Cookies() {
+		cookies = append(cookies, cookie.Name+"="+cookie.Value)
+		hasSession = hasSession || cookie.Name == "B1SESSION"
+	}
+	if !hasSession {
+		cookies = append(cookies, "B1SESSION="+loginResp.SessionID)
+	}
+
+	timeout := sapDefaultSessionTimeout
+	if loginResp.SessionTimeout > 0 {
+		timeout = time.Duration(loginResp.SessionTimeout) * time.Minute
+	}
+
+	return &sapSession{
+		credential: name,
+		client:     client,
+		baseURL:    baseURL,
+		cookies:    strings.Join(cookies, "; "),
+		timeout:    timeout,
+		lastUsed:   time.Now(),
+	}, nil
+}
+
+// logoutSAPSession ends a session on the server. Errors are only logged.
+func logoutSAPSession(session *sapSession) {
+	req, err := http.NewRequest(http.MethodPost, session.baseURL+"/Logout", nil)
+	if err != nil {
+		return
+	}
+	req.Header.Set("Cookie", session.cookies)
+
+	resp, err := session.client.Do(req)
+	if err != nil {
+		log.Printf("Warning: SAP logout for %s failed: %v", session.credential, err)
+		return
+	}
+	resp.Body.Close()
+}
+
+// doSAPRequest sends one request within a session. The path is relative to the
+// Service Layer root unless it is an absolute URL (as resolved nextLinks are).
+func doSAPRequest(session *sapSession, sapReq sapB1Request) (*http.Response, []byte, error) {
+	target := sapReq.Path
+	if !strings.HasPrefix(target, "http://") && !strings.HasPrefix(target, "https://") {
+		target = session.baseURL + "/" + strings.TrimLeft(target, "/")
+	}
+
+	req, err := http.NewRequest(sapReq.Method, target, bytes.NewReader(sapReq.Body))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create SAP request: %w", err)
+	}
+	req.Header.Set("Cookie", session.cookies)
+	if sapReq.Body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for key, value := range sapReq.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := session.client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("SAP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read SAP response: %w", err)
+	}
+	return resp, respBody, nil
+}
+
+// isSAPSessionExpired recognizes the Service Layer's invalid session responses
+// (HTTP 401, or error code 301 "Invalid session" on older versions).
+func isSAPSessionExpired(statusCode int, body []byte) bool {
+	if statusCode == http.StatusUnauthorized {
+		return true
+	}
+	if statusCode < 400 {
+		return false
+	}
+
+	var errResp struct {
+		Error struct {
+			Code json.Number `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error.Code.String() == "301" {
+		return true
+	}
+	return strings.Contains(string(body), "Invalid session")
+}
+
+// formatSAPKey renders an entity key for a URL: numbers as is, strings quoted
+// with embedded quotes doubled as OData requires.
+func formatSAPKey(key interface{}, keyType string) string {
+	switch v := key.(type) {
+	case float64:
+		if keyType != "string" {
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		}
+		key = strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		if keyType != "string" {
+			return strconv.Itoa(v)
+		}
+		key = strconv.Itoa(v)
+	}
+
+	keyStr := fmt.Sprintf("%v", key)
+	if keyType == "number" {
+		return url.PathEscape(keyStr)
+	}
+	return "'" + url.PathEscape(strings.ReplaceAll(keyStr, "'", "''")) + "'"
+}
+
+// buildSAPParamList renders saved query parameters as a ParamList: strings are
+// quoted with embedded quotes doubled, numbers and booleans are passed as is.
+func buildSAPParamList(params map[string]interface{}) string {
+	names := keys(params)
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		var value string
+		switch v := params[name].(type) {
+		case float64:
+			value = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			value = strconv.FormatBool(v)
+		default:
+			value = "'" + strings.ReplaceAll(fmt.Sprintf("%v", v), "'", "''") + "'"
+		}
+		parts = append(parts, name+"="+value)
+	}
+	return strings.Join(parts, "&")
+}
+
+// sapB1Request describes the Service Layer call made by a sapB1 node.
+type sapB1Request struct {
+	Method  string
+	Path    string
+	Body    []byte
+	Headers map[string]string
+}
+
+// buildSAPB1Request translates the node's operation into a Service Layer call.
+//
+// Operations:
+//   - get:      GET    {entity}({key})
+//   - list:     GET    {entity}[?{query}], with template values in the query escaped
+//   - create:   POST   {entity}
+//   - update:   PUT    {entity}({key})
+//   - patch:    PATCH  {entity}({key})
+//   - delete:   DELETE {entity}({key})
+//   - sqlQuery: POST   SQLQueries('{sqlQuery}')/List with the params as ParamList
+//
+// list and sqlQuery also accept the "odata" options (see ODataOptions); all
+// pages are collected by default.
+func buildSAPB1Request(params map[string]interface{}) (sapB1Request, error) {
+	operation, _ := params["operation"].(string)
+	entity, _ := params["entity"].(string)
+	keyType, _ := params["keyType"].(string)
+
+	entityPath := func() (string, error) {
+		if entity == "" {
+			return "", fmt.Errorf("sapB1 %s requires 'entity'", operation)
+		}
+		// Escape each segment so views like "view.svc/MyView" keep their slash
+		segments := strings.Split(entity, "/")
+		for i, segment := range segments {
+			segments[i] = url.PathEscape(segment)
+		}
+		return strings.Join(segments, "/"), nil
+	}
+	keyedPath := func() (string, error) {
+		path, err := entityPath()
+		if err != nil {
+			return "", err
+		}
+		key, ok := params["key"]
+		if !ok || key == nil || key == "" {
+			return "", fmt.Errorf("sapB1 %s requires 'key'", operation)
+		}
+		return path + "(" + formatSAPKey(key, keyType) + ")", nil
+	}
+	marshalBody := func() ([]byte, error) {
+		bodyData, ok := params["body"]
+		if !ok {
+			return nil, fmt.Errorf("sapB1 %s requires 'body'", operation)
+		}
+		body, err := json.Marshal(bodyData)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		return body, nil
+	}
+
+	var req sapB1Request
+	var err error
+	switch operation {
+	case "get", "delete":
+		req.Method = http.MethodGet
+		if operation == "delete" {
+			req.Method = http.MethodDelete
+		}
+		req.Path, err = keyedPath()
+	case "list":
+		req.Method = http.MethodGet
+		req.Path, err = entityPath()
+		if query, ok := params["query"].(string); ok && query != "" && err == nil {
+			req.Path += "?" + strings.TrimPrefix(query, "?")
+		}
+	case "create":
+		req.Method = http.MethodPost
+		if req.Path, err = entityPath(); err == nil {
+			req.Body, err = marshalBody()
+		}
+	case "update", "patch":
+		req.Method = http.MethodPut
+		if operation == "patch" {
+			req.Method = http.MethodPatch
+		}
+		if req.Path, err = keyedPath(); err == nil {
+			req.Body, err = marshalBody()
+		}
+	case "sqlQuery":
+		queryName, _ := params["sqlQuery"].(string)
+		if queryName == "" {
+			return req, fmt.Errorf("sapB1 sqlQuery requires 'sqlQuery'")
+		}
+		req.Method = http.MethodPost
+		req.Path = "SQLQueries(" + formatSAPKey(queryName, "string") + ")/List"
+		queryParams, _ := params["params"].(map[string]interface{})
+		req.Body, err = json.Marshal(map[string]interface{}{"ParamList": buildSAPParamList(queryParams)})
+	default:
+		return req, fmt.Errorf("unsupported sapB1 operation: %s", operation)
+	}
+
+	return req, err
+}
+
+func (we *WorkflowEngine) executeSAPB1(node *Node) error {
+	resolvedParams := we.resolveTemplateValue(node.Parameters).(map[string]interface{})
+
+	credentialName, _ := resolvedParams["credential"].(string)
+	if credentialName == "" {
+		return fmt.Errorf("sapB1 node requires 'credential'")
+	}
+	if credentialType := we.context.CredentialTypes[credentialName]; credentialType != "sapB1" {
+		return fmt.Errorf("credential %s has type %s, expected sapB1", credentialName, credentialType)
+	}
+
+	// Values inserted into the query are escaped like those of a URL template
+	if query, ok := node.Parameters["query"].(string); ok {
+		resolvedParams["query"] = we.resolveQueryTemplate(query)
+	}
+
+	sapReq, err := buildSAPB1Request(resolvedParams)
+	if err != nil {
+		return err
+	}
+
+	// OData options apply to collection reads (list and saved queries)
+	odataOpts, err := parseODataOptions(resolvedParams)
+	if err != nil {
+		return err
+	}
+	if operation, _ := resolvedParams["operation"].(string); odataOpts == nil && (operation == "list" || operation == "sqlQuery") {
+		// Service Layer pages collections (20 rows by default), so always collect every page
+		odataOpts = &ODataOptions{FollowNextLink: true, MaxPages: defaultODataMaxPages}
+	}
+	if odataOpts != nil {
+		sapReq.Path = appendQuery(sapReq.Path, odataOpts.Query)
+		if prefer := odataOpts.preferHeader(); prefer != "" {
+			sapReq.Headers = map[string]string{"Prefer": prefer}
+		}
+	}
+
+	data := we.context.Credentials[credentialName]
+	session, err := acquireSAPSession(credentialName, data)
+	if err != nil {
+		return err
+	}
+
+	do := func(req sapB1Request) (*http.Response, []byte, error) {
+		release, err := we.throttle(session.baseURL, credentialName)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer release()
+
+		var resp *http.Response
+		var respBody []byte
+		err = we.guardCall(session.baseURL, credentialName, func() (int, error) {
+			var err error
+			if resp, respBody, err = doSAPRequest(session, req); err != nil {
+				return 0, err
+			}
+			return resp.StatusCode, nil
+		})
+		return resp, respBody, err
+	}
+	send := func(req sapB1Request) (*http.Response, []byte, error) {
+		resp, respBody, err := do(req)
+		if err == nil && isSAPSessionExpired(resp.StatusCode, respBody) {
+			// The server dropped the session: log in again and retry once
+			log.Printf("SAP session for %s expired, logging in again", credentialName)
+			fresh, err := loginSAPSession(credentialName, data)
+			if err != nil {
+				return nil, nil, err
+			}
+			session = fresh
+			resp, respBody, err = do(req)
+		}
+		return resp, respBody, err
+	}
+
+	// After a failed call the session may be unusable, so it is logged out
+	// instead of going back to the pool
+	discard := func() {
+		log.Printf("Logging out SAP session of %s after a failed request", credentialName)
+		go logoutSAPSession(session)
+	}
+
+	resp, respBody, err := send(sapReq)
+	if err != nil {
+		discard()
+		return err
+	}
+
+	statusCode, respHeader := resp.StatusCode, resp.Header
+	var paged *pagedResult
+	if odataOpts != nil && odataOpts.FollowNextLink {
+		// Saved queries are paged by repeating the POST; entity sets by GET
+		result, err := followODataNextLinks(httpPage{resp.StatusCode, resp.Header, respBody}, odataOpts.MaxPages,
+			func(nextLink string) (httpPage, error) {
+				nextURL, err := resolveODataLink(nextLink, session.baseURL, session.baseURL+"/"+sapReq.Path)
+				if err != nil {
+					return httpPage{}, err
+				}
+				nextReq := sapReq
+				nextReq.Path = nextURL
+				nextResp, nextBody, err := send(nextReq)
+				if err != nil {
+					return httpPage{}, err
+				}
+				return httpPage{nextResp.StatusCode, nextResp.Header, nextBody}, nil
+			})
+		if err != nil {
+			discard()
+			return err
+		}
+		statusCode, respHeader, respBody = result.StatusCode, result.Header, result.Body
+		paged = &result
+	}
+
+	session.lastUsed = time.Now()
+	releaseSAPSession(session)
+
+	nodeResult := buildHTTPResult(statusCode, respHeader, respBody)
+	if paged != nil {
+		nodeResult["pageCount"] = paged.Pages
+		nodeResult["hasMore"] = paged.HasMore
+	}
+	we.context.NodeResults[node.ID] = nodeResult
+
+	if statusCode >= 400 {
+		return fmt.Errorf("SAP request failed with status %d: %s", statusCode, string(respBody))
+	}
+	return nil
+}
@@ -0,0 +1,965 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// sqlMaxParams is the number of bound parameters a statement may use per driver
+var sqlMaxParams = map[string]int{
+	"sqlserver": 2000, // The server allows 2100, minus some headroom
+	"postgres":  65535,
+	"mysql":     65535,
+	"sqlite":    32766,
+}
+
+// sqlDefaultBatchSize is the number of rows inserted per statement
+const sqlDefaultBatchSize = 100
+
+// sqlExecutor is implemented by both *sql.DB and *sql.Tx.
+type sqlExecutor interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// executeSQLQuery runs the sqlQuery node. Operations:
+//
+//   - query (default): runs a SELECT and returns its rows
+//   - exec: runs a write statement and returns rowsAffected and, where the
+//     driver supports it, lastInsertId
+//   - transaction: runs the statements array in one transaction, rolled back
+//     when any statement fails
+//   - insert, upsert: writes the items array to table in batches inside one
+//     transaction; upsert updates rows matching keyColumns
+//   - copy: streams the rows of query into a table of the target database
+//
+// Query results convert values by column type: decimals per decimals (auto,
+// number or string), times as RFC 3339, GUIDs as strings and binary data as
+// base64. Column names are lower-cased unless columnCase is preserve, and
+// maxRows stops reading a large result early (truncated is set).
+//
+// query and copy read one page of pageSize rows when it is set; the query must
+// then end with an ORDER BY so that pages do not overlap. hasMore tells whether
+// rows are left, and the run given nextPageToken as pageToken continues after them.
+//
+// Values are bound through params rather than interpolated into the SQL: an
+// array binds the driver's own placeholders (@p1, $1 or ?), an object binds
+// @name placeholders on every driver. Templates are not resolved in query, and
+// a query containing one is rejected.
+func (we *WorkflowEngine) executeSQLQuery(node *Node) error {
+	resolvedParams := we.resolveTemplateValue(node.Parameters).(map[string]interface{})
+	query, err := sqlQueryText(node.Parameters["query"])
+	if err != nil {
+		return err
+	}
+
+	pool, err := we.sqlPoolFor(resolvedParams)
+	if err != nil {
+		return err
+	}
+	options, err := parseSQLResultOptions(resolvedParams)
+	if err != nil {
+		return err
+	}
+	page, err := parseSQLPage(resolvedParams)
+	if err != nil {
+		return err
+	}
+
+	timeout := sqlDefaultQueryTimeout
+	if seconds, err := toNumber(resolvedParams["timeout"]); err == nil && seconds > 0 {
+		timeout = time.Duration(seconds * float64(time.Second))
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	if err := pool.checkHealth(ctx); err != nil {
+		return err
+	}
+
+	operation, _ := resolvedParams["operation"].(string)
+	var result map[string]interface{}
+	switch operation {
+	case "", "query", "exec":
+		result, err = runSQLStatement(ctx, pool.db, pool.driver, operation, query, we.resolveSQLParams(node.Parameters["params"]), options, page)
+	case "transaction":
+		result, err = we.runSQLTransaction(ctx, pool, node.Parameters["statements"], options)
+	case "insert", "upsert":
+		result, err = runSQLBatch(ctx, pool, operation, resolvedParams, we.resolveTemplateRaw(node.Parameters["items"]))
+	case "copy":
+		target, _ := resolvedParams["target"].(map[string]interface{})
+		if target == nil {
+			return fmt.Errorf("copy requires a 'target' object")
+		}
+		var targetPool *sqlPool
+		if targetPool, err = we.sqlPoolFor(target); err != nil {
+			return fmt.Errorf("copy target: %w", err)
+		}
+		result, err = runSQLCopy(ctx, pool, targetPool, query, we.resolveSQLParams(node.Parameters["params"]), target, options, page)
+	default:
+		return fmt.Errorf("unsupported sqlQuery operation: %s", operation)
+	}
+	if err != nil {
+		return err
+	}
+
+	we.context.NodeResults[node.ID] = result
+	return nil
+}
+
+// sqlPoolFor returns the pool named by params. Named connections come from
+// database credentials; a connection string is still accepted and gets a pool of its own.
+func (we *WorkflowEngine) sqlPoolFor(params map[string]interface{}) (*sqlPool, error) {
+	var pool *sqlPool
+	var err error
+	if credentialName, _ := params["credential"].(string); credentialName != "" {
+		if credentialType := we.context.CredentialTypes[credentialName]; credentialType != "database" {
+			return nil, fmt.Errorf("credential %s of type %s is not a database credential", credentialName, credentialType)
+		}
+		pool, err = getSQLPool(credentialName, we.context.Credentials[credentialName])
+	} else if connectionString, _ := params["connectionString"].(string); connectionString != "" {
+		driver, _ := params["driver"].(string)
+		pool, err = getInlineSQLPool(driver, connectionString)
+	} else {
+		return nil, fmt.Errorf("sqlQuery requires a credential or connectionString")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+	return pool, nil
+}
+
+// sqlQueryText returns the SQL of a query parameter as written. Data must reach
+// the database through params, so a template in the SQL is an error.
+func sqlQueryText(value interface{}) (string, error) {
+	query, _ := value.(string)
+	if isTemplateString(query) {
+		return "", fmt.Errorf("templates are not allowed in SQL queries: bind values through params, e.g. @name")
+	}
+	return query, nil
+}
+
+// resolveSQLParams resolves statement parameters keeping the type of each value.
+func (we *WorkflowEngine) resolveSQLParams(params interface{}) interface{} {
+	switch v := params.(type) {
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			resolved[key] = we.resolveTemplateRaw(value)
+		}
+		return resolved
+	case []interface{}:
+		resolved := make([]interface{}, len(v))
+		for i, value := range v {
+			resolved[i] = we.resolveTemplateRaw(value)
+		}
+		return resolved
+	default:
+		// A lone expression may produce the whole array or object
+		return we.resolveTemplateRaw(v)
+	}
+}
+
+// runSQLStatement runs one query or exec statement with its parameters. A
+// query reads only the given page when page has a size.
+func runSQLStatement(ctx context.Context, executor sqlExecutor, driver, operation, query string, params interface{}, options sqlResultOptions, page sqlPage) (map[string]interface{}, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, fmt.Errorf("sqlQuery requires 'query'")
+	}
+	if operation != "exec" {
+		var err error
+		if query, err = page.apply(driver, query); err != nil {
+			return nil, err
+		}
+	}
+	query, args, err := bindSQLParams(driver, query, params)
+	if err != nil {
+		return nil, err
+	}
+
+	if operation == "exec" {
+		res, err := executor.ExecContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to execute statement: %w", err)
+		}
+		result := map[string]interface{}{}
+		if affected, err := res.RowsAffected(); err == nil {
+			result["rowsAffected"] = affected
+		}
+		// SQL Server and PostgreSQL return generated keys through OUTPUT or RETURNING instead
+		if id, err := res.LastInsertId(); err == nil {
+			result["lastInsertId"] = id
+		}
+		return result, nil
+	}
+
+	rows, err := executor.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	if err := options.prepare(driver, rows); err != nil {
+		return nil, err
+	}
+
+	results := []map[string]interface{}{}
+	truncated, hasMore := false, false
+	for rows.Next() {
+		// Stop reading instead of holding a huge result set in memory
+		if options.maxRows > 0 && len(results) == options.maxRows {
+			truncated = true
+			break
+		}
+		if page.size > 0 && len(results) == page.size {
+			hasMore = true
+			break
+		}
+		row, err := options.scanRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read rows: %w", err)
+	}
+
+	result := map[string]interface{}{
+		"results":   results,
+		"rowCount":  len(results),
+		"truncated": truncated,
+	}
+	page.addResult(result, hasMore)
+	return result, nil
+}
+
+var (
+	// sqlOrderByRegex finds an ORDER BY clause
+	sqlOrderByRegex = regexp.MustCompile(`(?i)\border\s+by\b`)
+	// sqlStatementRegex finds what would start another statement after the ORDER BY
+	sqlStatementRegex = regexp.MustCompile(`(?i);|\bselect\b`)
+)
+
+// sqlEndsWithOrderBy tells whether the last clause of a query is an ORDER BY of
+// the query itself. One inside a subquery, OVER (...) clause, string literal,
+// quoted identifier or comment does not order the rows and does not count.
+func sqlEndsWithOrderBy(query string) bool {
+	topLevel := []byte(query)
+	depth := 0
+	for i := 0; i < len(topLevel); i++ {
+		start := i
+		switch c := topLevel[i]; {
+		case c == '\'' || c == '"' || c == '`' || c == '[':
+			end := c
+			if c == '[' {
+				end = ']'
+			}
+			for i++; i < len(topLevel) && topLevel[i] != end; i++ {
+			}
+		case c == '-' && i+1 < len(topLevel) && topLevel[i+1] == '-':
+			for i < len(topLevel) && topLevel[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(topLevel) && topLevel[i+1] == '*':
+			for i += 2; i < len(topLevel) && !(topLevel[i-1] == '*' && topLevel[i] == '/'); i++ {
+			}
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		default:
+			if depth == 0 {
+				continue
+			}
+		}
+		// Blank out everything that is not top-level query text
+		for j := start; j <= i && j < len(topLevel); j++ {
+			topLevel[j] = ' '
+		}
+	}
+
+	matches := sqlOrderByRegex.FindAllIndex(topLevel, -1)
+	if len(matches) == 0 {
+		return false
+	}
+	return !sqlStatementRegex.Match(topLevel[matches[len(matches)-1][1]:])
+}
+
+// sqlPage is the page of a query result to read, from the pageSize and
+// pageToken parameters. A zero size reads the whole result.
+type sqlPage struct {
+	size   int
+	token  string
+	offset int    // Rows before the page
+	query  string // Fingerprint of the paged query, set by apply
+}
+
+// sqlPageToken is the content of a pageToken
+type sqlPageToken struct {
+	Offset int    `json:"o"`
+	Query  string `json:"q"`
+}
+
+// parseSQLPage reads pageSize and pageToken.
+func parseSQLPage(params map[string]interface{}) (sqlPage, error) {
+	var page sqlPage
+	if value, ok := params["pageSize"]; ok && value != nil && value != "" {
+		n, err := toNumber(value)
+		if err != nil || n < 1 || n != math.Trunc(n) {
+			return page, fmt.Errorf("invalid pageSize: %v", value)
+		}
+		page.size = int(n)
+	}
+	page.token, _ = params["pageToken"].(string)
+	if page.token != "" && page.size == 0 {
+		return page, errors.New("pageToken requires pageSize")
+	}
+	return page, nil
+}
+
+// apply limits query to the page. The token must come from the same query, so
+// that a token is never applied to rows it does not describe.
+func (p *sqlPage) apply(driver, query string) (string, error) {
+	if p.size == 0 {
+		return query, nil
+	}
+	query = strings.TrimRight(strings.TrimSpace(query), "; \t\r\n")
+	if !sqlEndsWithOrderBy(query) {
+		return "", errors.New("pageSize requires a query that ends with an ORDER BY, so that pages do not overlap")
+	}
+	sum := sha256.Sum256([]byte(driver + "\x00" + query))
+	p.query = base64.RawURLEncoding.EncodeToString(sum[:12])
+
+	if p.token != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(p.token)
+		var token sqlPageToken
+		if err != nil || json.Unmarshal(raw, &token) != nil || token.Offset < 0 {
+			return "", errors.New("invalid pageToken")
+		}
+		if token.Query != p.query {
+			return "", errors.New("invalid pageToken: it was returned for a different query")
+		}
+		p.offset = token.Offset
+	}
+
+	// One row more than the page shows whether another page follows. The
+	// clause starts on a new line in case the query ends with a -- comment.
+	if driver == "sqlserver" {
+		return fmt.Sprintf("%s\nOFFSET %d ROWS FETCH NEXT %d ROWS ONLY", query, p.offset, p.size+1), nil
+	}
+	return fmt.Sprintf("%s\nLIMIT %d OFFSET %d", query, p.size+1, p.offset), nil
+}
+
+// addResult adds hasMore and nextPageToken to the result of a paged query.
+func (p *sqlPage) addResult(result map[string]interface{}, hasMore bool) {
+	if p.size == 0 {
+		return
+	}
+	result["hasMore"] = hasMore
+	result["nextPageToken"] = ""
+	if hasMore {
+		raw, _ := json.Marshal(sqlPageToken{Offset: p.offset + p.size, Query: p.query})
+		result["nextPageToken"] = base64.RawURLEncoding.EncodeToString(raw)
+	}
+}
+
+// runSQLTransaction runs statements in one transaction. Each statement is an
+// object with query, params and operation (exec by default); the transaction is
+// rolled back when any of them fails.
+func (we *WorkflowEngine) runSQLTransaction(ctx context.Context, pool *sqlPool, rawStatements interface{}, options sqlResultOptions) (map[string]interface{}, error) {
+	statements, ok := rawStatements.([]interface{})
+	if !ok || len(statements) == 0 {
+		return nil, fmt.Errorf("transaction requires a 'statements' array")
+	}
+
+	tx, err := pool.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op once committed
+
+	results := make([]interface{}, 0, len(statements))
+	for i, raw := range statements {
+		statement, ok := raw.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("statement %d is not an object", i+1)
+		}
+		query, err := sqlQueryText(statement["query"])
+		if err != nil {
+			return nil, fmt.Errorf("statement %d: %w", i+1, err)
+		}
+		operation, _ := statement["operation"].(string)
+		if operation == "" {
+			operation = "exec"
+		}
+		if operation != "query" && operation != "exec" {
+			return nil, fmt.Errorf("statement %d: unsupported operation %s", i+1, operation)
+		}
+
+		result, err := runSQLStatement(ctx, tx, pool.driver, operation, query, we.resolveSQLParams(statement["params"]), options, sqlPage{})
+		if err != nil {
+			return nil, fmt.Errorf("statement %d failed, transaction rolled back: %w", i+1, err)
+		}
+		results = append(results, result)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return map[string]interface{}{
+		"statements":     results,
+		"statementCount": len(results),
+	}, nil
+}
+
+// runSQLBatch inserts or upserts items into a table. Columns default to the
+// keys of all items; a column missing from an item is written as NULL.
+func runSQLBatch(ctx context.Context, pool *sqlPool, operation string, params map[string]interface{}, rawItems interface{}) (map[string]interface{}, error) {
+	var items []map[string]interface{}
+	switch v := rawItems.(type) {
+	case []interface{}:
+		for i, item := range v {
+			obj, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("item %d is not an object", i+1)
+			}
+			items = append(items, obj)
+		}
+	case []map[string]interface{}:
+		items = v
+	case map[string]interface{}:
+		items = []map[string]interface{}{v}
+	default:
+		return nil, fmt.Errorf("%s requires an 'items' array", operation)
+	}
+
+	columns := stringList(params["columns"])
+	if len(columns) == 0 {
+		seen := make(map[string]bool)
+		for _, item := range items {
+			for key := range item {
+				if !seen[key] {
+					seen[key] = true
+					columns = append(columns, key)
+				}
+			}
+		}
+		sort.Strings(columns)
+	}
+
+	result := map[string]interface{}{
+		"itemCount":    len(items),
+		"rowsAffected": int64(0),
+		"batchCount":   0,
+	}
+	if len(items) == 0 {
+		return result, nil
+	}
+
+	tx, err := pool.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op once committed
+
+	writer, err := newSQLBatchWriter(ctx, tx, pool.driver, operation, params, columns)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if err := writer.add(item); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.flush(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	result["rowsAffected"] = writer.rowsAffected
+	result["batchCount"] = writer.batches
+	return result, nil
+}
+
+// sqlBatchWriter collects rows and writes them with one statement per batch.
+type sqlBatchWriter struct {
+	ctx          context.Context
+	tx           *sql.Tx
+	driver       string
+	operation    string // insert or upsert.
+	table        string
+	columns      []string
+	keyColumns   []string
+	batchSize    int
+	pending      []map[string]interface{}
+	written      int
+	rowsAffected int64
+	batches      int
+}
+
+// newSQLBatchWriter reads table, keyColumns and batchSize from params. The
+// batch size is lowered to stay within the driver's parameter limit.
+func newSQLBatchWriter(ctx context.Context, tx *sql.Tx, driver, operation string, params map[string]interface{}, columns []string) (*sqlBatchWriter, error) {
+	writer := &sqlBatchWriter{
+		ctx:        ctx,
+		tx:         tx,
+		driver:     driver,
+		operation:  operation,
+		columns:    columns,
+		keyColumns: stringList(params["keyColumns"]),
+		batchSize:  sqlDefaultBatchSize,
+	}
+	writer.table, _ = params["table"].(string)
+	if writer.table == "" {
+		return nil, fmt.Errorf("%s requires 'table'", operation)
+	}
+	if operation == "upsert" && len(writer.keyColumns) == 0 {
+		return nil, fmt.Errorf("upsert requires 'keyColumns'")
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("%s requires 'columns' or items with fields", operation)
+	}
+
+	if n, err := toNumber(params["batchSize"]); err == nil && n >= 1 {
+		writer.batchSize = int(n)
+	}
+	if maxRows := sqlMaxParams[driver] / len(columns); writer.batchSize > maxRows {
+		writer.batchSize = maxRows
+	}
+	if writer.batchSize < 1 {
+		return nil, fmt.Errorf("too many columns for one %s statement", driver)
+	}
+	return writer, nil
+}
+
+// add queues a row and writes the batch once it is full.
+func (w *sqlBatchWriter) add(item map[string]interface{}) error {
+	w.pending = append(w.pending, item)
+	if len(w.pending) >= w.batchSize {
+		return w.flush()
+	}
+	return nil
+}
+
+// flush writes the queued rows.
+func (w *sqlBatchWriter) flush() error {
+	if len(w.pending) == 0 {
+		return nil
+	}
+
+	query, args, err := buildSQLBatch(w.driver, w.operation, w.table, w.columns, w.keyColumns, w.pending)
+	if err != nil {
+		return err
+	}
+	res, err := w.tx.ExecContext(w.ctx, query, args...)
+	if err != nil {
+		return fmt.Errorf("batch %d (items %d-%d) failed, transaction rolled back: %w",
+			w.batches+1, w.written+1, w.written+len(w.pending), err)
+	}
+	if n, err := res.RowsAffected(); err == nil {
+		w.rowsAffected += n
+	}
+	w.written += len(w.pending)
+	w.batches++
+	w.pending = w.pending[:0]
+	return nil
+}
+
+// runSQLCopy streams the rows of a query into a table of another (or the
+// same) database. Rows are read with a cursor and written batch by batch, so
+// only one batch is held in memory. target holds table, operation (insert or
+// upsert), keyColumns, columns and batchSize. With a page size, one page is
+// copied per run.
+func runSQLCopy(ctx context.Context, source, targetPool *sqlPool, query string, params interface{}, target map[string]interface{}, options sqlResultOptions, page sqlPage) (map[string]interface{}, error) {
+	operation, _ := target["operation"].(string)
+	if operation == "" {
+		operation = "insert"
+	}
+	if operation != "insert" && operation != "upsert" {
+		return nil, fmt.Errorf("unsupported copy target operation: %s", operation)
+	}
+	if err := targetPool.checkHealth(ctx); err != nil {
+		return nil, err
+	}
+
+	query, err := page.apply(source.driver, query)
+	if err != nil {
+		return nil, err
+	}
+	query, args, err := bindSQLParams(source.driver, query, params)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := source.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute query: %w", err)
+	}
+	defer rows.Close()
+
+	// Values keep their driver types so times and binary data arrive unchanged
+	options.native = true
+	if options.decimals == "auto" {
+		options.decimals = "string"
+	}
+	if err := options.prepare(source.driver, rows); err != nil {
+		return nil, err
+	}
+	columns := stringList(target["columns"])
+	if len(columns) == 0 {
+		columns = options.columnNames
+	}
+
+	tx, err := targetPool.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback() // No-op once committed
+
+	writer, err := newSQLBatchWriter(ctx, tx, targetPool.driver, operation, target, columns)
+	if err != nil {
+		return nil, err
+	}
+	rowsRead, truncated, hasMore := 0, false, false
+	for rows.Next() {
+		if options.maxRows > 0 && rowsRead == options.maxRows {
+			truncated = true
+			break
+		}
+		if page.size > 0 && rowsRead == page.size {
+			hasMore = true
+			break
+		}
+		row, err := options.scanRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		rowsRead++
+		if err := writer.add(row); err != nil {
+			return nil, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read rows: %w", err)
+	}
+	if err := writer.flush(); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	result := map[string]interface{}{
+		"rowsRead":     rowsRead,
+		"rowsAffected": writer.rowsAffected,
+		"batchCount":   writer.batches,
+		"truncated":    truncated,
+	}
+	page.addResult(result, hasMore)
+	return result, nil
+}
+
+// buildSQLBatch builds one multi-row INSERT or upsert statement for the driver.
+func buildSQLBatch(driver, operation, table string, columns, keyColumns []string, items []map[string]interface{}) (string, []interface{}, error) {
+	quotedColumns := make([]string, len(columns))
+	for i, column := range columns {
+		quotedColumns[i] = quoteSQLIdentifier(driver, column)
+	}
+	columnList := strings.Join(quotedColumns, ", ")
+
+	var args []interface{}
+	rows := make([]string, len(items))
+	for i, item := range items {
+		placeholders := make([]string, len(columns))
+		for j, column := range columns {
+			args = append(args, sqlArgValue(item[column]))
+			placeholders[j] = sqlPlaceholder(driver, len(args))
+		}
+		rows[i] = "(" + strings.Join(placeholders, ", ") + ")"
+	}
+	values := strings.Join(rows, ", ")
+	target := quoteSQLIdentifier(driver, table)
+
+	if operation == "insert" {
+		return fmt.Sprintf("INSERT INTO %s (%s) VALUES %s", target, columnList, values), args, nil
+	}
+
+	isKey := make(map[string]bool)
+	for _, key := range keyColumns {
+		isKey[key] = true
+	}
+	var updateColumns []string
+	for _, column := range columns {
+		if !isKey[column] {
+			updateColumns = append(updateColumns, column)
+		}
+	}
+	quotedKeys := make([]string, len(keyColumns))
+	for i, key := range keyColumns {
+		quotedKeys[i] = quoteSQLIdentifier(driver, key)
+	}
+
+	switch driver {
+	case "postgres", "sqlite":
+		sets := make([]string, len(updateColumns))
+		for i, column := range updateColumns {
+			quoted := quoteSQLIdentifier(driver, column)
+			sets[i] = quoted + " = EXCLUDED." + quoted
+		}
+		action := "DO NOTHING"
+		if len(sets) > 0 {
+			action = "DO UPDATE SET " + strings.Join(sets, ", ")
+		}
+		return fmt.Sprintf("INSERT INTO %s (%s) VALUES %s ON CONFLICT (%s) %s",
+			target, columnList, values, strings.Join(quotedKeys, ", "), action), args, nil
+	case "mysql":
+		// MySQL matches on the table's primary and unique keys
+		sets := make([]string, 0, len(updateColumns))
+		for _, column := range updateColumns {
+			quoted := quoteSQLIdentifier(driver, column)
+			sets = append(sets, quoted+" = VALUES("+quoted+")")
+		}
+		if len(sets) == 0 {
+			sets = append(sets, quotedKeys[0]+" = "+quotedKeys[0])
+		}
+		return fmt.Sprintf("INSERT INTO %s (%s) VALUES %s ON DUPLICATE KEY UPDATE %s",
+			target, columnList, values, strings.Join(sets, ", ")), args, nil
+	case "sqlserver":
+		conditions := make([]string, len(quotedKeys))
+		for i, key := range quotedKeys {
+			conditions[i] = "target." + key + " = source." + key
+		}
+		sourceColumns := make([]string, len(quotedColumns))
+		for i, column := range quotedColumns {
+			sourceColumns[i] = "source." + column
+		}
+		var query strings.Builder
+		fmt.Fprintf(&query, "MERGE INTO %s AS target USING (VALUES %s) AS source (%s) ON %s",
+			target, values, columnList, strings.Join(conditions, " AND "))
+		if len(updateColumns) > 0 {
+			sets := make([]string, len(updateColumns))
+			for i, column := range updateColumns {
+				quoted := quoteSQLIdentifier(driver, column)
+				sets[i] = "target." + quoted + " = source." + quoted
+			}
+			fmt.Fprintf(&query, " WHEN MATCHED THEN UPDATE SET %s", strings.Join(sets, ", "))
+		}
+		fmt.Fprintf(&query, " WHEN NOT MATCHED THEN INSERT (%s) VALUES (%s);", columnList, strings.Join(sourceColumns, ", "))
+		return query.String(), args, nil
+	default:
+		return "", nil, fmt.Errorf("upsert is not supported for driver %s", driver)
+	}
+}
+
+// quoteSQLIdentifier quotes a table or column name; "schema.table" is quoted per part.
+func quoteSQLIdentifier(driver, name string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		switch driver {
+		case "sqlserver":
+			parts[i] = "[" + strings.ReplaceAll(part, "]", "]]") + "]"
+		case "mysql":
+			parts[i] = "`" + strings.ReplaceAll(part, "`", "``") + "`"
+		default:
+			parts[i] = `"` + strings.ReplaceAll(part, `"`, `""`) + `"`
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// sqlPlaceholder returns the driver's placeholder for the n-th parameter.
+func sqlPlaceholder(driver string, n int) string {
+	switch driver {
+	case "sqlserver":
+		return fmt.Sprintf("@p%d", n)
+	case "postgres":
+		return fmt.Sprintf("$%d", n)
+	default:
+		return "?"
+	}
+}
+
+// sqlArgValue converts a JSON value into a value the drivers accept: whole
+// numbers become integers, objects and arrays are stored as JSON text.
+func sqlArgValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<53 {
+			return int64(v)
+		}
+		return v
+	case int:
+		return int64(v)
+	case map[string]interface{}, []interface{}, []map[string]interface{}:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(encoded)
+	default:
+		return v
+	}
+}
+
+// bindSQLParams prepares the arguments of a statement. An array is passed as
+// is for the driver's own placeholders. An object binds @name placeholders,
+// which are rewritten to $n for PostgreSQL and ? for MySQL.
+func bindSQLParams(driver, query string, params interface{}) (string, []interface{}, error) {
+	switch v := params.(type) {
+	case nil, string:
+		// An unresolved or empty expression binds nothing
+		if s, ok := v.(string); ok && s != "" {
+			return "", nil, fmt.Errorf("params must be an array or an object")
+		}
+		return query, nil, nil
+	case []interface{}:
+		args := make([]interface{}, len(v))
+		for i, value := range v {
+			args[i] = sqlArgValue(value)
+		}
+		return query, args, nil
+	case map[string]interface{}:
+		var out strings.Builder
+		var args []interface{}
+		positions := make(map[string]int)
+		var missing error
+
+		scanSQLNamedParams(driver, query, &out, func(name string) string {
+			value, ok := v[name]
+			if !ok {
+				if missing == nil {
+					missing = fmt.Errorf("missing SQL parameter @%s", name)
+				}
+				return "@" + name
+			}
+			switch driver {
+			case "postgres":
+				if n, ok := positions[name]; ok {
+					return fmt.Sprintf("$%d", n)
+				}
+				args = append(args, sqlArgValue(value))
+				positions[name] = len(args)
+				return fmt.Sprintf("$%d", len(args))
+			case "mysql":
+				args = append(args, sqlArgValue(value))
+				return "?"
+			default:
+				// SQL Server and SQLite bind @name natively
+				if _, ok := positions[name]; !ok {
+					args = append(args, sql.Named(name, sqlArgValue(value)))
+					positions[name] = len(args)
+				}
+				return "@" + name
+			}
+		})
+		if missing != nil {
+			return "", nil, missing
+		}
+		return out.String(), args, nil
+	default:
+		return "", nil, fmt.Errorf("params must be an array or an object")
+	}
+}
+
+// scanSQLNamedParams copies query to out, replacing each @name placeholder
+// with the result of replace. String literals (PostgreSQL $tag$ ones too),
+// quoted identifiers, comments and @@ system variables are left alone.
+func scanSQLNamedParams(driver, query string, out *strings.Builder, replace func(name string) string) {
+	isNameChar := func(c byte) bool {
+		return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+	}
+	// copyUntil copies up to and including the closing sequence
+	copyUntil := func(i int, closing string) int {
+		end := strings.Index(query[i:], closing)
+		if end < 0 {
+			out.WriteString(query[i:])
+			return len(query)
+		}
+		end += i + len(closing)
+		out.WriteString(query[i:end])
+		return end
+	}
+
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			// A doubled quote inside a literal reads as two adjacent literals, which copies the same text
+			out.WriteByte(c)
+			i = copyUntil(i+1, string(c))
+			continue
+		case c == '[' && driver == "sqlserver":
+			out.WriteByte(c)
+			i = copyUntil(i+1, "]")
+			continue
+		case c == '$' && driver == "postgres" && (i == 0 || !isNameChar(query[i-1])):
+			// A dollar-quoted string runs to the next $tag$, where the tag is
+			// empty or an identifier; $1 is a positional parameter instead
+			j := i + 1
+			for j < len(query) && isNameChar(query[j]) && !(j == i+1 && query[j] >= '0' && query[j] <= '9') {
+				j++
+			}
+			if j < len(query) && query[j] == '$' {
+				out.WriteString(query[i : j+1])
+				i = copyUntil(j+1, query[i:j+1])
+				continue
+			}
+		case c == '-' && strings.HasPrefix(query[i:], "--"):
+			i = copyUntil(i, "\n")
+			continue
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			i = copyUntil(i, "*/")
+			continue
+		case c == '@' && strings.HasPrefix(query[i:], "@@"):
+			j := i + 2
+			for j < len(query) && isNameChar(query[j]) {
+				j++
+			}
+			out.WriteString(query[i:j])
+			i = j
+			continue
+		case c == '@' && i+1 < len(query) && (query[i+1] == '_' || isNameChar(query[i+1]) && !(query[i+1] >= '0' && query[i+1] <= '9')):
+			j := i + 1
+			for j < len(query) && isNameChar(query[j]) {
+				j++
+			}
+			out.WriteString(replace(query[i+1 : j]))
+			i = j
+			continue
+		}
+		out.WriteByte(c)
+		i++
+	}
+}
+
+// stringList converts a JSON array or a comma separated string into strings.
+func stringList(value interface{}) []string {
+	var list []string
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			if s := strings.TrimSpace(fmt.Sprintf("%v", item)); s != "" {
+				list = append(list, s)
+			}
+		}
+	case string:
+		for _, item := range strings.Split(v, ",") {
+			if s := strings.TrimSpace(item); s != "" {
+				list = append(list, s)
+			}
+		}
+	}
+	return list
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSQLQueryKeepsTemplateValuesOutOfSQL(t *testing.T) {
+	we := newDetachedEngine()
+	dsn := filepath.Join(t.TempDir(), "partners.db")
+	hostile := "x'; DROP TABLE partners; --"
+	we.context.NodeResults["get_bps"] = map[string]interface{}{"CardCode": hostile}
+
+	run := func(params map[string]interface{}) (map[string]interface{}, error) {
+		params["driver"] = "sqlite"
+		params["connectionString"] = dsn
+		node := &Node{ID: "sql", Name: "SQL", Type: "sqlQuery", Parameters: params}
+		if err := we.executeSQLQuery(node); err != nil {
+			return nil, err
+		}
+		return we.context.NodeResults["sql"], nil
+	}
+
+	if _, err := run(map[string]interface{}{"operation": "exec", "query": "CREATE TABLE partners (code TEXT)"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A template in the SQL text is rejected before anything runs
+	_, err := run(map[string]interface{}{
+		"operation": "exec",
+		"query":     "INSERT INTO partners (code) VALUES ('{{$node['get_bps'].CardCode}}')",
+	})
+	if err == nil || !strings.Contains(err.Error(), "templates are not allowed") {
+		t.Fatalf("templated query error = %v, want templates rejected", err)
+	}
+	_, err = run(map[string]interface{}{
+		"operation":  "transaction",
+		"statements": []interface{}{map[string]interface{}{"query": "DELETE FROM partners WHERE code = '{{$node['get_bps'].CardCode}}'"}},
+	})
+	if err == nil || !strings.Contains(err.Error(), "templates are not allowed") {
+		t.Fatalf("templated transaction error = %v, want templates rejected", err)
+	}
+
+	// Through params the value is stored as data
+	if _, err := run(map[string]interface{}{
+		"operation": "exec",
+		"query":     "INSERT INTO partners (code) VALUES (@code)",
+		"params":    map[string]interface{}{"code": "{{$node['get_bps'].CardCode}}"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	result, err := run(map[string]interface{}{"query": "SELECT code FROM partners"})
+	if err != nil {
+		t.Fatalf("partners table is gone: %v", err)
+	}
+	rows, _ := result["results"].([]map[string]interface{})
+	if len(rows) != 1 || rows[0]["code"] != hostile {
+		t.Errorf("rows = %v, want one row with code %q", result["results"], hostile)
+	}
+}
+
+func TestSQLEndsWithOrderBy(t *testing.T) {
+	for query, want := range map[string]bool{
+		"SELECT * FROM t ORDER BY id":                                       true,
+		"SELECT a FROM t UNION SELECT a FROM u\norder  by a DESC":           true,
+		"SELECT id, ROW_NUMBER() OVER (ORDER BY id) FROM t ORDER BY id":     true,
+		"SELECT * FROM (SELECT TOP 10 * FROM t ORDER BY id) x":              false,
+		"SELECT id, ROW_NUMBER() OVER (ORDER BY id) FROM t":                 false,
+		"SELECT * FROM t WHERE note = 'it''s in order by date'":             false,
+		"SELECT [order by] FROM t -- ORDER BY id":                           false,
+		"SELECT * FROM t ORDER BY id; DELETE FROM t WHERE id IN (SELECT 1)": false,
+	} {
+		if got := sqlEndsWithOrderBy(query); got != want {
+			t.Errorf("sqlEndsWithOrderBy(%q) = %v, want %v", query, got, want)
+		}
+	}
+}
+
+func TestSQLCopyReportsTruncation(t *testing.T) {
+	we := newDetachedEngine()
+	dir := t.TempDir()
+	source, target := filepath.Join(dir, "source.db"), filepath.Join(dir, "target.db")
+	node := func(dsn string, params map[string]interface{}) *Node {
+		params["driver"] = "sqlite"
+		params["connectionString"] = dsn
+		return &Node{ID: "sql", Name: "SQL", Type: "sqlQuery", Parameters: params}
+	}
+
+	for dsn, query := range map[string]string{
+		source: "CREATE TABLE source (id INTEGER); INSERT INTO source (id) VALUES (1), (2), (3)",
+		target: "CREATE TABLE target (id INTEGER)",
+	} {
+		if err := we.executeSQLQuery(node(dsn, map[string]interface{}{"operation": "exec", "query": query})); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err := we.executeSQLQuery(node(source, map[string]interface{}{
+		"operation": "copy",
+		"query":     "SELECT id FROM source ORDER BY id",
+		"maxRows":   2,
+		"target":    map[string]interface{}{"driver": "sqlite", "connectionString": target, "table": "target"},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := we.context.NodeResults["sql"]
+	if result["rowsRead"] != 2 || result["truncated"] != true {
+		t.Errorf("rowsRead = %v, truncated = %v, want 2 and true", result["rowsRead"], result["truncated"])
+	}
+}
@@ -0,0 +1,436 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	mssql "github.com/microsoft/go-mssqldb"
+	"github.com/microsoft/go-mssqldb/msdsn"
+	_ "modernc.org/sqlite"
+)
+
+// Defaults of the pool settings of a database credential
+const (
+	sqlDefaultMaxOpenConns        = 10
+	sqlDefaultMaxIdleConns        = 2
+	sqlDefaultConnMaxLifetime     = 30 * time.Minute
+	sqlDefaultConnMaxIdleTime     = 5 * time.Minute
+	sqlDefaultHealthCheckInterval = 30 * time.Second
+
+	// sqlDefaultQueryTimeout bounds a statement unless the node sets a timeout
+	sqlDefaultQueryTimeout = 60 * time.Second
+
+	// A templated connection string can open a pool per execution, so pools of
+	// connection strings are closed after sqlInlinePoolIdleTimeout unused, and
+	// the least recently used idle one makes room beyond sqlMaxInlinePools
+	sqlInlinePoolIdleTimeout = 10 * time.Minute
+	sqlMaxInlinePools        = 32
+)
+
+// sqlDefaultPorts are used when a database credential has a host but no port
+var sqlDefaultPorts = map[string]string{
+	"sqlserver": "1433",
+	"postgres":  "5432",
+	"mysql":     "3306",
+}
+
+// sqlPool is a connection pool shared by all executions using the same database.
+type sqlPool struct {
+	name                string        // Credential name, or "inline:<hash>" for a connection string.
+	driver              string        // sqlserver, postgres, mysql or sqlite.
+	db                  *sql.DB       // Pooled connections.
+	healthCheckInterval time.Duration // How long a successful ping is trusted.
+	lastUsed            time.Time     // When a node last got the pool; guarded by sqlPools.
+
+	mu       sync.Mutex
+	lastPing time.Time
+	lastErr  string
+}
+
+// SQLPoolStatus is the API view of a pool.
+type SQLPoolStatus struct {
+	Name      string      `json:"name"`
+	Driver    string      `json:"driver"`
+	Healthy   bool        `json:"healthy"`
+	LastPing  *time.Time  `json:"lastPing,omitempty"`
+	LastError string      `json:"lastError,omitempty"`
+	Stats     sql.DBStats `json:"stats"`
+}
+
+// sqlPools holds the open pools keyed by pool name.
+var sqlPools = struct {
+	sync.Mutex
+	byName map[string]*sqlPool
+}{byName: make(map[string]*sqlPool)}
+
+// getSQLPool returns the pool of a database credential, opening it on first use.
+//
+// A database credential holds a driver (sqlserver, postgres, mysql or sqlite)
+// and either a dsn or host, port, database, username, password and options
+// (extra connection parameters). Optional pool settings are maxOpenConns,
+// maxIdleConns, connMaxLifetime and connMaxIdleTime (seconds) and
+// healthCheckInterval (seconds between pings, 0 pings before every use).
+func getSQLPool(name string, data map[string]interface{}) (*sqlPool, error) {
+	sqlPools.Lock()
+	defer sqlPools.Unlock()
+
+	if pool, ok := sqlPools.byName[name]; ok {
+		return pool, nil
+	}
+
+	driver, _ := data["driver"].(string)
+	dsn, err := buildSQLDSN(driver, data)
+	if err != nil {
+		return nil, fmt.Errorf("database credential %s: %w", name, err)
+	}
+	pool, err := openSQLPool(name, driver, dsn, data)
+	if err != nil {
+		return nil, fmt.Errorf("database credential %s: %w", name, err)
+	}
+	sqlPools.byName[name] = pool
+	return pool, nil
+}
+
+// getInlineSQLPool returns the pool of a connection string given on a node.
+// Pools are keyed by a hash so the connection string never shows up in the API.
+func getInlineSQLPool(driver, dsn string) (*sqlPool, error) {
+	if driver == "" {
+		driver = "sqlserver"
+	}
+	sum := sha256.Sum256([]byte(driver + "\x00" + dsn))
+	name := "inline:" + hex.EncodeToString(sum[:6])
+
+	sqlPools.Lock()
+	defer sqlPools.Unlock()
+
+	if pool, ok := sqlPools.byName[name]; ok {
+		pool.lastUsed = time.Now()
+		return pool, nil
+	}
+	evictInlineSQLPools()
+	pool, err := openSQLPool(name, driver, dsn, nil)
+	if err != nil {
+		return nil, err
+	}
+	pool.lastUsed = time.Now()
+	sqlPools.byName[name] = pool
+	return pool, nil
+}
+
+// evictInlineSQLPools closes the pools of connection strings that have not
+// been used for sqlInlinePoolIdleTimeout, and the least recently used ones
+// while there are sqlMaxInlinePools or more. Pools with connections in use are
+// kept. The caller holds sqlPools.
+func evictInlineSQLPools() {
+	var idle []*sqlPool
+	count := 0
+	for name, pool := range sqlPools.byName {
+		if !strings.HasPrefix(name, "inline:") {
+			continue
+		}
+		count++
+		if pool.db.Stats().InUse == 0 {
+			idle = append(idle, pool)
+		}
+	}
+	sort.Slice(idle, func(i, j int) bool { return idle[i].lastUsed.Before(idle[j].lastUsed) })
+
+	for _, pool := range idle {
+		if count < sqlMaxInlinePools && time.Since(pool.lastUsed) < sqlInlinePoolIdleTimeout {
+			break
+		}
+		delete(sqlPools.byName, pool.name)
+		count--
+		// Close waits for queries in flight to finish
+		go pool.db.Close()
+	}
+}
+
+// buildSQLDSN returns the connection string of a database credential.
+func buildSQLDSN(driver string, data map[string]interface{}) (string, error) {
+	str := func(key string) string {
+		if value, ok := data[key]; ok && value != nil {
+			return fmt.Sprintf("%v", value)
+		}
+		return ""
+	}
+
+	if dsn := str("dsn"); dsn != "" {
+		return dsn, nil
+	}
+
+	options := url.Values{}
+	if extra, ok := data["options"].(map[string]interface{}); ok {
+		for key, value := range extra {
+			options.Set(key, fmt.Sprintf("%v", value))
+		}
+	}
+
+	if driver == "sqlite" {
+		if str("database") == "" {
+			return "", fmt.Errorf("sqlite credentials require 'database' (the file path) or 'dsn'")
+		}
+		if len(options) == 0 {
+			return str("database"), nil
+		}
+		return "file:" + str("database") + "?" + options.Encode(), nil
+	}
+
+	host := str("host")
+	if host == "" {
+		return "", fmt.Errorf("credentials require 'dsn' or 'host'")
+	}
+	port := str("port")
+	if port == "" {
+		port = sqlDefaultPorts[driver]
+	}
+
+	switch driver {
+	case "sqlserver":
+		if database := str("database"); database != "" {
+			options.Set("database", database)
+		}
+		u := url.URL{Scheme: "sqlserver", Host: net.JoinHostPort(host, port), RawQuery: options.Encode()}
+		if username := str("username"); username != "" {
+			u.User = url.UserPassword(username, str("password"))
+		}
+		return u.String(), nil
+	case "postgres":
+		u := url.URL{Scheme: "postgres", Host: net.JoinHostPort(host, port), Path: "/" + str("database"), RawQuery: options.Encode()}
+		if username := str("username"); username != "" {
+			u.User = url.UserPassword(username, str("password"))
+		}
+		return u.String(), nil
+	case "mysql":
+		config := mysql.NewConfig()
+		config.User = str("username")
+		config.Passwd = str("password")
+		config.Net = "tcp"
+		config.Addr = net.JoinHostPort(host, port)
+		config.DBName = str("database")
+		config.ParseTime = true
+		if len(options) > 0 {
+			config.Params = make(map[string]string)
+			for key := range options {
+				config.Params[key] = options.Get(key)
+			}
+		}
+		return config.FormatDSN(), nil
+	default:
+		return "", fmt.Errorf("unsupported driver %q: expected sqlserver, postgres, mysql or sqlite", driver)
+	}
+}
+
+// sqlHostDialer dials SQL Server through the outbound policy. It implements
+// mssql.HostDialer so the driver passes the host name instead of resolving it,
+// which lets host rules apply.
+type sqlHostDialer struct {
+	host string
+	dial func(ctx context.Context, network, addr string) (net.Conn, error)
+}
+
+func (d sqlHostDialer) DialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	return d.dial(ctx, network, addr)
+}
+
+func (d sqlHostDialer) HostName() string {
+	return d.host
+}
+
+// openSQLPool opens a pool whose network connections go through the outbound
+// policy. data holds the pool settings; nil uses the defaults.
+func openSQLPool(name, driver, dsn string, data map[string]interface{}) (*sqlPool, error) {
+	dial := policyDialContext(&net.Dialer{Timeout: 15 * time.Second, KeepAlive: 30 * time.Second})
+
+	var db *sql.DB
+	switch driver {
+	case "sqlserver":
+		params, err := msdsn.Parse(dsn)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sqlserver connection string: %w", err)
+		}
+		connector, err := mssql.NewConnector(dsn)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sqlserver connection string: %w", err)
+		}
+		connector.Dialer = sqlHostDialer{host: params.Host, dial: dial}
+		db = sql.OpenDB(connector)
+	case "postgres":
+		config, err := pgx.ParseConfig(dsn)
+		if err != nil {
+			return nil, fmt.Errorf("invalid postgres connection string: %w", err)
+		}
+		config.DialFunc = dial
+		// Hosts are resolved by the dialer so that host rules apply
+		config.LookupFunc = func(ctx context.Context, host string) ([]string, error) {
+			return []string{host}, nil
+		}
+		db = stdlib.OpenDB(*config)
+	case "mysql":
+		config, err := mysql.ParseDSN(dsn)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mysql connection string: %w", err)
+		}
+		config.DialFunc = dial
+		connector, err := mysql.NewConnector(config)
+		if err != nil {
+			return nil, fmt.Errorf("invalid mysql connection string: %w", err)
+		}
+		db = sql.OpenDB(connector)
+	case "sqlite":
+		var err error
+		if db, err = sql.Open("sqlite", dsn); err != nil {
+			return nil, fmt.Errorf("invalid sqlite connection string: %w", err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported driver %q: expected sqlserver, postgres, mysql or sqlite", driver)
+	}
+
+	setting := func(key string, fallback int) int {
+		if n, err := toNumber(data[key]); err == nil && data[key] != nil {
+			return int(n)
+		}
+		return fallback
+	}
+	seconds := func(key string, fallback time.Duration) time.Duration {
+		return time.Duration(setting(key, int(fallback/time.Second))) * time.Second
+	}
+
+	db.SetMaxOpenConns(setting("maxOpenConns", sqlDefaultMaxOpenConns))
+	db.SetMaxIdleConns(setting("maxIdleConns", sqlDefaultMaxIdleConns))
+	db.SetConnMaxLifetime(seconds("connMaxLifetime", sqlDefaultConnMaxLifetime))
+	db.SetConnMaxIdleTime(seconds("connMaxIdleTime", sqlDefaultConnMaxIdleTime))
+
+	return &sqlPool{
+		name:                name,
+		driver:              driver,
+		db:                  db,
+		healthCheckInterval: seconds("healthCheckInterval", sqlDefaultHealthCheckInterval),
+	}, nil
+}
+
+// checkHealth pings the database unless a recent ping succeeded, so a dead
+// server fails the node up front instead of in the middle of a statement.
+func (p *sqlPool) checkHealth(ctx context.Context) error {
+	p.mu.Lock()
+	fresh := p.lastErr == "" && !p.lastPing.IsZero() && time.Since(p.lastPing) < p.healthCheckInterval
+	p.mu.Unlock()
+	if fresh {
+		return nil
+	}
+
+	// Ping without the lock so that a slow server does not hold up status()
+	ctx, cancel := context.WithTimeout(ctx, 15*time.Second)
+	defer cancel()
+	err := p.db.PingContext(ctx)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.lastPing = time.Now()
+	if err != nil {
+		p.lastErr = err.Error()
+		return fmt.Errorf("database %s is not reachable: %w", p.name, err)
+	}
+	p.lastErr = ""
+	return nil
+}
+
+// status returns the API view of the pool.
+func (p *sqlPool) status() SQLPoolStatus {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status := SQLPoolStatus{
+		Name:      p.name,
+		Driver:    p.driver,
+		Healthy:   p.lastErr == "",
+		LastError: p.lastErr,
+		Stats:     p.db.Stats(),
+	}
+	if !p.lastPing.IsZero() {
+		lastPing := p.lastPing
+		status.LastPing = &lastPing
+	}
+	return status
+}
+
+// SQLPoolStatuses returns the status of every open pool, sorted by name.
+func SQLPoolStatuses() []SQLPoolStatus {
+	sqlPools.Lock()
+	pools := make([]*sqlPool, 0, len(sqlPools.byName))
+	for _, pool := range sqlPools.byName {
+		pools = append(pools, pool)
+	}
+	sqlPools.Unlock()
+
+	statuses := make([]SQLPoolStatus, 0, len(pools))
+	for _, pool := range pools {
+		statuses = append(statuses, pool.status())
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// CheckSQLPool pings the database of a credential and returns its status.
+func CheckSQLPool(name string) (SQLPoolStatus, error) {
+	credentialType, data, err := GetCredentialData(name)
+	if err != nil {
+		return SQLPoolStatus{}, err
+	}
+	if credentialType != "database" {
+		return SQLPoolStatus{}, fmt.Errorf("invalid credential %s: type %s is not a database credential", name, credentialType)
+	}
+
+	pool, err := getSQLPool(name, data)
+	if err != nil {
+		return SQLPoolStatus{}, err
+	}
+
+	// Force a fresh ping
+	pool.mu.Lock()
+	pool.lastPing = time.Time{}
+	pool.mu.Unlock()
+	pool.checkHealth(context.Background())
+
+	return pool.status(), nil
+}
+
+// invalidateSQLPool closes the pool of a credential so changed settings apply.
+func invalidateSQLPool(name string) {
+	sqlPools.Lock()
+	pool, ok := sqlPools.byName[name]
+	delete(sqlPools.byName, name)
+	sqlPools.Unlock()
+
+	if ok {
+		// Close waits for queries in flight to finish
+		go pool.db.Close()
+	}
+}
+
+// CloseSQLPools closes every pool; called on shutdown.
+func CloseSQLPools() {
+	sqlPools.Lock()
+	pools := sqlPools.byName
+	sqlPools.byName = make(map[string]*sqlPool)
+	sqlPools.Unlock()
+
+	for name, pool := range pools {
+		if err := pool.db.Close(); err != nil {
+			log.Printf("Failed to close database pool %s: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// sqlResultOptions controls how rows are converted into node results.
+type sqlResultOptions struct {
+	decimals    string // auto (default), number or string.
+	columnCase  string // lower (default) or preserve.
+	maxRows     int    // Rows read before the result is truncated (0 for no limit).
+	native      bool   // Keep times and binary values as they are, for writing to another database.
+	driver      string
+	columnNames []string
+	columnTypes []string
+	numericCols []bool // Decimal columns returned as numbers
+}
+
+// Database type names by kind, as reported by the drivers
+var (
+	sqlIntegerTypes = map[string]bool{"INT": true, "INTEGER": true, "BIGINT": true, "SMALLINT": true, "TINYINT": true,
+		"MEDIUMINT": true, "INT2": true, "INT4": true, "INT8": true, "YEAR": true, "UNSIGNED INT": true,
+		"UNSIGNED BIGINT": true, "UNSIGNED SMALLINT": true, "UNSIGNED TINYINT": true, "UNSIGNED MEDIUMINT": true}
+	sqlFloatTypes   = map[string]bool{"FLOAT": true, "DOUBLE": true, "REAL": true, "FLOAT4": true, "FLOAT8": true}
+	sqlDecimalTypes = map[string]bool{"DECIMAL": true, "NUMERIC": true, "MONEY": true, "SMALLMONEY": true, "UNSIGNED DECIMAL": true}
+	sqlBinaryTypes  = map[string]bool{"BINARY": true, "VARBINARY": true, "BLOB": true, "TINYBLOB": true,
+		"MEDIUMBLOB": true, "LONGBLOB": true, "IMAGE": true, "BYTEA": true}
+	sqlDateTimeTypes = map[string]bool{"DATETIME": true, "DATETIME2": true, "SMALLDATETIME": true,
+		"DATETIMEOFFSET": true, "TIMESTAMP": true, "TIMESTAMPTZ": true}
+)
+
+// parseSQLResultOptions reads the conversion options of a node.
+func parseSQLResultOptions(params map[string]interface{}) (sqlResultOptions, error) {
+	options := sqlResultOptions{decimals: "auto", columnCase: "lower"}
+	if decimals, _ := params["decimals"].(string); decimals != "" {
+		options.decimals = decimals
+	}
+	if columnCase, _ := params["columnCase"].(string); columnCase != "" {
+		options.columnCase = columnCase
+	}
+	if n, err := toNumber(params["maxRows"]); err == nil && n > 0 {
+		options.maxRows = int(n)
+	}
+
+	switch options.decimals {
+	case "auto", "number", "string":
+	default:
+		return options, fmt.Errorf("invalid decimals %q: expected auto, number or string", options.decimals)
+	}
+	switch options.columnCase {
+	case "lower", "preserve":
+	default:
+		return options, fmt.Errorf("invalid columnCase %q: expected lower or preserve", options.columnCase)
+	}
+	return options, nil
+}
+
+// prepare reads the column names and types of a result set.
+func (o *sqlResultOptions) prepare(driver string, rows *sql.Rows) error {
+	columns, err := rows.Columns()
+	if err != nil {
+		return fmt.Errorf("failed to get columns: %w", err)
+	}
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return fmt.Errorf("failed to get column types: %w", err)
+	}
+
+	o.driver = driver
+	o.columnNames = make([]string, len(columns))
+	o.columnTypes = make([]string, len(columns))
+	o.numericCols = make([]bool, len(columns))
+	for i, column := range columns {
+		if o.columnCase == "lower" {
+			column = strings.ToLower(column)
+		}
+		o.columnNames[i] = column
+		o.columnTypes[i] = strings.ToUpper(types[i].DatabaseTypeName())
+		o.numericCols[i] = o.decimalsAsNumbers(types[i])
+	}
+	return nil
+}
+
+// decimalsAsNumbers reports whether the decimals of a column are returned as
+// numbers. In auto mode that depends on the declared precision rather than on
+// each value, so a column always has one type: numbers when a float64 keeps
+// every digit (precision 15 or less), strings otherwise or when the precision
+// is not known.
+func (o *sqlResultOptions) decimalsAsNumbers(columnType *sql.ColumnType) bool {
+	switch o.decimals {
+	case "number":
+		return true
+	case "string":
+		return false
+	}
+	precision, _, ok := columnType.DecimalSize()
+	return ok && precision > 0 && precision <= 15
+}
+
+// scanRow reads the current row and converts its values.
+func (o *sqlResultOptions) scanRow(rows *sql.Rows) (map[string]interface{}, error) {
+	values := make([]interface{}, len(o.columnNames))
+	valuePtrs := make([]interface{}, len(o.columnNames))
+	for i := range values {
+		valuePtrs[i] = &values[i]
+	}
+
+	if err := rows.Scan(valuePtrs...); err != nil {
+		return nil, fmt.Errorf("failed to scan row: %w", err)
+	}
+
+	row := make(map[string]interface{}, len(values))
+	for i, column := range o.columnNames {
+		row[column] = o.convert(values[i], i)
+	}
+	return row, nil
+}
+
+// convert turns a driver value into a JSON friendly value based on the type of its column.
+func (o *sqlResultOptions) convert(value interface{}, column int) interface{} {
+	columnType := o.columnTypes[column]
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case time.Time:
+		if o.native {
+			return v
+		}
+		switch columnType {
+		case "DATE":
+			return v.Format("2006-01-02")
+		case "TIME":
+			return v.Format("15:04:05.999999999")
+		}
+		return v.Format(time.RFC3339Nano)
+	case []byte:
+		switch {
+		case columnType == "UNIQUEIDENTIFIER" && len(v) == 16:
+			return formatSQLServerGUID(v)
+		case columnType == "UUID" && len(v) == 16:
+			return fmt.Sprintf("%x-%x-%x-%x-%x", v[0:4], v[4:6], v[6:8], v[8:10], v[10:16])
+		case sqlBinaryTypes[columnType], columnType == "TIMESTAMP" && o.driver == "sqlserver", columnType == "ROWVERSION":
+			if o.native {
+				return v
+			}
+			return base64.StdEncoding.EncodeToString(v)
+		}
+		return o.convert(string(v), column)
+	case string:
+		switch {
+		case sqlDecimalTypes[columnType]:
+			if o.numericCols[column] {
+				if n, err := strconv.ParseFloat(v, 64); err == nil {
+					return n
+				}
+			}
+		case sqlIntegerTypes[columnType]:
+			if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+				return n
+			}
+		case sqlFloatTypes[columnType]:
+			if n, err := strconv.ParseFloat(v, 64); err == nil {
+				return n
+			}
+		case columnType == "JSON" || columnType == "JSONB":
+			var parsed interface{}
+			if err := json.Unmarshal([]byte(v), &parsed); err == nil {
+				return parsed
+			}
+		case sqlDateTimeTypes[columnType] && !o.native:
+			// Text protocol values such as MySQL's "2024-01-31 13:45:00"
+			for _, layout := range []string{"2006-01-02 15:04:05.999999999", time.RFC3339Nano} {
+				if t, err := time.Parse(layout, v); err == nil {
+					return t.Format(time.RFC3339Nano)
+				}
+			}
+		}
+		return v
+	}
+	return value
+}
+
+// formatSQLServerGUID formats a uniqueidentifier, whose first three groups
+// SQL Server stores little-endian.
+func formatSQLServerGUID(b []byte) string {
+	return fmt.Sprintf("%X-%X-%X-%X-%X",
+		[]byte{b[3], b[2], b[1], b[0]}, []byte{b[5], b[4]}, []byte{b[7], b[6]}, b[8:10], b[10:16])
+}
@@ -0,0 +1,696 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Template functions are applied in a pipeline after the base expression,
+// e.g. {{$item.name | trim | substring:0,40}}. Arguments follow the function
+// name after a colon, separated by commas, and can be:
+//
+//   - literals, used as written: truncate:40
+//   - quoted strings, for text with commas, colons or pipes: formatDate:'Mon, 02 Jan 2006'
+//   - expressions starting with $ or config.: join:$item.lastName, lookup:$node['cfg'].table
+//   - pipelines in parentheses: defaultIfEmpty:($item.fullName | trim)
+//
+// Every argument is converted to the type declared by the function's signature.
+// Functions are added to the registry from Go code with RegisterTemplateFunction.
+
+// TemplateFunction describes a function that can be used in template pipelines
+type TemplateFunction struct {
+	Name        string        `json:"name"`
+	Category    string        `json:"category"`
+	Description string        `json:"description"`
+	Input       string        `json:"input"` // Type of the value piped into the function
+	Args        []FunctionArg `json:"args,omitempty"`
+	Variadic    bool          `json:"variadic,omitempty"` // The last argument can be repeated
+	Returns     string        `json:"returns"`
+
+	// Call receives the value and the arguments converted to their declared
+	// types; optional arguments that were not given are left out
+	Call func(value interface{}, args []interface{}) (interface{}, error) `json:"-"`
+}
+
+// FunctionArg describes an argument of a template function
+type FunctionArg struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// functionTypes are the types values and arguments can be declared with
+var functionTypes = map[string]bool{
+	"any": true, "string": true, "number": true, "integer": true,
+	"boolean": true, "object": true, "array": true,
+}
+
+var templateFunctions = struct {
+	sync.Mutex
+	byName map[string]TemplateFunction
+}{byName: make(map[string]TemplateFunction)}
+
+func init() {
+	for _, fn := range builtinTemplateFunctions() {
+		if err := RegisterTemplateFunction(fn); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// RegisterTemplateFunction adds a function to the registry, replacing a
+// function of the same name
+func RegisterTemplateFunction(fn TemplateFunction) error {
+	if fn.Name == "" || strings.ContainsAny(fn.Name, ":|,() ") {
+		return fmt.Errorf("invalid template function name: %q", fn.Name)
+	}
+	if fn.Call == nil {
+		return fmt.Errorf("invalid template function %s: Call is required", fn.Name)
+	}
+	if fn.Input == "" {
+		fn.Input = "any"
+	}
+	if !functionTypes[fn.Input] {
+		return fmt.Errorf("invalid template function %s: unknown input type %s", fn.Name, fn.Input)
+	}
+	optional := false
+	for _, arg := range fn.Args {
+		if !functionTypes[arg.Type] {
+			return fmt.Errorf("invalid template function %s: unknown type %s for argument %s", fn.Name, arg.Type, arg.Name)
+		}
+		if optional && !arg.Optional {
+			return fmt.Errorf("invalid template function %s: argument %s follows an optional argument", fn.Name, arg.Name)
+		}
+		optional = arg.Optional
+	}
+	if fn.Variadic && len(fn.Args) == 0 {
+		return fmt.Errorf("invalid template function %s: a variadic function needs an argument", fn.Name)
+	}
+
+	templateFunctions.Lock()
+	defer templateFunctions.Unlock()
+	templateFunctions.byName[fn.Name] = fn
+	return nil
+}
+
+// TemplateFunctions returns the registered template functions sorted by name
+func TemplateFunctions() []TemplateFunction {
+	templateFunctions.Lock()
+	defer templateFunctions.Unlock()
+
+	functions := make([]TemplateFunction, 0, len(templateFunctions.byName))
+	for _, fn := range templateFunctions.byName {
+		functions = append(functions, fn)
+	}
+	sort.Slice(functions, func(i, j int) bool { return functions[i].Name < functions[j].Name })
+	return functions
+}
+
+func lookupTemplateFunction(name string) (TemplateFunction, bool) {
+	templateFunctions.Lock()
+	defer templateFunctions.Unlock()
+	fn, ok := templateFunctions.byName[name]
+	return fn, ok
+}
+
+// parseFunctionCall splits a call such as substring:0,10 into the function
+// name and its raw arguments
+func parseFunctionCall(call string) (string, []string) {
+	name, rest, hasArgs := strings.Cut(strings.TrimSpace(call), ":")
+	var args []string
+	if hasArgs {
+		for _, arg := range splitTopLevel(rest, ',') {
+			args = append(args, strings.TrimSpace(arg))
+		}
+	}
+	return strings.TrimSpace(name), args
+}
+
+// checkTemplateFunctionCall checks that a call names a registered function and
+// passes it an acceptable number of arguments
+func checkTemplateFunctionCall(call string) (TemplateFunction, []string, error) {
+	name, args := parseFunctionCall(call)
+	fn, ok := lookupTemplateFunction(name)
+	if !ok {
+		return fn, nil, fmt.Errorf("%w: %s", ErrUnknownFunction, name)
+	}
+	if len(args) < len(fn.Args) && !fn.Args[len(args)].Optional {
+		return fn, nil, fmt.Errorf("%s requires the %s argument", name, fn.Args[len(args)].Name)
+	}
+	if len(args) > len(fn.Args) && !fn.Variadic {
+		return fn, nil, fmt.Errorf("%s takes at most %d arguments, got %d", name, len(fn.Args), len(args))
+	}
+	return fn, args, nil
+}
+
+// splitTopLevel splits s at sep, except inside quotes, parentheses and
+// brackets. A quote only opens a string at the start of an argument or
+// after a bracket, so apostrophes inside words are kept as is.
+func splitTopLevel(s string, sep byte) []string {
+	var parts []string
+	var quote byte
+	var prev byte // Last non-space character outside quotes
+	depth, start := 0, 0
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if quote != 0 {
+			if c == '\\' {
+				i++
+			} else if c == quote {
+				quote = 0
+				prev = c
+			}
+			continue
+		}
+
+		switch {
+		case (c == '\'' || c == '"') && (prev == 0 || strings.IndexByte(":,([|", prev) >= 0):
+			quote = c
+		case c == '(' || c == '[':
+			depth++
+		case (c == ')' || c == ']') && depth > 0:
+			depth--
+		case c == sep && depth == 0:
+			parts = append(parts, s[start:i])
+			start = i + 1
+		}
+		if c != ' ' && c != '\t' {
+			prev = c
+		}
+	}
+	return append(parts, s[start:])
+}
+
+// unquoteArg returns the text of a quoted argument, with backslash escapes
+// removed
+func unquoteArg(arg string) (string, bool) {
+	if len(arg) < 2 || (arg[0] != '\'' && arg[0] != '"') || arg[len(arg)-1] != arg[0] {
+		return "", false
+	}
+	var out strings.Builder
+	for i := 1; i < len(arg)-1; i++ {
+		if arg[i] == '\\' && i+1 < len(arg)-1 {
+			i++
+		}
+		out.WriteByte(arg[i])
+	}
+	return out.String(), true
+}
+
+// resolveFunctionArg returns the value of a raw function argument
+func (we *WorkflowEngine) resolveFunctionArg(arg string) interface{} {
+	if text, ok := unquoteArg(arg); ok {
+		return text
+	}
+	if strings.HasPrefix(arg, "(") && strings.HasSuffix(arg, ")") {
+		return we.resolveExpression(strings.TrimSpace(arg[1 : len(arg)-1]))
+	}
+	if strings.HasPrefix(arg, "$") || strings.HasPrefix(arg, "config.") {
+		return we.resolveBaseExpression(arg)
+	}
+	return arg
+}
+
+// convertFunctionValue converts a value to one of the functionTypes
+func convertFunctionValue(value interface{}, typ string) (interface{}, bool) {
+	switch typ {
+	case "string":
+		return valueString(value), true
+	case "number":
+		return numberValue(value)
+	case "integer":
+		n, ok := numberValue(value)
+		if !ok || n != math.Trunc(n) {
+			return nil, false
+		}
+		return int(n), true
+	case "boolean":
+		switch v := value.(type) {
+		case bool:
+			return v, true
+		case string:
+			b, err := strconv.ParseBool(strings.TrimSpace(v))
+			return b, err == nil
+		}
+		return nil, false
+	case "object":
+		obj, ok := value.(map[string]interface{})
+		return obj, ok
+	case "array":
+		list, ok := value.([]interface{})
+		return list, ok
+	}
+	return value, true
+}
+
+// callTemplateFunction applies one function call of a pipeline to a value
+func (we *WorkflowEngine) callTemplateFunction(value interface{}, call string) (interface{}, error) {
+	fn, rawArgs, err := checkTemplateFunctionCall(call)
+	if err != nil {
+		return nil, err
+	}
+
+	input, ok := convertFunctionValue(value, fn.Input)
+	if !ok {
+		return nil, fmt.Errorf("%s expects a value of type %s, got %T", fn.Name, fn.Input, value)
+	}
+
+	args := make([]interface{}, len(rawArgs))
+	for i, raw := range rawArgs {
+		spec := fn.Args[min(i, len(fn.Args)-1)]
+		arg, ok := convertFunctionValue(we.resolveFunctionArg(raw), spec.Type)
+		if !ok {
+			return nil, fmt.Errorf("%s: the %s argument must be of type %s, got %q", fn.Name, spec.Name, spec.Type, raw)
+		}
+		args[i] = arg
+	}
+
+	return fn.Call(input, args)
+}
+
+// stringArg returns an optional string argument, empty when it was not given
+func stringArg(args []interface{}, i int) string {
+	if i < len(args) {
+		s, _ := args[i].(string)
+		return s
+	}
+	return ""
+}
+
+// stringArgs returns string arguments as a slice
+func stringArgs(args []interface{}) []string {
+	strs := make([]string, len(args))
+	for i := range args {
+		strs[i] = stringArg(args, i)
+	}
+	return strs
+}
+
+// sortedKeys returns the keys of an object in order
+func sortedKeys(obj map[string]interface{}) []string {
+	names := keys(obj)
+	sort.Strings(names)
+	return names
+}
+
+// builtinTemplateFunctions returns the functions every workflow can use
+func builtinTemplateFunctions() []TemplateFunction {
+	arg := func(name, typ string) FunctionArg { return FunctionArg{Name: name, Type: typ} }
+	optional := func(name, typ string) FunctionArg { return FunctionArg{Name: name, Type: typ, Optional: true} }
+
+	functions := []TemplateFunction{
+		// Strings
+		{
+			Name: "truncate", Category: "string", Input: "string", Returns: "string",
+			Description: "Shortens a string to at most maxLen characters",
+			Args:        []FunctionArg{arg("maxLen", "integer")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return truncate(v.(string), args[0].(int)), nil
+			},
+		},
+		{
+			Name: "join", Category: "string", Input: "string", Returns: "string",
+			Description: "Joins the value and another string with a space",
+			Args:        []FunctionArg{arg("other", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return join(v.(string), args[0].(string)), nil
+			},
+		},
+		{
+			Name: "concat", Category: "string", Input: "string", Returns: "string",
+			Description: "Appends one or more strings to the value",
+			Args:        []FunctionArg{arg("text", "string")}, Variadic: true,
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return concat(append([]string{v.(string)}, stringArgs(args)...)...), nil
+			},
+		},
+		{
+			Name: "substring", Category: "string", Input: "string", Returns: "string",
+			Description: "Returns the characters from start up to end (the end of the string by default)",
+			Args:        []FunctionArg{arg("start", "integer"), optional("end", "integer")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				s := v.(string)
+				end := strLength(s)
+				if len(args) > 1 {
+					end = args[1].(int)
+				}
+				return substring(s, args[0].(int), end), nil
+			},
+		},
+		{
+			Name: "toUpperCase", Category: "string", Input: "string", Returns: "string",
+			Description: "Converts a string to upper case",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return toUpperCase(v.(string)), nil
+			},
+		},
+		{
+			Name: "toLowerCase", Category: "string", Input: "string", Returns: "string",
+			Description: "Converts a string to lower case",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return toLowerCase(v.(string)), nil
+			},
+		},
+		{
+			Name: "trim", Category: "string", Input: "string", Returns: "string",
+			Description: "Removes leading and trailing white space",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return trim(v.(string)), nil
+			},
+		},
+		{
+			Name: "split", Category: "string", Input: "string", Returns: "array",
+			Description: "Splits a string into an array at each separator",
+			Args:        []FunctionArg{arg("separator", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				parts := split(v.(string), args[0].(string))
+				list := make([]interface{}, len(parts))
+				for i, part := range parts {
+					list[i] = part
+				}
+				return list, nil
+			},
+		},
+		{
+			Name: "replace", Category: "string", Input: "string", Returns: "string",
+			Description: "Replaces the first occurrence of old with new",
+			Args:        []FunctionArg{arg("old", "string"), arg("new", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return replace(v.(string), args[0].(string), args[1].(string)), nil
+			},
+		},
+		{
+			Name: "strLength", Category: "string", Input: "string", Returns: "integer",
+			Description: "Returns the length of a string in characters",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return strLength(v.(string)), nil
+			},
+		},
+		{
+			Name: "indexOf", Category: "string", Input: "string", Returns: "integer",
+			Description: "Returns the position of the first occurrence of a substring, or -1",
+			Args:        []FunctionArg{arg("substring", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return indexOf(v.(string), args[0].(string)), nil
+			},
+		},
+		{
+			Name: "includes", Category: "string", Input: "string", Returns: "boolean",
+			Description: "Reports whether a string contains a substring",
+			Args:        []FunctionArg{arg("substring", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return includes(v.(string), args[0].(string)), nil
+			},
+		},
+		{
+			Name: "startsWith", Category: "string", Input: "string", Returns: "boolean",
+			Description: "Reports whether a string starts with a prefix",
+			Args:        []FunctionArg{arg("prefix", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return startsWith(v.(string), args[0].(string)), nil
+			},
+		},
+		{
+			Name: "endsWith", Category: "string", Input: "string", Returns: "boolean",
+			Description: "Reports whether a string ends with a suffix",
+			Args:        []FunctionArg{arg("suffix", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return endsWith(v.(string), args[0].(string)), nil
+			},
+		},
+		{
+			Name: "defaultIfEmpty", Category: "string", Input: "any", Returns: "any",
+			Description: "Replaces a missing or empty value with a default",
+			Args:        []FunctionArg{arg("default", "any")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				if v == nil || v == "" {
+					return args[0], nil
+				}
+				return v, nil
+			},
+		},
+
+		// Escaping
+		{
+			Name: "urlEncode", Category: "escaping", Input: "string", Returns: "string",
+			Description: "Percent-encodes a URL query value, or a path segment with mode path",
+			Args:        []FunctionArg{optional("mode", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return urlEncode(v.(string), stringArg(args, 0)), nil
+			},
+		},
+		{
+			Name: "odataString", Category: "escaping", Input: "string", Returns: "string",
+			Description: "Escapes a string for a quoted OData literal",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return odataString(v.(string)), nil
+			},
+		},
+		{
+			Name: "sqlString", Category: "escaping", Input: "string", Returns: "string",
+			Description: "Escapes a string for a quoted SQL literal",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return sqlString(v.(string)), nil
+			},
+		},
+		{
+			Name: "soqlString", Category: "escaping", Input: "string", Returns: "string",
+			Description: "Escapes a string for a quoted SOQL literal",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return soqlString(v.(string)), nil
+			},
+		},
+		{
+			Name: "raw", Category: "escaping", Input: "any", Returns: "any",
+			Description: "Marks a value that automatic URL escaping must leave alone",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return v, nil
+			},
+		},
+
+		// Numbers and conversion
+		{
+			Name: "toNumber", Category: "conversion", Input: "any", Returns: "number",
+			Description: "Converts a number or numeric string to a number",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				num, err := toNumber(v)
+				if err != nil {
+					return nil, fmt.Errorf("toNumber conversion failed: %w", err)
+				}
+				return num, nil
+			},
+		},
+		{
+			Name: "toBoolean", Category: "conversion", Input: "any", Returns: "boolean",
+			Description: "Converts a boolean or a string such as true or 0 to a boolean",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				b, err := toBoolean(v)
+				if err != nil {
+					return nil, fmt.Errorf("toBoolean conversion failed: %w", err)
+				}
+				return b, nil
+			},
+		},
+		{
+			Name: "parseInt", Category: "conversion", Input: "string", Returns: "integer",
+			Description: "Parses an integer; an empty string gives 0",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				n, err := parseInt(strings.TrimSpace(v.(string)))
+				if err != nil {
+					return nil, fmt.Errorf("parseInt: %w", err)
+				}
+				return n, nil
+			},
+		},
+		{
+			Name: "parseFloat", Category: "conversion", Input: "string", Returns: "number",
+			Description: "Parses a decimal number; an empty string gives 0",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				n, err := parseFloat(strings.TrimSpace(v.(string)))
+				if err != nil {
+					return nil, fmt.Errorf("parseFloat: %w", err)
+				}
+				return n, nil
+			},
+		},
+		{
+			Name: "toFixed", Category: "conversion", Input: "number", Returns: "string",
+			Description: "Formats a number with a fixed number of decimals",
+			Args:        []FunctionArg{arg("digits", "integer")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return toFixed(v.(float64), args[0].(int)), nil
+			},
+		},
+
+		// JSON and objects
+		{
+			Name: "parseJSON", Category: "json", Input: "string", Returns: "any",
+			Description: "Parses a JSON document",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				parsed, err := parseJSON(v.(string))
+				if err != nil {
+					return nil, fmt.Errorf("parseJSON: %w", err)
+				}
+				return parsed, nil
+			},
+		},
+		{
+			Name: "stringifyJSON", Category: "json", Input: "any", Returns: "string",
+			Description: "Encodes a value as JSON",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return stringifyJSON(v)
+			},
+		},
+		{
+			Name: "hasOwnProperty", Category: "json", Input: "object", Returns: "boolean",
+			Description: "Reports whether an object has a key",
+			Args:        []FunctionArg{arg("key", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return hasOwnProperty(v.(map[string]interface{}), args[0].(string)), nil
+			},
+		},
+		{
+			Name: "keys", Category: "json", Input: "object", Returns: "array",
+			Description: "Returns the keys of an object in order",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				names := sortedKeys(v.(map[string]interface{}))
+				list := make([]interface{}, len(names))
+				for i, name := range names {
+					list[i] = name
+				}
+				return list, nil
+			},
+		},
+		{
+			Name: "values", Category: "json", Input: "object", Returns: "array",
+			Description: "Returns the values of an object, ordered by key",
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				obj := v.(map[string]interface{})
+				list := make([]interface{}, 0, len(obj))
+				for _, name := range sortedKeys(obj) {
+					list = append(list, obj[name])
+				}
+				return list, nil
+			},
+		},
+
+		// Countries and subdivisions (ISO 3166)
+		countryFunction("countryToAlpha2", "alpha2", "Converts a country name or code to its ISO 3166-1 alpha-2 code"),
+		countryFunction("countryToAlpha3", "alpha3", "Converts a country name or code to its ISO 3166-1 alpha-3 code"),
+		countryFunction("countryToNumeric", "numeric", "Converts a country name or code to its ISO 3166-1 numeric code"),
+		{
+			Name: "countryName", Category: "country", Input: "string", Returns: "string",
+			Description: "Returns the English (en) or Hebrew (he) name of a country",
+			Args:        []FunctionArg{optional("language", "string"), optional("fallback", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				form := "name"
+				if stringArg(args, 0) == "he" {
+					form = "hebrew"
+				}
+				return countryCode(v.(string), form, stringArg(args, 1)), nil
+			},
+		},
+		{
+			Name: "subdivisionCode", Category: "country", Input: "string", Returns: "string",
+			Description: "Converts a subdivision name or code to its ISO 3166-2 code; the country is needed for names and short codes",
+			Args:        []FunctionArg{optional("country", "string"), optional("fallback", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return subdivisionCode(v.(string), stringArg(args, 0), stringArg(args, 1)), nil
+			},
+		},
+		{
+			Name: "subdivisionName", Category: "country", Input: "string", Returns: "string",
+			Description: "Returns the name of a subdivision",
+			Args:        []FunctionArg{optional("country", "string"), optional("fallback", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				return subdivisionName(v.(string), stringArg(args, 0), stringArg(args, 1)), nil
+			},
+		},
+
+		// Lookup tables
+		{
+			Name: "lookup", Category: "lookup", Input: "string", Returns: "any",
+			Description: "Looks a value up in a lookup table; without a default, values not found are kept",
+			Args:        []FunctionArg{arg("table", "string"), optional("default", "any"), optional("column", "string")},
+			Call: func(v interface{}, args []interface{}) (interface{}, error) {
+				table := args[0].(string)
+				if table == "" {
+					return nil, fmt.Errorf("lookup requires a table argument")
+				}
+				value, found, err := lookupValue(table, v.(string), stringArg(args, 2))
+				if err != nil {
+					return nil, err
+				}
+				if found {
+					return value, nil
+				}
+				if len(args) > 1 {
+					return args[1], nil
+				}
+				return v, nil
+			},
+		},
+
+		// Dates and times
+		dateFunction("formatDate", "Formats a time in a layout, in a time zone when given", "string",
+			arg("layout", "string"), optional("zone", "string")),
+		dateFunction("parseDate", "Parses a time written in a layout", "date",
+			arg("layout", "string"), optional("zone", "string")),
+		dateFunction("toTimezone", "Converts a time to a time zone, e.g. Asia/Jerusalem", "date",
+			arg("zone", "string")),
+		dateFunction("addDuration", "Adds a duration such as 90m, 7d, 1M or 1y2M", "date",
+			arg("amount", "string")),
+		dateFunction("subtractDuration", "Subtracts a duration such as 90m, 7d, 1M or 1y2M", "date",
+			arg("amount", "string")),
+		dateFunction("startOfDay", "Returns the start of the day, in a time zone when given", "date",
+			optional("zone", "string")),
+		dateFunction("endOfDay", "Returns the end of the day, in a time zone when given", "date",
+			optional("zone", "string")),
+		dateFunction("startOfMonth", "Returns the start of the month, in a time zone when given", "date",
+			optional("zone", "string")),
+		dateFunction("endOfMonth", "Returns the end of the month, in a time zone when given", "date",
+			optional("zone", "string")),
+		dateFunction("isBefore", "Reports whether a time is before another time, now or today", "boolean",
+			arg("other", "string")),
+		dateFunction("isAfter", "Reports whether a time is after another time, now or today", "boolean",
+			arg("other", "string")),
+		dateFunction("isSameDay", "Reports whether a time is on the same day as another time", "boolean",
+			arg("other", "string"), optional("zone", "string")),
+		dateFunction("dateDiff", "Returns the time until another time in seconds, minutes, hours or days (default)", "integer",
+			arg("other", "string"), optional("unit", "string")),
+		dateFunction("toUnix", "Converts a time to a Unix timestamp in seconds", "integer"),
+		dateFunction("toUnixMs", "Converts a time to a Unix timestamp in milliseconds", "integer"),
+		dateFunction("fromUnix", "Converts a Unix timestamp in seconds, or milliseconds with ms, to a time", "date",
+			optional("unit", "string")),
+	}
+	return functions
+}
+
+// countryFunction returns a function converting countries to one form of code
+func countryFunction(name, form, description string) TemplateFunction {
+	return TemplateFunction{
+		Name: name, Category: "country", Input: "string", Returns: "string",
+		Description: description + "; unknown countries give the fallback",
+		Args:        []FunctionArg{{Name: "fallback", Type: "string", Optional: true}},
+		Call: func(v interface{}, args []interface{}) (interface{}, error) {
+			return countryCode(v.(string), form, stringArg(args, 0)), nil
+		},
+	}
+}
+
+// dateFunction returns one of the date functions implemented by applyDateFunction
+func dateFunction(name, description, returns string, args ...FunctionArg) TemplateFunction {
+	return TemplateFunction{
+		Name: name, Category: "date", Input: "any", Returns: returns,
+		Description: description,
+		Args:        args,
+		Call: func(v interface{}, args []interface{}) (interface{}, error) {
+			return applyDateFunction(name, v, stringArgs(args))
+		},
+	}
+}
@@ -0,0 +1,625 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Transform nodes reshape the items of a previous node's output. They read the
+// array named by sourceArray, either a path such as "get_orders.results" or an
+// expression such as "{{$node['get_orders'].json.value}}", and store the new
+// items in output (with count). Templates in their parameters can refer to the
+// item being processed as {{$item.field}}.
+//
+//   - set: fields sets values by path, remove drops fields, keepOnly keeps only the set fields
+//   - rename: mapping renames fields, {"old.path": "new.path"}
+//   - filter: keeps the items matching conditions (combine: and or or)
+//   - sort: sortBy is "a,-b" or [{"field": "a", "order": "desc"}]
+//   - limit: keeps limit items after offset, from the start or the end (from)
+//   - removeDuplicates: keeps the first item of each combination of keys (all fields by default)
+//   - splitOut: turns the array in field of each item into items of its own
+//   - aggregate: collects fields into arrays, per groupBy value when set
+var transformNodeTypes = map[string]func(we *WorkflowEngine, params map[string]interface{}, items []interface{}) ([]interface{}, error){
+	"set":              (*WorkflowEngine).transformSet,
+	"rename":           (*WorkflowEngine).transformRename,
+	"filter":           (*WorkflowEngine).transformFilter,
+	"sort":             (*WorkflowEngine).transformSort,
+	"limit":            (*WorkflowEngine).transformLimit,
+	"removeDuplicates": (*WorkflowEngine).transformRemoveDuplicates,
+	"splitOut":         (*WorkflowEngine).transformSplitOut,
+	"aggregate":        (*WorkflowEngine).transformAggregate,
+}
+
+// transformItemParams are the parameters that are resolved for each item
+// ($item) instead of once per node
+var transformItemParams = map[string]map[string]bool{
+	"set":    {"fields": true},
+	"filter": {"conditions": true},
+}
+
+// executeTransform runs a transform node.
+func (we *WorkflowEngine) executeTransform(node *Node) error {
+	transform := transformNodeTypes[node.Type]
+
+	items, _, err := we.transformInput(node.Parameters["sourceArray"])
+	if err != nil {
+		return err
+	}
+
+	// Settings such as limit or sortBy may be templates too; they keep the
+	// type of their value
+	params := make(map[string]interface{}, len(node.Parameters))
+	for key, value := range node.Parameters {
+		if key == "sourceArray" || transformItemParams[node.Type][key] {
+			params[key] = value
+		} else {
+			params[key] = we.resolveTemplateDeep(value)
+		}
+	}
+
+	output, err := transform(we, params, items)
+	we.context.Item = nil
+	if err != nil {
+		return fmt.Errorf("%s: %w", node.Type, err)
+	}
+
+	we.context.NodeResults[node.ID] = map[string]interface{}{
+		"output": output,
+		"count":  len(output),
+	}
+	return nil
+}
+
+// transformInput returns the items a transform node works on, and whether the
+// source was a single object rather than an array.
+func (we *WorkflowEngine) transformInput(source interface{}) ([]interface{}, bool, error) {
+	path, ok := source.(string)
+	if !ok || path == "" {
+		return nil, false, fmt.Errorf("sourceArray is required")
+	}
+
+	var value interface{}
+	if strings.Contains(path, "{{") {
+		value = we.resolveTemplateRaw(path)
+	} else {
+		// A bare node ID stands for the whole result of that node
+		nodeID, field, _ := strings.Cut(path, ".")
+		if result, ok := we.context.NodeResults[nodeID]; ok && field == "" {
+			value = map[string]interface{}(result)
+		} else if ok {
+			value = lookupPath(map[string]interface{}(result), field)
+		}
+	}
+
+	switch v := value.(type) {
+	case []interface{}:
+		return v, false, nil
+	case []map[string]interface{}:
+		items := make([]interface{}, len(v))
+		for i, item := range v {
+			items[i] = item
+		}
+		return items, false, nil
+	case map[string]interface{}:
+		// A single object, e.g. the flattened first row of a response
+		return []interface{}{v}, true, nil
+	case nil:
+		return []interface{}{}, false, nil
+	default:
+		return nil, false, fmt.Errorf("sourceArray %s is not an array", path)
+	}
+}
+
+// resolveForItem resolves a parameter value with $item set to item.
+func (we *WorkflowEngine) resolveForItem(value interface{}, item interface{}) interface{} {
+	we.context.Item = item
+	return we.resolveTemplateDeep(value)
+}
+
+// transformSet sets, removes and keeps fields of each item.
+func (we *WorkflowEngine) transformSet(params map[string]interface{}, items []interface{}) ([]interface{}, error) {
+	fields, _ := params["fields"].(map[string]interface{})
+	remove := stringList(params["remove"])
+	keepOnly, _ := toBoolean(params["keepOnly"])
+
+	// Set fields in a stable order so nested paths apply predictably
+	paths := make([]string, 0, len(fields))
+	for path := range fields {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	output := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		source, _ := item.(map[string]interface{})
+		var result map[string]interface{}
+		if keepOnly || source == nil {
+			result = make(map[string]interface{})
+		} else {
+			result = copyItem(source)
+		}
+
+		for _, path := range paths {
+			setPath(result, path, we.resolveForItem(fields[path], item))
+		}
+		for _, path := range remove {
+			deletePath(result, path)
+		}
+		output = append(output, result)
+	}
+	return output, nil
+}
+
+// transformRename moves fields to new paths.
+func (we *WorkflowEngine) transformRename(params map[string]interface{}, items []interface{}) ([]interface{}, error) {
+	mapping, ok := params["mapping"].(map[string]interface{})
+	if !ok || len(mapping) == 0 {
+		return nil, fmt.Errorf("'mapping' is required")
+	}
+
+	output := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		source, ok := item.(map[string]interface{})
+		if !ok {
+			output = append(output, item)
+			continue
+		}
+		result := copyItem(source)
+		for from, to := range mapping {
+			target, _ := to.(string)
+			value := lookupPath(result, from)
+			if target == "" || value == nil && !hasPath(result, from) {
+				continue
+			}
+			deletePath(result, from)
+			setPath(result, target, value)
+		}
+		output = append(output, result)
+	}
+	return output, nil
+}
+
+// transformFilter keeps the items that match the conditions. Each condition
+// compares field (a path in the item) or value1 (a template) with value2.
+func (we *WorkflowEngine) transformFilter(params map[string]interface{}, items []interface{}) ([]interface{}, error) {
+	conditions, ok := params["conditions"].([]interface{})
+	if !ok || len(conditions) == 0 {
+		return nil, fmt.Errorf("'conditions' is required")
+	}
+	combine, _ := params["combine"].(string)
+	if combine == "" {
+		combine = "and"
+	}
+	if combine != "and" && combine != "or" {
+		return nil, fmt.Errorf("invalid combine %q: expected and or or", combine)
+	}
+
+	output := []interface{}{}
+	for _, item := range items {
+		matched := combine == "and"
+		for i, raw := range conditions {
+			condition, ok := raw.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("condition %d is not an object", i+1)
+			}
+			result, err := we.evaluateItemCondition(condition, item)
+			if err != nil {
+				return nil, fmt.Errorf("condition %d: %w", i+1, err)
+			}
+			if combine == "and" && !result {
+				matched = false
+				break
+			}
+			if combine == "or" && result {
+				matched = true
+				break
+			}
+		}
+		if matched {
+			output = append(output, item)
+		}
+	}
+	return output, nil
+}
+
+// evaluateItemCondition evaluates one filter condition against an item.
+func (we *WorkflowEngine) evaluateItemCondition(condition map[string]interface{}, item interface{}) (bool, error) {
+	var value1 interface{}
+	if field, ok := condition["field"].(string); ok {
+		value1 = lookupPath(item, field)
+	} else {
+		value1 = we.resolveForItem(condition["value1"], item)
+	}
+	value2 := we.resolveForItem(condition["value2"], item)
+	operation, _ := condition["operation"].(string)
+	return compareValues(value1, operation, value2)
+}
+
+// compareValues applies a comparison operation. Values that both parse as
+// numbers are compared as numbers, everything else as strings.
+func compareValues(value1 interface{}, operation string, value2 interface{}) (bool, error) {
+	str1, str2 := valueString(value1), valueString(value2)
+
+	switch operation {
+	case "isEmpty":
+		return isEmptyValue(value1), nil
+	case "isNotEmpty":
+		return !isEmptyValue(value1), nil
+	case "contains":
+		return strings.Contains(str1, str2), nil
+	case "notContains":
+		return !strings.Contains(str1, str2), nil
+	case "startsWith":
+		return strings.HasPrefix(str1, str2), nil
+	case "endsWith":
+		return strings.HasSuffix(str1, str2), nil
+	case "regex":
+		re, err := regexp.Compile(str2)
+		if err != nil {
+			return false, fmt.Errorf("invalid regex: %w", err)
+		}
+		return re.MatchString(str1), nil
+	case "before", "after", "sameDay":
+		// Values that are not dates never match
+		t1, err1 := parseDateValue(value1, nil)
+		t2, err2 := parseDateValue(value2, nil)
+		if err1 != nil || err2 != nil {
+			return false, nil
+		}
+		switch operation {
+		case "before":
+			return t1.Before(t2), nil
+		case "after":
+			return t1.After(t2), nil
+		}
+		return t1.Format("2006-01-02") == t2.In(t1.Location()).Format("2006-01-02"), nil
+	case "in", "notIn":
+		found := false
+		for _, candidate := range valueList(value2) {
+			if compareOrder(value1, candidate) == 0 {
+				found = true
+				break
+			}
+		}
+		return found == (operation == "in"), nil
+	}
+
+	order := compareOrder(value1, value2)
+	switch operation {
+	case "equals":
+		return order == 0, nil
+	case "notEquals":
+		return order != 0, nil
+	case "greater":
+		return order > 0, nil
+	case "greaterOrEqual":
+		return order >= 0, nil
+	case "less":
+		return order < 0, nil
+	case "lessOrEqual":
+		return order <= 0, nil
+	default:
+		return false, fmt.Errorf("unsupported operation: %s", operation)
+	}
+}
+
+// compareOrder orders two values: numerically when both are numbers, else as strings.
+func compareOrder(a, b interface{}) int {
+	if numA, ok := numberValue(a); ok {
+		if numB, ok := numberValue(b); ok {
+			switch {
+			case numA < numB:
+				return -1
+			case numA > numB:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(valueString(a), valueString(b))
+}
+
+// numberValue returns a value as a number when it is one or parses as one.
+func numberValue(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case string:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return n, err == nil
+	}
+	return 0, false
+}
+
+// valueString formats a value for comparisons and keys; objects and arrays as
+// JSON and times as RFC 3339.
+func valueString(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		encoded, _ := json.Marshal(v)
+		return string(encoded)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// valueList returns an array value, or splits a comma separated string.
+func valueList(value interface{}) []interface{} {
+	if list, ok := value.([]interface{}); ok {
+		return list
+	}
+	var list []interface{}
+	for _, item := range stringList(valueString(value)) {
+		list = append(list, item)
+	}
+	return list
+}
+
+// isEmptyValue reports whether a value is nil, an empty string, array or object.
+func isEmptyValue(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(v) == ""
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// transformSort sorts items by one or more fields. Missing values sort last.
+func (we *WorkflowEngine) transformSort(params map[string]interface{}, items []interface{}) ([]interface{}, error) {
+	type sortKey struct {
+		field string
+		desc  bool
+	}
+	var keys []sortKey
+	switch v := params["sortBy"].(type) {
+	case string:
+		for _, field := range stringList(v) {
+			name, desc := strings.CutPrefix(field, "-")
+			keys = append(keys, sortKey{strings.TrimPrefix(name, "+"), desc})
+		}
+	case []interface{}:
+		for _, raw := range v {
+			key, ok := raw.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("sortBy items must be objects")
+			}
+			field, _ := key["field"].(string)
+			order, _ := key["order"].(string)
+			keys = append(keys, sortKey{field, strings.EqualFold(order, "desc")})
+		}
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("'sortBy' is required")
+	}
+
+	output := append([]interface{}{}, items...)
+	sort.SliceStable(output, func(i, j int) bool {
+		for _, key := range keys {
+			a, b := lookupPath(output[i], key.field), lookupPath(output[j], key.field)
+			if a == nil || b == nil {
+				if (a == nil) != (b == nil) {
+					return b == nil
+				}
+				continue
+			}
+			if order := compareOrder(a, b); order != 0 {
+				return (order < 0) != key.desc
+			}
+		}
+		return false
+	})
+	return output, nil
+}
+
+// transformLimit keeps at most limit items.
+func (we *WorkflowEngine) transformLimit(params map[string]interface{}, items []interface{}) ([]interface{}, error) {
+	limit, ok := numberValue(params["limit"])
+	if !ok || limit < 0 {
+		return nil, fmt.Errorf("'limit' must be a number")
+	}
+	offset, _ := numberValue(params["offset"])
+	from, _ := params["from"].(string)
+
+	start := min(max(int(offset), 0), len(items))
+	end := min(start+int(limit), len(items))
+	if from == "end" {
+		end = len(items) - start
+		start = max(end-int(limit), 0)
+	}
+	return append([]interface{}{}, items[start:end]...), nil
+}
+
+// transformRemoveDuplicates keeps the first item of each distinct key.
+func (we *WorkflowEngine) transformRemoveDuplicates(params map[string]interface{}, items []interface{}) ([]interface{}, error) {
+	fields := stringList(params["keys"])
+
+	seen := make(map[string]bool)
+	output := []interface{}{}
+	for _, item := range items {
+		var key string
+		if len(fields) == 0 {
+			key = valueString(item)
+		} else {
+			parts := make([]interface{}, len(fields))
+			for i, field := range fields {
+				parts[i] = lookupPath(item, field)
+			}
+			key = valueString(parts)
+		}
+		if !seen[key] {
+			seen[key] = true
+			output = append(output, item)
+		}
+	}
+	return output, nil
+}
+
+// transformSplitOut turns the elements of a nested array into items. With
+// include set to allOtherFields, each new item also gets the other fields of
+// its parent; elements that are not objects are stored under the field name.
+func (we *WorkflowEngine) transformSplitOut(params map[string]interface{}, items []interface{}) ([]interface{}, error) {
+	field, _ := params["field"].(string)
+	if field == "" {
+		return nil, fmt.Errorf("'field' is required")
+	}
+	include, _ := params["include"].(string)
+	name := field[strings.LastIndex(field, ".")+1:]
+
+	output := []interface{}{}
+	for _, item := range items {
+		var elements []interface{}
+		switch v := lookupPath(item, field).(type) {
+		case []interface{}:
+			elements = v
+		case nil:
+			continue
+		default:
+			elements = []interface{}{v}
+		}
+
+		for _, element := range elements {
+			obj, ok := element.(map[string]interface{})
+			if ok {
+				obj = copyItem(obj)
+			} else {
+				obj = map[string]interface{}{name: element}
+			}
+			if parent, ok := item.(map[string]interface{}); ok && include == "allOtherFields" {
+				for key, value := range parent {
+					if _, exists := obj[key]; !exists && key != field {
+						obj[key] = value
+					}
+				}
+			}
+			output = append(output, obj)
+		}
+	}
+	return output, nil
+}
+
+// transformAggregate collects the values of fields into arrays named after the
+// last part of each path. Without fields the whole items are collected in
+// items. With groupBy there is one output item per group value.
+func (we *WorkflowEngine) transformAggregate(params map[string]interface{}, items []interface{}) ([]interface{}, error) {
+	fields := stringList(params["fields"])
+	groupBy, _ := params["groupBy"].(string)
+
+	var order []string
+	groups := make(map[string]map[string]interface{})
+	for _, item := range items {
+		key := ""
+		if groupBy != "" {
+			key = valueString(lookupPath(item, groupBy))
+		}
+		group, ok := groups[key]
+		if !ok {
+			group = map[string]interface{}{"count": 0}
+			if groupBy != "" {
+				setPath(group, groupBy, lookupPath(item, groupBy))
+			}
+			groups[key] = group
+			order = append(order, key)
+		}
+		group["count"] = group["count"].(int) + 1
+
+		if len(fields) == 0 {
+			list, _ := group["items"].([]interface{})
+			group["items"] = append(list, item)
+			continue
+		}
+		for _, field := range fields {
+			name := field[strings.LastIndex(field, ".")+1:]
+			list, _ := group[name].([]interface{})
+			if value := lookupPath(item, field); value != nil {
+				list = append(list, value)
+			}
+			if list == nil {
+				list = []interface{}{}
+			}
+			group[name] = list
+		}
+	}
+
+	output := make([]interface{}, 0, len(order))
+	for _, key := range order {
+		output = append(output, groups[key])
+	}
+	if len(output) == 0 && groupBy == "" {
+		output = append(output, map[string]interface{}{"count": 0})
+	}
+	return output, nil
+}
+
+// copyItem returns a shallow copy of an item with its nested objects copied,
+// so that transforms never change the output of an earlier node.
+func copyItem(item map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(item))
+	for key, value := range item {
+		if nested, ok := value.(map[string]interface{}); ok {
+			value = copyItem(nested)
+		}
+		result[key] = value
+	}
+	return result
+}
+
+// setPath sets a value at a dot separated path, creating objects on the way.
+func setPath(obj map[string]interface{}, path string, value interface{}) {
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := obj[part].(map[string]interface{})
+		if !ok {
+			next = make(map[string]interface{})
+			obj[part] = next
+		}
+		obj = next
+	}
+	obj[parts[len(parts)-1]] = value
+}
+
+// deletePath removes the value at a dot separated path.
+func deletePath(obj map[string]interface{}, path string) {
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := obj[part].(map[string]interface{})
+		if !ok {
+			return
+		}
+		obj = next
+	}
+	delete(obj, parts[len(parts)-1])
+}
+
+// hasPath reports whether a dot separated path exists, even with a null value.
+func hasPath(obj map[string]interface{}, path string) bool {
+	parts := strings.Split(path, ".")
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := obj[part].(map[string]interface{})
+		if !ok {
+			return false
+		}
+		obj = next
+	}
+	_, ok := obj[parts[len(parts)-1]]
+	return ok
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// templateRegex matches one {{expression}} inside a string
+var templateRegex = regexp.MustCompile(`\{\{(.*?)\}\}`)
+
+// urlEscapingEnabled reports whether a node's URL templates are escaped
+// automatically, set with "urlEscaping": "auto" on the node or in the workflow config.
+func (we *WorkflowEngine) urlEscapingEnabled(params map[string]interface{}) bool {
+	mode, ok := params["urlEscaping"].(string)
+	if !ok {
+		mode, _ = we.context.Config["urlEscaping"].(string)
+	}
+	return mode == "auto"
+}
+
+// resolveURLTemplate resolves the templates of a URL, escaping each value for
+// the part of the URL it appears in:
+//
+//   - at the very start or inside the scheme and host: inserted as is, so a
+//     base URL such as {{$credentials.x.instanceUrl}} keeps working
+//   - in the path: encoded as a path segment, so "/" and "?" cannot change the path
+//   - in the query: encoded as a query value, so "&" and "=" cannot add parameters
+//   - between single quotes (an OData key or literal such as
+//     BusinessPartners('{{code}}') or $filter=Name eq '{{name}}'): quotes are
+//     doubled before encoding
+//
+// Values piped through urlEncode or raw are inserted as is; odataString
+// values are only encoded.
+func (we *WorkflowEngine) resolveURLTemplate(template string) string {
+	var out strings.Builder
+	var literal strings.Builder // The template text without the inserted values
+	last := 0
+
+	for _, match := range templateRegex.FindAllStringSubmatchIndex(template, -1) {
+		literal.WriteString(template[last:match[0]])
+		out.WriteString(template[last:match[0]])
+		last = match[1]
+
+		expr := template[match[2]:match[3]]
+		value := fmt.Sprintf("%v", we.resolveExpression(expr))
+
+		funcs := pipelineFunctions(expr)
+		if funcs["raw"] || funcs["urlEncode"] {
+			out.WriteString(value)
+			continue
+		}
+
+		out.WriteString(escapeURLValue(literal.String(), value, funcs["odataString"]))
+	}
+
+	out.WriteString(template[last:])
+	return out.String()
+}
+
+// resolveQueryTemplate resolves the templates of a query string such as
+// "CardCode='{{code}}'&$top=5", escaping each value as a query value the way
+// resolveURLTemplate does.
+func (we *WorkflowEngine) resolveQueryTemplate(template string) string {
+	return strings.TrimPrefix(we.resolveURLTemplate("?"+strings.TrimPrefix(template, "?")), "?")
+}
+
+// pipelineFunctions returns the names of the functions an expression is piped through.
+func pipelineFunctions(expr string) map[string]bool {
+	funcs := make(map[string]bool)
+	for _, call := range splitTopLevel(expr, '|')[1:] {
+		name, _, _ := strings.Cut(strings.TrimSpace(call), ":")
+		funcs[strings.TrimSpace(name)] = true
+	}
+	return funcs
+}
+
+// escapeURLValue escapes a value inserted after the literal URL text before it.
+func escapeURLValue(before, value string, quotesEscaped bool) string {
+	// Before the path starts the value is part of the base URL
+	if before == "" {
+		return value
+	}
+	if scheme := strings.Index(before, "://"); scheme >= 0 && !strings.Contains(before[scheme+3:], "/") {
+		return value
+	}
+
+	query := strings.IndexAny(before, "?#")
+	var segment string
+	if query >= 0 {
+		segment = before[strings.LastIndexAny(before, "?&#")+1:]
+	} else {
+		segment = before[strings.LastIndex(before, "/")+1:]
+	}
+
+	if strings.Count(segment, "'")%2 == 1 && !quotesEscaped {
+		value = odataString(value)
+	}
+	if query >= 0 {
+		return urlEncode(value, "")
+	}
+	return urlEncode(value, "path")
+}
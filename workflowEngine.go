@@ -2,8 +2,8 @@ package main
 
 import (
 	"bytes"
-	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -14,6 +14,13 @@ import (
 	"time"
 )
 
+// ErrUnknownFunction is returned for template functions that do not exist
+var ErrUnknownFunction = errors.New("unknown function")
+
+// ErrNotRetryable marks node errors that a retry could make worse, e.g. after
+// some records were already created
+var ErrNotRetryable = errors.New("not retried because it may repeat writes")
+
 func NewWorkflowEngine(workflowJSON string) (*WorkflowEngine, error) {
 	var workflow Workflow
 	if err := json.Unmarshal([]byte(workflowJSON), &workflow); err != nil {
@@ -23,8 +30,10 @@ func NewWorkflowEngine(workflowJSON string) (*WorkflowEngine, error) {
 	return &WorkflowEngine{
 		workflow: &workflow,
 		context: &ExecutionContext{
-			NodeResults: make(map[string]map[string]interface{}),
-			Config:      workflow.Config,
+			NodeResults:     make(map[string]map[string]interface{}),
+			Config:          workflow.Config,
+			Credentials:     make(map[string]map[string]interface{}),
+			CredentialTypes: make(map[string]string),
 		},
 	}, nil
 }
@@ -112,18 +121,46 @@ func (we *WorkflowEngine) executeNode(node *Node) error {
 		delay = time.Duration(node.Retry.Delay) * time.Millisecond
 	}
 
+	// Decrypt referenced credentials up front so a missing one fails the node
+	// instead of silently sending an empty value
+	if err := we.loadNodeCredentials(node); err != nil {
+		return fmt.Errorf("node %s: %w", node.Name, err)
+	}
+
+	we.context.RateLimitWait = 0
+	defer func() {
+		// Show how long the node was held back by rate limits
+		if result, ok := we.context.NodeResults[node.ID]; ok && we.context.RateLimitWait > 0 {
+			result["rateLimitWaitMs"] = we.context.RateLimitWait.Milliseconds()
+		}
+	}()
+
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
 		log.Printf("Executing %s (attempt %d/%d)", node.Name, attempt, maxAttempts)
 
 		switch node.Type {
 		case "httpRequest":
 			err = we.executeHTTPRequest(node)
+		case "sapB1":
+			err = we.executeSAPB1(node)
+		case "salesforce":
+			err = we.executeSalesforce(node)
 		case "trigger":
 			err = we.executeTriggerNode(node)
 		case "sqlQuery":
 			err = we.executeSQLQuery(node)
+		case "mongodb":
+			err = we.executeMongoDB(node)
 		case "if":
 			err = we.executeIfCondition(node)
+		case "arrayMap":
+			err = we.executeArrayMap(node)
+		case "set", "rename", "filter", "sort", "limit", "removeDuplicates", "splitOut", "aggregate":
+			err = we.executeTransform(node)
+		case "map":
+			err = we.executeMap(node)
+		case "lookup":
+			err = we.executeLookup(node)
 		default:
 			err = fmt.Errorf("unsupported node type: %s", node.Type)
 		}
@@ -133,9 +170,14 @@ func (we *WorkflowEngine) executeNode(node *Node) error {
 			return nil
 		}
 
+		// Retrying against a system known to be down only adds load
+		if errors.Is(err, ErrCircuitOpen) || errors.Is(err, ErrNotRetryable) {
+			return fmt.Errorf("node %s failed: %w", node.Name, err)
+		}
+
 		if attempt < maxAttempts {
-			log.Printf("Node %s failed (attempt %d/%d): %v. Retrying in %v...",
-				node.Name, attempt, maxAttempts, err, delay)
+			log.Printf("Node %s failed (attempt %d/%d): %s. Retrying in %v...",
+				node.Name, attempt, maxAttempts, we.redactError(err), delay)
 			time.Sleep(delay)
 		}
 	}
@@ -143,84 +185,50 @@ func (we *WorkflowEngine) executeNode(node *Node) error {
 	return fmt.Errorf("node %s failed after %d attempts: %w", node.Name, maxAttempts, err)
 }
 
-func (we *WorkflowEngine) applyTemplateFunctions(value interface{}, funcCalls []string) (interface{}, error) {
-	var currentValue = value
+func (we *WorkflowEngine) loadNodeCredentials(node *Node) error {
+	refs := make(map[string]bool)
+	findNodeCredentials(node.Parameters, refs)
 
-	for _, call := range funcCalls {
-		// Trim spaces from the entire call string first
-		call = strings.TrimSpace(call)
-		// Use SplitN to handle arguments containing colons
-		parts := strings.SplitN(call, ":", 2)
-
-		// Trim spaces from function name
-		funcName := strings.TrimSpace(parts[0])
-		var args []string
-
-		if len(parts) > 1 {
-			// Split arguments and trim each one
-			for _, arg := range strings.Split(parts[1], ",") {
-				args = append(args, strings.TrimSpace(arg))
-			}
+	for name := range refs {
+		if _, ok := we.context.Credentials[name]; ok {
+			continue
+		}
+		credentialType, data, err := GetCredentialData(name)
+		if err != nil {
+			return fmt.Errorf("failed to load credential %s: %w", name, err)
 		}
 
-		fmt.Println("[DEBUG] Function call parts:", parts)
-		fmt.Println("[DEBUG] Applying function:", funcName, "with args:", args)
-
-		switch funcName {
-		case "countryToAlpha3":
-			fmt.Println("[DEBUG] currentVal", "->", currentValue, "function:", funcName)
-			if strVal, ok := currentValue.(string); ok {
-				currentValue = countryToAlpha3(strVal)
-			}
-		case "truncate":
-			fmt.Println("[DEBUG] currentVal", "->", currentValue, "function:", funcName)
-			if len(args) != 1 {
-				return nil, fmt.Errorf("truncate requires maxLen argument")
-			}
-			maxLen, err := strconv.Atoi(args[0])
-			if err != nil {
-				return nil, fmt.Errorf("invalid maxLen for truncate: %w", err)
-			}
-			if strVal, ok := currentValue.(string); ok {
-				currentValue = truncate(strVal, maxLen)
-				fmt.Println("[DEBUG] strVal", strVal, "->", currentValue)
-			}
-		case "join":
-			if len(args) != 1 {
-				return nil, fmt.Errorf("join requires one argument")
-			}
-			argVal := we.resolveTemplateValue(args[0])
-			if str1, ok := currentValue.(string); ok {
-				if str2, ok := argVal.(string); ok {
-					currentValue = join(str1, str2)
-				}
-			}
-		case "toNumber":
-			num, err := toNumber(currentValue)
+		// OAuth2 credentials expose their current token to templates
+		if isOAuth2CredentialType(credentialType) {
+			token, err := getOAuth2Token(name, credentialType, data, false)
 			if err != nil {
-				return nil, fmt.Errorf("toNumber conversion failed: %w", err)
+				return err
 			}
-			currentValue = num
+			data["accessToken"] = token.AccessToken
+			data["instanceUrl"] = token.InstanceURL
+		}
 
-		case "toBoolean":
-			boolVal, err := toBoolean(currentValue)
-			if err != nil {
-				return nil, fmt.Errorf("toBoolean conversion failed: %w", err)
-			}
-			currentValue = boolVal
+		we.context.Credentials[name] = data
+		we.context.CredentialTypes[name] = credentialType
+	}
+	return checkCredentialFields(node.Parameters, we.context.Credentials)
+}
 
-		case "defaultIfEmpty":
-			if len(args) != 1 {
-				return nil, fmt.Errorf("defaultIfEmpty requires default value argument")
-			}
-			if currentValue == nil || currentValue == "" {
-				currentValue = args[0]
-			}
-		default:
-			return nil, fmt.Errorf("unknown function: %s", funcName)
+func (we *WorkflowEngine) applyTemplateFunctions(value interface{}, funcCalls []string) (interface{}, error) {
+	var currentValue = value
+
+	for _, call := range funcCalls {
+		result, err := we.callTemplateFunction(currentValue, call)
+		if err != nil {
+			return nil, err
 		}
+		currentValue = result
 	}
 
+	// Times computed by date functions leave the pipeline as RFC 3339 strings
+	if t, ok := currentValue.(time.Time); ok {
+		currentValue = t.Format(time.RFC3339Nano)
+	}
 	return currentValue, nil
 }
 
@@ -228,42 +236,140 @@ func (we *WorkflowEngine) executeHTTPRequest(node *Node) error {
 	resolvedParams := we.resolveTemplateValue(node.Parameters).(map[string]interface{})
 	inputUrl := resolvedParams["url"].(string)
 	method := resolvedParams["method"].(string)
+	if rawURL, ok := node.Parameters["url"].(string); ok && we.urlEscapingEnabled(node.Parameters) {
+		inputUrl = we.resolveURLTemplate(rawURL)
+	}
+	responseFormat, _ := resolvedParams["responseFormat"].(string)
+
+	// Structured query parameters are encoded and appended to the URL
+	if queryParams, ok := resolvedParams["query"].(map[string]interface{}); ok {
+		inputUrl = appendQueryParams(inputUrl, queryParams)
+	}
+
+	headers := make(map[string]interface{})
+	if headerParams, ok := resolvedParams["headers"].(map[string]interface{}); ok {
+		for key, value := range headerParams {
+			headers[key] = value
+		}
+	}
+	credentialName, _ := resolvedParams["credential"].(string)
 
-	var body io.Reader
+	var body []byte
 	if bodyData, ok := resolvedParams["body"]; ok {
-		bodyJSON, err := json.Marshal(bodyData)
+		bodyType, _ := resolvedParams["bodyType"].(string)
+		contentType, _ := resolvedParams["contentType"].(string)
+		encoded, encodedType, err := buildHTTPBody(bodyType, bodyData, contentType)
 		if err != nil {
-			return fmt.Errorf("failed to marshal request body: %w", err)
+			return err
+		}
+		body = encoded
+
+		// A multipart body needs the boundary of its own Content-Type
+		if _, ok := headerValue(headers, "Content-Type"); !ok || bodyType == "multipart" {
+			for key := range headers {
+				if strings.EqualFold(key, "Content-Type") {
+					delete(headers, key)
+				}
+			}
+			headers["Content-Type"] = encodedType
 		}
-		body = bytes.NewBuffer(bodyJSON)
 	}
 
-	req, err := http.NewRequest(method, inputUrl, body)
+	// OData query options are appended to the URL
+	odataOpts, err := parseODataOptions(resolvedParams)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return err
+	}
+	if odataOpts != nil {
+		inputUrl = appendQuery(inputUrl, odataOpts.Query)
+		if prefer := odataOpts.preferHeader(); prefer != "" {
+			headers["Prefer"] = prefer
+		}
 	}
 
-	if headers, ok := resolvedParams["headers"].(map[string]interface{}); ok {
-		for key, value := range headers {
-			req.Header.Set(key, fmt.Sprintf("%v", value))
+	// Generic pagination sets the parameters of the first page
+	pagination, err := parsePagination(resolvedParams)
+	if err != nil {
+		return err
+	}
+	if pagination != nil {
+		if odataOpts != nil {
+			return fmt.Errorf("odata and pagination cannot be combined")
+		}
+		if inputUrl, err = pagination.firstURL(inputUrl); err != nil {
+			return err
 		}
 	}
 
-	client := &http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
+	// TLS and proxy settings come from the credential and the node
+	client, err := we.httpClient(resolvedParams, credentialName)
 	if err != nil {
-		return fmt.Errorf("HTTP request failed: %w", err)
+		return err
 	}
-	defer resp.Body.Close()
 
-	respBody, err := io.ReadAll(resp.Body)
+	resp, respBody, err := we.sendHTTPRequest(client, method, inputUrl, headers, body, credentialName)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return err
+	}
+
+	statusCode, respHeader := resp.StatusCode, resp.Header
+	var paged *pagedResult
+	if odataOpts != nil && odataOpts.FollowNextLink {
+		// Follow nextLinks and collect the rows of every page
+		result, err := followODataNextLinks(httpPage{resp.StatusCode, resp.Header, respBody}, odataOpts.MaxPages,
+			func(nextLink string) (httpPage, error) {
+				nextURL, err := resolveODataLink(nextLink, odataOpts.ServiceRoot, inputUrl)
+				if err != nil {
+					return httpPage{}, err
+				}
+				nextResp, nextBody, err := we.sendHTTPRequest(client, http.MethodGet, nextURL, headers, nil, credentialName)
+				if err != nil {
+					return httpPage{}, err
+				}
+				return httpPage{nextResp.StatusCode, nextResp.Header, nextBody}, nil
+			})
+		if err != nil {
+			return err
+		}
+		statusCode, respHeader, respBody = result.StatusCode, result.Header, result.Body
+		paged = &result
+	} else if pagination != nil {
+		result, err := pagination.follow(httpPage{resp.StatusCode, resp.Header, respBody}, method, inputUrl, body,
+			func(pageMethod, pageURL string, pageBody []byte) (httpPage, error) {
+				pageResp, pageRespBody, err := we.sendHTTPRequest(client, pageMethod, pageURL, headers, pageBody, credentialName)
+				if err != nil {
+					return httpPage{}, err
+				}
+				return httpPage{pageResp.StatusCode, pageResp.Header, pageRespBody}, nil
+			})
+		if err != nil {
+			return err
+		}
+		statusCode, respHeader, respBody = result.StatusCode, result.Header, result.Body
+		paged = &result
+	}
+
+	nodeResult, err := buildHTTPResponseResult(responseFormat, statusCode, respHeader, respBody)
+	if nodeResult == nil {
+		return err
+	}
+	if paged != nil {
+		nodeResult["pageCount"] = paged.Pages
+		nodeResult["hasMore"] = paged.HasMore
+	}
+	we.context.NodeResults[node.ID] = nodeResult
+
+	if statusCode >= 400 {
+		return fmt.Errorf("HTTP request failed with status %d: %s", statusCode, string(respBody))
 	}
 
+	return err
+}
+
+func buildHTTPResult(statusCode int, headers http.Header, respBody []byte) map[string]interface{} {
 	result := map[string]interface{}{
-		"httpStatusCode": resp.StatusCode,
-		"headers":        resp.Header,
+		"httpStatusCode": statusCode,
+		"headers":        headers,
 		"body":           string(respBody),
 	}
 
@@ -290,12 +396,106 @@ func (we *WorkflowEngine) executeHTTPRequest(node *Node) error {
 		}
 	}
 
-	we.context.NodeResults[node.ID] = result
+	return result
+}
 
-	if resp.StatusCode >= 400 {
-		return fmt.Errorf("HTTP request failed with status %d: %s", resp.StatusCode, string(respBody))
+func (we *WorkflowEngine) sendHTTPRequest(client *http.Client, method, inputUrl string, headers map[string]interface{}, body []byte, credentialName string) (*http.Response, []byte, error) {
+	for attempt := 1; ; attempt++ {
+		req, err := http.NewRequest(method, inputUrl, bytes.NewReader(body))
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create request: %w", err)
+		}
+
+		for key, value := range headers {
+			req.Header.Set(key, fmt.Sprintf("%v", value))
+		}
+
+		// Credentials are applied last so they win over templated headers
+		if credentialName != "" {
+			if err := we.applyCredentialAuth(req, credentialName, attempt > 1); err != nil {
+				return nil, nil, err
+			}
+		}
+
+		release, err := we.throttle(inputUrl, credentialName)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var resp *http.Response
+		err = we.guardCall(inputUrl, credentialName, func() (int, error) {
+			var err error
+			if resp, err = client.Do(req); err != nil {
+				return 0, err
+			}
+			return resp.StatusCode, nil
+		})
+		if err != nil {
+			release()
+			return nil, nil, fmt.Errorf("HTTP request failed: %w", err)
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		release()
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read response: %w", err)
+		}
+
+		// An expired token gets one transparent retry with a fresh token
+		if resp.StatusCode == http.StatusUnauthorized && attempt == 1 &&
+			isOAuth2CredentialType(we.context.CredentialTypes[credentialName]) {
+			log.Printf("Request to %s returned 401, refreshing token of credential %s", req.URL.Host, credentialName)
+			continue
+		}
+
+		return resp, respBody, nil
+	}
+}
+
+func (we *WorkflowEngine) applyCredentialAuth(req *http.Request, credentialName string, forceRefresh bool) error {
+	data, ok := we.context.Credentials[credentialName]
+	if !ok {
+		return fmt.Errorf("credential %s is not loaded", credentialName)
+	}
+	credentialType := we.context.CredentialTypes[credentialName]
+
+	str := func(key string) string {
+		value, _ := data[key].(string)
+		return value
 	}
 
+	switch {
+	case isOAuth2CredentialType(credentialType):
+		token, err := getOAuth2Token(credentialName, credentialType, data, forceRefresh)
+		if err != nil {
+			return err
+		}
+		data["accessToken"] = token.AccessToken
+		req.Header.Set("Authorization", token.TokenType+" "+token.AccessToken)
+	case credentialType == "basic":
+		req.SetBasicAuth(str("username"), str("password"))
+	case credentialType == "bearer":
+		req.Header.Set("Authorization", "Bearer "+str("token"))
+	case credentialType == "apiKey":
+		// The key goes into a query parameter when one is configured, else a header
+		if param := str("queryParam"); param != "" {
+			keyParam := escapeODataQueryValue(param) + "=" + escapeODataQueryValue(str("key"))
+			if req.URL.RawQuery == "" {
+				req.URL.RawQuery = keyParam
+			} else {
+				req.URL.RawQuery += "&" + keyParam
+			}
+		} else {
+			header := str("header")
+			if header == "" {
+				header = "X-API-Key"
+			}
+			req.Header.Set(header, str("prefix")+str("key"))
+		}
+	default:
+		return fmt.Errorf("credential %s of type %s cannot be used for HTTP authentication", credentialName, credentialType)
+	}
 	return nil
 }
 
@@ -354,72 +554,30 @@ func (we *WorkflowEngine) mapObject(item map[string]interface{}, mapping map[str
 	return result
 }
 
-func (we *WorkflowEngine) executeSQLQuery(node *Node) error {
-	resolvedParams := we.resolveTemplateValue(node.Parameters).(map[string]interface{})
-	query := resolvedParams["query"].(string)
-	connectionString := resolvedParams["connectionString"].(string)
-
-	db, err := sql.Open("sqlserver", connectionString)
-	if err != nil {
-		return fmt.Errorf("failed to connect to database: %w", err)
-	}
-	defer db.Close()
-
-	rows, err := db.Query(query)
-	if err != nil {
-		return fmt.Errorf("failed to execute query: %w", err)
-	}
-	defer rows.Close()
-
-	var results []map[string]interface{}
-	columns, err := rows.Columns()
+func (we *WorkflowEngine) executeIfCondition(node *Node) error {
+	result, err := we.evaluateCondition(node)
 	if err != nil {
-		return fmt.Errorf("failed to get columns: %w", err)
-	}
-
-	for rows.Next() {
-		values := make([]interface{}, len(columns))
-		valuePtrs := make([]interface{}, len(columns))
-		for i := range values {
-			valuePtrs[i] = &values[i]
-		}
-
-		if err := rows.Scan(valuePtrs...); err != nil {
-			return fmt.Errorf("failed to scan row: %w", err)
-		}
-
-		row := make(map[string]interface{})
-		for i, col := range columns {
-			row[strings.ToLower(col)] = values[i]
-		}
-		results = append(results, row)
+		return err
 	}
-
-	result := map[string]interface{}{
-		"results":  results,
-		"rowCount": len(results),
-	}
-
-	we.context.NodeResults[node.ID] = result
-	return nil
-}
-
-func (we *WorkflowEngine) executeIfCondition(node *Node) error {
 	we.context.NodeResults[node.ID] = map[string]interface{}{
-		"conditionResult": we.evaluateCondition(node),
+		"conditionResult": result,
 	}
 	return nil
 }
 
-func (we *WorkflowEngine) evaluateCondition(node *Node) bool {
+func (we *WorkflowEngine) evaluateCondition(node *Node) (bool, error) {
 	conditions, ok := node.Parameters["conditions"].(map[string]interface{})
 	if !ok {
-		return false
+		return false, nil
+	}
+
+	if matched, err := we.dateConditionsMatch(conditions["dateTime"]); err != nil || matched {
+		return matched, err
 	}
 
 	numberConds, ok := conditions["number"].([]interface{})
 	if !ok {
-		return false
+		return false, nil
 	}
 
 	for _, condInterface := range numberConds {
@@ -442,20 +600,20 @@ func (we *WorkflowEngine) evaluateCondition(node *Node) bool {
 		switch operation {
 		case "equals":
 			if val1 == val2 {
-				return true
+				return true, nil
 			}
 		case "greater":
 			if val1 > val2 {
-				return true
+				return true, nil
 			}
 		case "less":
 			if val1 < val2 {
-				return true
+				return true, nil
 			}
 		}
 	}
 
-	return false
+	return false, nil
 }
 
 func (we *WorkflowEngine) resolveTemplateValue(value interface{}) interface{} {
@@ -479,6 +637,37 @@ func (we *WorkflowEngine) resolveTemplateValue(value interface{}) interface{} {
 	}
 }
 
+func (we *WorkflowEngine) resolveTemplateRaw(value interface{}) interface{} {
+	// A lone {{expression}} keeps the type of its value, e.g. an array of records
+	if str, ok := value.(string); ok {
+		trimmed := strings.TrimSpace(str)
+		if strings.HasPrefix(trimmed, "{{") && strings.HasSuffix(trimmed, "}}") && strings.Count(trimmed, "{{") == 1 {
+			return we.resolveExpression(strings.TrimSpace(trimmed[2 : len(trimmed)-2]))
+		}
+	}
+	return we.resolveTemplateValue(value)
+}
+
+func (we *WorkflowEngine) resolveTemplateDeep(value interface{}) interface{} {
+	// Like resolveTemplateRaw, for every value nested in maps and arrays
+	switch v := value.(type) {
+	case map[string]interface{}:
+		resolved := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			resolved[key] = we.resolveTemplateDeep(elem)
+		}
+		return resolved
+	case []interface{}:
+		resolved := make([]interface{}, len(v))
+		for i, elem := range v {
+			resolved[i] = we.resolveTemplateDeep(elem)
+		}
+		return resolved
+	default:
+		return we.resolveTemplateRaw(v)
+	}
+}
+
 func (we *WorkflowEngine) resolveStringTemplates(template string) string {
 	re := regexp.MustCompile(`\{\{(.*?)\}\}`)
 	return re.ReplaceAllStringFunc(template, func(match string) string {
@@ -488,7 +677,7 @@ func (we *WorkflowEngine) resolveStringTemplates(template string) string {
 }
 
 func (we *WorkflowEngine) resolveExpression(expr string) interface{} {
-	parts := strings.Split(expr, "|")
+	parts := splitTopLevel(expr, '|')
 	baseExpr := strings.TrimSpace(parts[0])
 	funcCalls := parts[1:]
 
@@ -500,7 +689,7 @@ func (we *WorkflowEngine) resolveExpression(expr string) interface{} {
 	if len(funcCalls) > 0 {
 		result, err := we.applyTemplateFunctions(value, funcCalls)
 		if err != nil {
-			log.Printf("Error applying functions: %v", err)
+			log.Printf("Error applying functions: %s", we.redactError(err))
 			return ""
 		}
 		return result
@@ -515,6 +704,38 @@ func (we *WorkflowEngine) resolveBaseExpression(expr string) interface{} {
 		return we.context.Config[key]
 	}
 
+	if strings.HasPrefix(expr, "$credentials.") {
+		parts := strings.SplitN(strings.TrimPrefix(expr, "$credentials."), ".", 2)
+		credential, ok := we.context.Credentials[parts[0]]
+		if !ok {
+			return nil
+		}
+		// Only single fields are exposed, so a template cannot print every secret at once
+		if len(parts) == 1 {
+			log.Printf("Credential reference %s must name a field, e.g. %s.password", expr, expr)
+			return nil
+		}
+		if value, ok := credential[parts[1]]; ok {
+			return value
+		}
+		log.Printf("Credential %s has no field %s", parts[0], parts[1])
+		return nil
+	}
+
+	if expr == "$now" {
+		return time.Now().UTC().Format(time.RFC3339Nano)
+	}
+	if expr == "$today" {
+		return time.Now().UTC().Format("2006-01-02")
+	}
+
+	if expr == "$item" {
+		return we.context.Item
+	}
+	if strings.HasPrefix(expr, "$item.") {
+		return lookupPath(we.context.Item, strings.TrimPrefix(expr, "$item."))
+	}
+
 	if strings.HasPrefix(expr, "$node['") {
 		re := regexp.MustCompile(`\$node\['([^']+)'\]\.(.+)`)
 		matches := re.FindStringSubmatch(expr)